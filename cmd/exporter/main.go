@@ -64,6 +64,11 @@ func main() {
 	enableK8sScl := fs.Bool("enable-k8s-scl", true, "Enable Kubernetes Scheduler client integration")
 	enableCRI := fs.Bool("enable-cri", true, "Enable CRI runtime client for per-pod container ID resolution")
 	enableSlumrScl := fs.Bool("enable-slurm-scl", true, "Enable Slurm Scheduler client integration")
+	enableFluxScl := fs.Bool("enable-flux-scl", false, "Enable Flux Scheduler client integration")
+	enablePBSScl := fs.Bool("enable-pbs-scl", false, "Enable PBS Pro Scheduler client integration")
+	enableGenericScl := fs.Bool("enable-generic-scl", false, "Enable generic file based workload client integration")
+	enableContainerScl := fs.Bool("enable-container-scl", false, "Enable Docker/CRI container client integration for non k8s deployments")
+	containerSocket := fs.String("container-runtime-socket", "", "Docker or CRI runtime socket for the container client (auto detected if empty)")
 	sriov := fs.Bool("sriov-enable", false, "sriov host mode")
 	exitOnAgentDown := fs.Bool("exit-on-agent-down", false, "Exit DME if gpuagent is unreachable after consecutive failures")
 	exitOnRocpctlError := fs.Bool("exit-on-rocpctl-error", false, "Exit DME when rocpctl is auto-disabled after consecutive failures or a crash")
@@ -128,6 +133,10 @@ func main() {
 		exporter.WithExitOnRocpctlError(*exitOnRocpctlError),
 		exporter.WithBindAddr(*bindAddr),
 		exporter.WithSlurmClient(*enableSlumrScl),
+		exporter.WithFluxClient(*enableFluxScl),
+		exporter.WithPBSClient(*enablePBSScl),
+		exporter.WithGenericClient(*enableGenericScl),
+		exporter.WithContainerClient(*enableContainerScl, *containerSocket),
 		exporter.WithenableIFOEMonitoring(*enableIFOEMonitoring),
 		exporter.WithK8sApiClient(*enableK8s),
 		exporter.WithK8sSchedulerClient(*enableK8sScl),
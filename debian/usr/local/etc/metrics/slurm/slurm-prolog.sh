@@ -48,7 +48,8 @@ MSG=$(
     "SLURM_CLUSTER_NAME": "${SLURM_CLUSTER_NAME}",
     "SLURM_JOB_GPUS": "${AMD_SLURM_GPUS}",
     "CUDA_VISIBLE_DEVICES": "${AMDGPU_DEVICES}",
-    "SLURM_SCRIPT_CONTEXT": "${SLURM_SCRIPT_CONTEXT}"
+    "SLURM_SCRIPT_CONTEXT": "${SLURM_SCRIPT_CONTEXT}",
+    "AMD_PROFILER_OPT_OUT": "${AMD_PROFILER_OPT_OUT}"
    }
 EOF
 )
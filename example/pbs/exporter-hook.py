@@ -0,0 +1,71 @@
+#
+#Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+
+#Licensed under the Apache License, Version 2.0 (the \"License\");
+#you may not use this file except in compliance with the License.
+#You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+#Unless required by applicable law or agreed to in writing, software
+#distributed under the License is distributed on an \"AS IS\" BASIS,
+#WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+#See the License for the specific language governing permissions and
+#limitations under the License.
+#
+
+# PBS Pro hook for the AMD device metrics exporter. Register it for the
+# execjob_launch and execjob_end events; on launch one file per assigned GPU
+# is written to EXPORT_DIR, on end the files owned by the job are removed.
+
+import json
+import os
+
+import pbs
+
+EXPORT_DIR = "/var/run/exporter/pbs/"
+GPU_ENV_VARS = ("ROCR_VISIBLE_DEVICES", "HIP_VISIBLE_DEVICES", "CUDA_VISIBLE_DEVICES")
+
+
+def job_gpus(env):
+    for var in GPU_ENV_VARS:
+        val = env.get(var)
+        if val:
+            return [str(int(g) % 128) for g in val.split(",") if g.strip().isdigit()]
+    return []
+
+
+def job_env(job, env):
+    return {
+        "PBS_JOBID": job.id,
+        "PBS_JOBUSER": str(job.euser or env.get("PBS_O_LOGNAME", "")),
+        "PBS_QUEUE": str(job.queue.name if job.queue else env.get("PBS_QUEUE", "")),
+        "PBS_SERVER": pbs.get_pbs_server_name() if hasattr(pbs, "get_pbs_server_name") else "",
+    }
+
+
+e = pbs.event()
+try:
+    if not os.path.isdir(EXPORT_DIR):
+        e.accept()
+    job = e.job
+    if e.type == pbs.EXECJOB_LAUNCH:
+        env = e.env
+        msg = json.dumps(job_env(job, env))
+        for gpu in job_gpus(env):
+            with open(os.path.join(EXPORT_DIR, gpu), "w") as f:
+                f.write(msg)
+    elif e.type == pbs.EXECJOB_END:
+        for name in os.listdir(EXPORT_DIR):
+            path = os.path.join(EXPORT_DIR, name)
+            try:
+                with open(path) as f:
+                    if json.load(f).get("PBS_JOBID") == job.id:
+                        os.remove(path)
+            except (OSError, ValueError):
+                continue
+except SystemExit:
+    pass
+except Exception as ex:
+    pbs.logmsg(pbs.EVENT_DEBUG, "exporter hook failed: %s" % ex)
+e.accept()
@@ -0,0 +1,309 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package fsysdevice
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// AMDVendorID is the PCI vendor id of the AMD GPUs
+	AMDVendorID = "0x1002"
+
+	drmClassPath = "class/drm"
+)
+
+// keys of GPUSysfsStats.Values
+const (
+	SysfsPowerAverage = "power_average"       // Watts
+	SysfsPowerInput   = "power_input"         // Watts
+	SysfsGPUBusy      = "gpu_busy_percent"    // percent
+	SysfsMemBusy      = "mem_busy_percent"    // percent
+	SysfsVRAMTotal    = "mem_info_vram_total" // MB
+	SysfsVRAMUsed     = "mem_info_vram_used"  // MB
+	SysfsPCIeSpeed    = "current_link_speed"  // GT/s
+	SysfsPCIeMaxSpeed = "max_link_speed"      // GT/s
+	SysfsPCIeWidth    = "current_link_width"  // lanes
+	SysfsPCIeMaxWidth = "max_link_width"      // lanes
+	SysfsPowerCap     = "power_cap"           // Watts
+	SysfsPowerCapMin  = "power_cap_min"       // Watts
+	SysfsPowerCapMax  = "power_cap_max"       // Watts
+)
+
+var (
+	cardRe   = regexp.MustCompile(`^card(\d+)$`)
+	renderRe = regexp.MustCompile(`renderD(\d+)$`)
+	hwmonRe  = regexp.MustCompile(`^(temp|freq)(\d+)_label$`)
+)
+
+// SysfsClock is a hwmon clock reading
+type SysfsClock struct {
+	Label     string  // hwmon label, sclk or mclk
+	Frequency float64 // MHz
+}
+
+// RASErrorCount is the RAS error count of a block
+type RASErrorCount struct {
+	Correctable   uint64
+	Uncorrectable uint64
+}
+
+// GPUSysfsStats holds the amdgpu sysfs and hwmon readings of a GPU
+type GPUSysfsStats struct {
+	CardID       string
+	RenderID     string
+	PCIeBusID    string
+	ProductName  string
+	SerialNumber string
+	VBIOSVersion string
+	// scalar readings keyed by the Sysfs* keys, unreadable attributes are
+	// not set
+	Values map[string]float64
+	// temperatures in Celsius keyed by the hwmon label (edge, junction, mem)
+	Temperatures map[string]float64
+	// clocks in the hwmon order
+	Clocks []SysfsClock
+	// RAS error counts keyed by the RAS block (umc, gfx, sdma, ...)
+	RASErrors map[string]RASErrorCount
+}
+
+// PowerCapRange is the range the power cap of a GPU can be set to in Watts
+type PowerCapRange struct {
+	Min float64
+	Max float64
+}
+
+// ReadGPUSysfsStats reads the amdgpu sysfs and hwmon attributes of the AMD
+// GPUs under sysRoot, the GPUs are sorted by PCIe bus id
+func ReadGPUSysfsStats(sysRoot string) ([]*GPUSysfsStats, error) {
+	devices, err := findAMDGPUCards(sysRoot)
+	if err != nil {
+		return nil, err
+	}
+	gpus := []*GPUSysfsStats{}
+	for cardID, devPath := range devices {
+		gpus = append(gpus, readGPUSysfsStats(cardID, devPath))
+	}
+	sort.Slice(gpus, func(i, j int) bool {
+		if gpus[i].PCIeBusID != gpus[j].PCIeBusID {
+			return gpus[i].PCIeBusID < gpus[j].PCIeBusID
+		}
+		return gpus[i].CardID < gpus[j].CardID
+	})
+	return gpus, nil
+}
+
+// ReadPowerCapRanges reads the hwmon power cap range of the AMD GPUs under
+// sysRoot keyed by the lower case PCIe bus id
+func ReadPowerCapRanges(sysRoot string) (map[string]PowerCapRange, error) {
+	devices, err := findAMDGPUCards(sysRoot)
+	if err != nil {
+		return nil, err
+	}
+	ranges := make(map[string]PowerCapRange)
+	for _, devPath := range devices {
+		busID := strings.ToLower(readKeyValues(filepath.Join(devPath, "uevent"))["PCI_SLOT_NAME"])
+		hwmons, _ := filepath.Glob(filepath.Join(devPath, "hwmon", "hwmon*", "power1_cap_max"))
+		if busID == "" || len(hwmons) == 0 {
+			continue
+		}
+		hwmon := filepath.Dir(hwmons[0])
+		capMin, minErr := readFloat(filepath.Join(hwmon, "power1_cap_min"))
+		capMax, maxErr := readFloat(filepath.Join(hwmon, "power1_cap_max"))
+		if minErr != nil || maxErr != nil {
+			continue
+		}
+		// microwatts
+		ranges[busID] = PowerCapRange{Min: capMin / 1000000, Max: capMax / 1000000}
+	}
+	return ranges, nil
+}
+
+// findAMDGPUCards returns the device directory of the AMD GPU drm cards
+// under sysRoot keyed by card id
+func findAMDGPUCards(sysRoot string) (map[string]string, error) {
+	entries, err := os.ReadDir(filepath.Join(sysRoot, drmClassPath))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read drm class directory: %w", err)
+	}
+	devices := make(map[string]string)
+	for _, entry := range entries {
+		match := cardRe.FindStringSubmatch(entry.Name())
+		if match == nil {
+			continue
+		}
+		devPath := filepath.Join(sysRoot, drmClassPath, entry.Name(), "device")
+		if readString(filepath.Join(devPath, "vendor")) != AMDVendorID {
+			continue
+		}
+		devices[match[1]] = devPath
+	}
+	if len(devices) == 0 {
+		return nil, fmt.Errorf("no amdgpu devices found in %v", filepath.Join(sysRoot, drmClassPath))
+	}
+	return devices, nil
+}
+
+func readGPUSysfsStats(cardID, devPath string) *GPUSysfsStats {
+	gpu := &GPUSysfsStats{
+		CardID:       cardID,
+		PCIeBusID:    readKeyValues(filepath.Join(devPath, "uevent"))["PCI_SLOT_NAME"],
+		ProductName:  readString(filepath.Join(devPath, "product_name")),
+		SerialNumber: readString(filepath.Join(devPath, "serial_number")),
+		VBIOSVersion: readString(filepath.Join(devPath, "vbios_version")),
+		Values:       make(map[string]float64),
+		Temperatures: make(map[string]float64),
+		RASErrors:    make(map[string]RASErrorCount),
+	}
+	if renders, _ := filepath.Glob(filepath.Join(devPath, "drm", "renderD*")); len(renders) != 0 {
+		if match := renderRe.FindStringSubmatch(renders[0]); match != nil {
+			gpu.RenderID = match[1]
+		}
+	}
+
+	// link speeds are formatted as "16.0 GT/s PCIe", only the number is read
+	for key, scale := range map[string]float64{
+		SysfsGPUBusy:      1,
+		SysfsMemBusy:      1,
+		SysfsVRAMTotal:    1024 * 1024,
+		SysfsVRAMUsed:     1024 * 1024,
+		SysfsPCIeSpeed:    1,
+		SysfsPCIeMaxSpeed: 1,
+		SysfsPCIeWidth:    1,
+		SysfsPCIeMaxWidth: 1,
+	} {
+		if val, err := readFloat(filepath.Join(devPath, key)); err == nil {
+			gpu.Values[key] = val / scale
+		}
+	}
+
+	hwmons, _ := filepath.Glob(filepath.Join(devPath, "hwmon", "hwmon*"))
+	sort.Strings(hwmons)
+	for _, hwmon := range hwmons {
+		readHwmon(gpu, hwmon)
+	}
+
+	rasFiles, _ := filepath.Glob(filepath.Join(devPath, "ras", "*_err_count"))
+	for _, rasFile := range rasFiles {
+		block := strings.TrimSuffix(filepath.Base(rasFile), "_err_count")
+		counts := readKeyValues(rasFile)
+		ce, cerr := strconv.ParseUint(counts["ce"], 10, 64)
+		ue, uerr := strconv.ParseUint(counts["ue"], 10, 64)
+		if cerr != nil || uerr != nil {
+			continue
+		}
+		gpu.RASErrors[block] = RASErrorCount{Correctable: ce, Uncorrectable: ue}
+	}
+	return gpu
+}
+
+// readHwmon reads the labeled temperatures and clocks and the power of a
+// hwmon directory, the first reading of a label wins
+func readHwmon(gpu *GPUSysfsStats, hwmon string) {
+	entries, err := os.ReadDir(hwmon)
+	if err != nil {
+		return
+	}
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	// freq10 after freq9
+	sort.Slice(names, func(i, j int) bool {
+		mi, mj := hwmonRe.FindStringSubmatch(names[i]), hwmonRe.FindStringSubmatch(names[j])
+		if mi == nil || mj == nil || mi[1] != mj[1] {
+			return names[i] < names[j]
+		}
+		ni, _ := strconv.Atoi(mi[2])
+		nj, _ := strconv.Atoi(mj[2])
+		return ni < nj
+	})
+	for _, name := range names {
+		match := hwmonRe.FindStringSubmatch(name)
+		if match == nil {
+			continue
+		}
+		label := readString(filepath.Join(hwmon, name))
+		val, err := readFloat(filepath.Join(hwmon, match[1]+match[2]+"_input"))
+		if label == "" || err != nil {
+			continue
+		}
+		switch match[1] {
+		case "temp":
+			if _, ok := gpu.Temperatures[label]; !ok {
+				// millidegrees Celsius
+				gpu.Temperatures[label] = val / 1000
+			}
+		case "freq":
+			// Hz
+			gpu.Clocks = append(gpu.Clocks, SysfsClock{Label: label, Frequency: val / 1000000})
+		}
+	}
+	for key, file := range map[string]string{
+		SysfsPowerAverage: "power1_average",
+		SysfsPowerInput:   "power1_input",
+		SysfsPowerCap:     "power1_cap",
+		SysfsPowerCapMin:  "power1_cap_min",
+		SysfsPowerCapMax:  "power1_cap_max",
+	} {
+		if _, ok := gpu.Values[key]; ok {
+			continue
+		}
+		// microwatts
+		if val, err := readFloat(filepath.Join(hwmon, file)); err == nil {
+			gpu.Values[key] = val / 1000000
+		}
+	}
+}
+
+func readString(filePath string) string {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// readFloat parses the leading number of an attribute
+func readFloat(filePath string) (float64, error) {
+	fields := strings.Fields(readString(filePath))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("empty attribute %v", filePath)
+	}
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+// readKeyValues parses the "key=value" or "key: value" lines of an attribute
+func readKeyValues(filePath string) map[string]string {
+	result := make(map[string]string)
+	for _, line := range strings.Split(readString(filePath), "\n") {
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			key, value, ok = strings.Cut(line, ":")
+		}
+		if ok {
+			result[strings.TrimSpace(key)] = strings.TrimSpace(value)
+		}
+	}
+	return result
+}
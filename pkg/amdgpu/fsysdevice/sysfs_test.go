@@ -0,0 +1,97 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package fsysdevice
+
+import (
+	"path/filepath"
+	"testing"
+
+	"gotest.tools/assert"
+)
+
+func TestReadGPUSysfsStats(t *testing.T) {
+	gpus, err := ReadGPUSysfsStats(filepath.Join("testdata", "sysfs"))
+	assert.Assert(t, err == nil, "unexpected error %v", err)
+	// connector and non AMD cards are skipped, sorted by PCIe bus id
+	assert.Equal(t, len(gpus), 2)
+	assert.Equal(t, gpus[0].PCIeBusID, "0000:01:00.0")
+	assert.Equal(t, gpus[1].PCIeBusID, "0000:05:00.0")
+
+	gpu := gpus[1]
+	assert.Equal(t, gpu.CardID, "0")
+	assert.Equal(t, gpu.RenderID, "128")
+	assert.Equal(t, gpu.ProductName, "AMD Instinct MI300X")
+	assert.Equal(t, gpu.SerialNumber, "692251001124")
+	assert.Equal(t, gpu.VBIOSVersion, "113-M3000100-102")
+	assert.DeepEqual(t, gpu.Values, map[string]float64{
+		SysfsPowerAverage: 152,
+		SysfsPowerInput:   158,
+		SysfsGPUBusy:      42,
+		SysfsMemBusy:      7,
+		SysfsVRAMTotal:    196592,
+		SysfsVRAMUsed:     10240,
+		SysfsPCIeSpeed:    32,
+		SysfsPCIeMaxSpeed: 32,
+		SysfsPCIeWidth:    16,
+		SysfsPCIeMaxWidth: 16,
+		SysfsPowerCap:     750,
+		SysfsPowerCapMin:  0,
+		SysfsPowerCapMax:  750,
+	})
+	assert.DeepEqual(t, gpu.Temperatures, map[string]float64{
+		"edge":     41,
+		"junction": 48,
+		"mem":      39,
+	})
+	assert.DeepEqual(t, gpu.Clocks, []SysfsClock{
+		{Label: "sclk", Frequency: 2100},
+		{Label: "mclk", Frequency: 1300},
+	})
+	assert.DeepEqual(t, gpu.RASErrors, map[string]RASErrorCount{
+		"umc":      {Correctable: 4, Uncorrectable: 1},
+		"gfx":      {Correctable: 2},
+		"pcie_bif": {Correctable: 1},
+	})
+
+	// missing attributes are not set
+	gpu = gpus[0]
+	assert.Equal(t, gpu.CardID, "1")
+	assert.Equal(t, gpu.RenderID, "136")
+	assert.DeepEqual(t, gpu.Values, map[string]float64{
+		SysfsGPUBusy:   0,
+		SysfsVRAMTotal: 196592,
+		SysfsVRAMUsed:  0,
+	})
+	assert.DeepEqual(t, gpu.Temperatures, map[string]float64{"edge": 35})
+	assert.Equal(t, len(gpu.Clocks), 0)
+	assert.Equal(t, len(gpu.RASErrors), 0)
+
+	_, err = ReadGPUSysfsStats(t.TempDir())
+	assert.Assert(t, err != nil)
+}
+
+func TestReadPowerCapRanges(t *testing.T) {
+	ranges, err := ReadPowerCapRanges(filepath.Join("testdata", "sysfs"))
+	assert.Assert(t, err == nil, "unexpected error %v", err)
+	// card1 has no power cap range
+	assert.DeepEqual(t, ranges, map[string]PowerCapRange{
+		"0000:05:00.0": {Min: 0, Max: 750},
+	})
+
+	_, err = ReadPowerCapRanges(t.TempDir())
+	assert.Assert(t, err != nil)
+}
@@ -42,11 +42,17 @@ type GPUAgentClient struct {
 	k8sApiClient         *k8sclient.K8sClient
 	k8sScheduler         scheduler.SchedulerClient
 	slurmScheduler       scheduler.SchedulerClient
+	jobSchedulers        []scheduler.SchedulerClient // flux, pbs, generic, container
 	enableGPUMonitoring  bool
 	enableIFOEMonitoring bool
 	isKubernetes         bool // pod resource client enabled or not
 	enabledK8sApi        bool
 	enableSlurmScl       bool
+	enableFluxScl        bool
+	enablePBSScl         bool
+	enableGenericScl     bool
+	enableContainerScl   bool
+	containerSocket      string // container runtime socket, auto detected if empty
 	enableSriov          bool
 	exitOnAgentDown      bool // exit DME process when agent is unreachable
 	exitOnRocpctlError   bool // exit DME process when rocpctl auto-disables on failure
@@ -104,6 +110,35 @@ func WithSlurmClient(enable bool) GPUAgentClientOptions {
 	}
 }
 
+func WithFluxClient(enable bool) GPUAgentClientOptions {
+	return func(ga *GPUAgentClient) {
+		logger.Log.Printf("flux scheduler client set to %v", enable)
+		ga.enableFluxScl = enable
+	}
+}
+
+func WithPBSClient(enable bool) GPUAgentClientOptions {
+	return func(ga *GPUAgentClient) {
+		logger.Log.Printf("pbs scheduler client set to %v", enable)
+		ga.enablePBSScl = enable
+	}
+}
+
+func WithGenericClient(enable bool) GPUAgentClientOptions {
+	return func(ga *GPUAgentClient) {
+		logger.Log.Printf("generic workload client set to %v", enable)
+		ga.enableGenericScl = enable
+	}
+}
+
+func WithContainerClient(enable bool, socket string) GPUAgentClientOptions {
+	return func(ga *GPUAgentClient) {
+		logger.Log.Printf("container scheduler client set to %v socket %q", enable, socket)
+		ga.enableContainerScl = enable
+		ga.containerSocket = socket
+	}
+}
+
 func WithGPUMonitoring(enableGPUMonitoring bool) GPUAgentClientOptions {
 	return func(ga *GPUAgentClient) {
 		logger.Log.Printf("GPU monitoring enable %v", enableGPUMonitoring)
@@ -229,6 +264,42 @@ func (ga *GPUAgentClient) initalizeScheduler() error {
 		}
 		ga.slurmScheduler = slurmScl
 	}
+	if ga.enableFluxScl {
+		fluxScl, err := scheduler.NewFluxClient(ga.ctx)
+		if err != nil {
+			events.EmitWarning(ga.ctx, events.FluxClientFailed,
+				fmt.Sprintf("flux scheduler init failed: %v; flux job labels will be unavailable.", err))
+			return err
+		}
+		ga.jobSchedulers = append(ga.jobSchedulers, fluxScl)
+	}
+	if ga.enablePBSScl {
+		pbsScl, err := scheduler.NewPBSClient(ga.ctx)
+		if err != nil {
+			events.EmitWarning(ga.ctx, events.PBSWatcherFailed,
+				fmt.Sprintf("pbs scheduler init failed: %v; pbs job labels will be unavailable.", err))
+			return err
+		}
+		ga.jobSchedulers = append(ga.jobSchedulers, pbsScl)
+	}
+	if ga.enableGenericScl {
+		genericScl, err := scheduler.NewGenericClient(ga.ctx)
+		if err != nil {
+			events.EmitWarning(ga.ctx, events.GenericWatcherFailed,
+				fmt.Sprintf("generic workload client init failed: %v; workload labels will be unavailable.", err))
+			return err
+		}
+		ga.jobSchedulers = append(ga.jobSchedulers, genericScl)
+	}
+	if ga.enableContainerScl {
+		containerScl, err := scheduler.NewContainerClient(ga.ctx, ga.containerSocket)
+		if err != nil {
+			events.EmitWarning(ga.ctx, events.ContainerClientFailed,
+				fmt.Sprintf("container scheduler init failed: %v; container labels will be unavailable.", err))
+			return err
+		}
+		ga.jobSchedulers = append(ga.jobSchedulers, containerScl)
+	}
 	return nil
 }
 
@@ -333,6 +404,15 @@ func (ga *GPUAgentClient) StartMonitor() {
 		}
 	}
 
+	// start background profiler sampling, idle until enabled by config
+	if ga.enableGPUMonitoring {
+		for _, client := range ga.clients {
+			if gpuClient, ok := client.(*GPUAgentGPUClient); ok {
+				gpuClient.startProfilerScheduler(ga.ctx)
+			}
+		}
+	}
+
 	// Get health polling interval from configuration
 	pollInterval := ga.mh.GetHealthPollingInterval()
 	logger.Log.Printf("Health polling interval set to %v", pollInterval)
@@ -398,6 +478,10 @@ func (ga *GPUAgentClient) StartMonitor() {
 				}
 			}
 
+			if ga.enableIFOEMonitoring {
+				ga.processIFOEHealthValidation()
+			}
+
 			// Successful poll tick — reset the failure counter.
 			consecutiveFailures = 0
 
@@ -431,6 +515,32 @@ func (ga *GPUAgentClient) processHealthValidation() error {
 	return nil
 }
 
+// processIFOEHealthValidation - process health validation and node label
+// update of the IFOE clients
+func (ga *GPUAgentClient) processIFOEHealthValidation() {
+	for _, client := range ga.clients {
+		if client.GetDeviceType() != globals.IFOEDevice {
+			continue
+		}
+		if err := client.processHealthValidation(); err != nil {
+			logger.Log.Printf("ifoe health validation failed %v", err)
+		}
+		if err := client.sendNodeLabelUpdate(); err != nil {
+			logger.Log.Printf("ifoe failed to send node label update %v", err)
+		}
+	}
+}
+
+// GetIFOEClient returns the IFOE client, nil when IFOE monitoring is disabled
+func (ga *GPUAgentClient) GetIFOEClient() *GPUAgentIFOEClient {
+	for _, client := range ga.clients {
+		if ifoeClient, ok := client.(*GPUAgentIFOEClient); ok {
+			return ifoeClient
+		}
+	}
+	return nil
+}
+
 // sendNodeLabelUpdate - send node label update for all clients
 func (ga *GPUAgentClient) sendNodeLabelUpdate() error {
 	for _, client := range ga.clients {
@@ -455,7 +565,9 @@ func strDoubleToFloat(strValue string) float64 {
 }
 
 // ListWorkloads - get all workloads from every client , lock must be taken by
-// the caller
+// the caller. When several clients claim the same GPU the first one wins, in
+// the order kubernetes, slurm, flux, pbs, generic and container, so a
+// descriptor file or a runtime container never hides a scheduler job.
 func (ga *GPUAgentClient) ListWorkloads() (wls map[string]scheduler.Workload, err error) {
 	wls = make(map[string]scheduler.Workload)
 	if ga.isKubernetes && ga.k8sScheduler != nil {
@@ -468,17 +580,25 @@ func (ga *GPUAgentClient) ListWorkloads() (wls map[string]scheduler.Workload, er
 			wls[k] = wl
 		}
 	}
-	if ga.slurmScheduler == nil {
-		return wls, nil
-	}
-	var swls map[string]scheduler.Workload
-	swls, err = ga.slurmScheduler.ListWorkloads()
-	if err != nil {
-		return
+	jobSchedulers := ga.jobSchedulers
+	if ga.slurmScheduler != nil {
+		jobSchedulers = append([]scheduler.SchedulerClient{ga.slurmScheduler}, jobSchedulers...)
 	}
-	// return combined list
-	for k, wl := range swls {
-		wls[k] = wl
+	for _, scl := range jobSchedulers {
+		var swls map[string]scheduler.Workload
+		swls, err = scl.ListWorkloads()
+		if err != nil {
+			return
+		}
+		// return combined list, keeping the higher precedence entry
+		for k, wl := range swls {
+			if cur, ok := wls[k]; ok {
+				logger.Debugf("gpu %v claimed by %v and %v workloads, keeping %v",
+					k, cur.Type, wl.Type, cur.Type)
+				continue
+			}
+			wls[k] = wl
+		}
 	}
 	return
 }
@@ -503,6 +623,12 @@ func (ga *GPUAgentClient) Close() {
 		ga.slurmScheduler = nil
 	}
 
+	for _, scl := range ga.jobSchedulers {
+		logger.Log.Printf("gpuagent %v scheduler closing", scl.Type())
+		scl.Close()
+	}
+	ga.jobSchedulers = nil
+
 	for _, client := range ga.clients {
 		logger.Log.Printf("gpuagent client %v closing", client.GetDeviceType())
 		client.Close()
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/fsysdevice"
@@ -33,6 +34,7 @@ import (
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+	"github.com/ROCm/device-metrics-exporter/pkg/topology"
 	"github.com/ROCm/device-metrics-exporter/pkg/types"
 	"github.com/gofrs/uuid"
 )
@@ -103,12 +105,26 @@ type GPUAgentGPUClient struct {
 	gpuSelectorMap        map[int]bool
 	customLabelMap        map[string]string
 	extraPodLabelsMap     map[string]string
+	extraWorkloadLabels   map[string]string // prometheus label -> workload descriptor key
 	allowedCustomLabels   []string
 	k8PodInfoMap          map[string]types.K8sPodInfo
 	nodeHealthLabellerCfg *utils.NodeHealthLabellerConfig
+	gpuIDMapMu            sync.RWMutex         // guards gpuIDMap, read by the profiler scheduler
 	gpuIDMap              map[string]GPUIDMeta // populate once at boot time
 	fl                    *fieldLogger
 	podInfoEnabled        bool
+	processTopN           int    // max processes exported per gpu
+	procRoot              string // procfs root, replaced in tests
+	sysRoot               string // sysfs root, replaced in tests
+	profilerSched         atomic.Pointer[exportermetrics.ProfilerSchedulerConfig]
+	profilerOptOut        atomic.Pointer[exportermetrics.ProfilerOptOutConfig]
+	sysfsCollector        globals.SysfsCollectorMode
+	settingsMu            sync.Mutex
+	gpuSettings           map[string]*gpuSettings // pcie base address -> settings of the last scrape
+	powerCapOnce          sync.Once
+	powerCapRanges        map[string]fsysdevice.PowerCapRange // pcie bus id -> range
+	infoMetrics           bool                                // identity labels on gpu_info only
+	partitionRollup       bool                                // export the partition rollups of the physical gpus
 
 	computeNodeHealthState bool // Tracks the health state of the compute node
 }
@@ -129,6 +145,10 @@ func NewGPUAgentGPUClient(gpuHandler *GPUAgentClient) (*GPUAgentGPUClient, error
 		gpuHandler:      gpuHandler,
 		fl:              gpuHandler.fl,
 		gpuIDMap:        make(map[string]GPUIDMeta),
+		processTopN:     globals.DefaultProcessMetricsTopN,
+		procRoot:        utils.GetProcRoot(),
+		sysRoot:         "/sys",
+		gpuSettings:     make(map[string]*gpuSettings),
 	}
 	gpuClient.rocpclient = rocprofiler.NewRocProfilerClient("rocpclient")
 	gpuClient.rocpclient.SetEventEmitter(func(ctx context.Context, reason, msg string) {
@@ -159,7 +179,8 @@ func (ga *GPUAgentGPUClient) InitPodExtraLabels(config *exportermetrics.GPUMetri
 	// initialize pod labels maps
 	ga.k8PodInfoMap = make(map[string]types.K8sPodInfo)
 	if config != nil {
-		ga.extraPodLabelsMap = utils.NormalizeExtraPodLabels(config.GetExtraPodLabels())
+		ga.extraPodLabelsMap = utils.NormalizePodMetadataLabels(config.GetExtraPodLabels(),
+			config.GetExtraPodAnnotations(), config.GetExtraNamespaceLabels(), config.GetOwnerKindLabel())
 		if len(ga.extraPodLabelsMap) > 0 {
 			ga.podInfoEnabled = true
 		}
@@ -167,6 +188,14 @@ func (ga *GPUAgentGPUClient) InitPodExtraLabels(config *exportermetrics.GPUMetri
 	logger.Log.Printf("export-labels updated to %v", ga.extraPodLabelsMap)
 }
 
+func (ga *GPUAgentGPUClient) initWorkloadLabels(config *exportermetrics.GPUMetricConfig) {
+	ga.extraWorkloadLabels = make(map[string]string)
+	if config != nil {
+		ga.extraWorkloadLabels = utils.NormalizeExtraWorkloadLabels(config.GetExtraWorkloadLabels())
+	}
+	logger.Log.Printf("workload labels updated to %v", ga.extraWorkloadLabels)
+}
+
 func (ga *GPUAgentGPUClient) InitClients() error {
 	conn := ga.gpuHandler.GetGRPCConnection()
 	if conn == nil {
@@ -179,6 +208,8 @@ func (ga *GPUAgentGPUClient) InitClients() error {
 
 func (ga *GPUAgentGPUClient) initGPUMetadata(gpus []*amdgpu.GPU) {
 	logger.Debugf("Initializing GPU metadata for %d GPUs", len(gpus))
+	ga.gpuIDMapMu.Lock()
+	defer ga.gpuIDMapMu.Unlock()
 	for _, gpu := range gpus {
 		gpuID := fmt.Sprintf("%v", getGPUInstanceID(gpu))
 		renderID := getGPURenderId(gpu)
@@ -199,10 +230,44 @@ func (ga *GPUAgentGPUClient) initGPUMetadata(gpus []*amdgpu.GPU) {
 		}
 		ga.gpuIDMap[gpuID] = gpuIDMeta
 	}
+	ga.updateTopology(gpus)
+}
+
+// updateTopology registers the GPUs in the PCIe/NUMA topology, the
+// partitions of a GPU share the device of the GPU
+func (ga *GPUAgentGPUClient) updateTopology(gpus []*amdgpu.GPU) {
+	devs := map[string]string{}
+	ids := map[string]int{}
+	for _, gpu := range gpus {
+		addr := getTopologyAddr(gpu)
+		if addr == "" {
+			continue
+		}
+		if id, ok := ids[addr]; ok && id <= getGPUInstanceID(gpu) {
+			continue
+		}
+		ids[addr] = getGPUInstanceID(gpu)
+		devs[addr] = getGPUInstanceIDString(gpu)
+	}
+	topology.SetDevices(topology.GPU, devs)
+}
+
+// gpuIDMapSnapshot returns a copy of the GPU metadata, the map is updated
+// on scrape while the profiler scheduler reads it
+func (ga *GPUAgentGPUClient) gpuIDMapSnapshot() map[string]GPUIDMeta {
+	ga.gpuIDMapMu.RLock()
+	defer ga.gpuIDMapMu.RUnlock()
+	gpuIDMap := make(map[string]GPUIDMeta, len(ga.gpuIDMap))
+	for gpuID, meta := range ga.gpuIDMap {
+		gpuIDMap[gpuID] = meta
+	}
+	return gpuIDMap
 }
 
 func (ga *GPUAgentGPUClient) GetGPUMeta(gpuID string) (*GPUIDMeta, error) {
+	ga.gpuIDMapMu.RLock()
 	gpuMeta, exists := ga.gpuIDMap[gpuID]
+	ga.gpuIDMapMu.RUnlock()
 	if !exists {
 		logger.Debugf("GPU metadata not found for GPU ID: %s", gpuID)
 		return nil, fmt.Errorf("GPU metadata not found for GPU ID: %s", gpuID)
@@ -228,6 +293,10 @@ func (ga *GPUAgentGPUClient) getProfilerMetrics(ctx context.Context) (map[string
 	if !ga.isProfilerEnabled() {
 		return gpuMetrics, nil
 	}
+	// the scheduler updates the suppression before each sample
+	if !ga.rocpclient.IsScheduled() && ga.updateProfilerSuppression() {
+		return gpuMetrics, nil
+	}
 	gpuProfiler, err := ga.rocpclient.GetMetrics(ctx)
 	if err != nil {
 		return gpuMetrics, err
@@ -258,6 +327,9 @@ func (ga *GPUAgentGPUClient) isProfilerEnabled() bool {
 }
 
 func (ga *GPUAgentGPUClient) getMetricsAll(ctx context.Context) error {
+	if ga.sysfsCollector == globals.SysfsCollectorPrimary {
+		return ga.updateSysfsMetrics()
+	}
 	if !ga.isActive() {
 		// nolint
 		_ = ga.InitClients()
@@ -280,13 +352,16 @@ func (ga *GPUAgentGPUClient) getMetricsAll(ctx context.Context) error {
 
 	// send the req to gpuclient
 	resp, partitionMap, err := ga.getGPUs()
+	if err == nil && resp != nil && resp.ApiStatus != 0 {
+		logger.Errorf("resp status :%v", resp.ApiStatus)
+		err = fmt.Errorf("%v", resp.ApiStatus)
+	}
+	if ga.fallbackToSysfs(err) {
+		return ga.updateSysfsMetrics()
+	}
 	if err != nil {
 		return err
 	}
-	if resp != nil && resp.ApiStatus != 0 {
-		logger.Errorf("resp status :%v", resp.ApiStatus)
-		return fmt.Errorf("%v", resp.ApiStatus)
-	}
 	cper, err := ga.getLatestCPER()
 	if err != nil {
 		logger.Debugf("getLatestCPER failed with err : %v", err)
@@ -311,6 +386,7 @@ func (ga *GPUAgentGPUClient) getMetricsAll(ctx context.Context) error {
 
 	nonGpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
 	ga.metrics.gpuNodesTotal.With(nonGpuLabels).Set(float64(len(resp.Response)))
+	ga.updateProfilerSampleAge()
 	for _, gpu := range resp.Response {
 		var gpuProfMetrics map[string]float64
 		// if available use the data
@@ -321,6 +397,8 @@ func (ga *GPUAgentGPUClient) getMetricsAll(ctx context.Context) error {
 		}
 		ga.updateGPUInfoToMetrics(wls, gpu, partitionMap, gpuProfMetrics, cper)
 	}
+	ga.updateGPUSettingsEvents(resp.Response)
+	ga.updatePartitionRollupMetrics(resp.Response)
 
 	return nil
 }
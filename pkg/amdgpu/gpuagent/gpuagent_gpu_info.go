@@ -0,0 +1,89 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+var (
+	// labels identifying the gpu series, kept on every series in info metric
+	// mode to join with gpu_info
+	gpuInfoJoinLabels = []string{
+		exportermetrics.MetricLabel_HOSTNAME.String(),
+		exportermetrics.GPUMetricLabel_GPU_ID.String(),
+		exportermetrics.GPUMetricLabel_GPU_PARTITION_ID.String(),
+	}
+	// static identity labels only exported on gpu_info in info metric mode
+	gpuInfoLabels = []string{
+		exportermetrics.GPUMetricLabel_GPU_UUID.String(),
+		exportermetrics.MetricLabel_SERIAL_NUMBER.String(),
+		exportermetrics.MetricLabel_CARD_SERIES.String(),
+		exportermetrics.MetricLabel_CARD_MODEL.String(),
+		exportermetrics.MetricLabel_CARD_VENDOR.String(),
+		exportermetrics.MetricLabel_DRIVER_VERSION.String(),
+		exportermetrics.MetricLabel_VBIOS_VERSION.String(),
+		exportermetrics.GPUMetricLabel_GPU_COMPUTE_PARTITION_TYPE.String(),
+		exportermetrics.GPUMetricLabel_GPU_MEMORY_PARTITION_TYPE.String(),
+		exportermetrics.GPUMetricLabel_DEPLOYMENT_MODE.String(),
+		exportermetrics.GPUMetricLabel_AFFINITY_NIC.String(),
+	}
+)
+
+func (ga *GPUAgentGPUClient) initInfoMetrics(config *exportermetrics.GPUMetricConfig) {
+	ga.infoMetrics = config.GetInfoMetrics()
+	logger.Log.Printf("info metric mode set to %v", ga.infoMetrics)
+}
+
+// isInfoLabel returns true if the label is only exported on gpu_info
+func (ga *GPUAgentGPUClient) isInfoLabel(name string) bool {
+	return ga.infoMetrics && slices.Contains(gpuInfoLabels, strings.ToUpper(name))
+}
+
+// GetInfoLabels returns the labels of the gpu_info series, the join labels
+// followed by the enabled identity labels and the custom labels
+func (ga *GPUAgentGPUClient) GetInfoLabels() []string {
+	labelList := []string{}
+	for _, name := range append(slices.Clone(gpuInfoJoinLabels), gpuInfoLabels...) {
+		if ga.exportLabels[name] {
+			labelList = append(labelList, strings.ToLower(name))
+		}
+	}
+	for key := range ga.customLabelMap {
+		labelList = append(labelList, key)
+	}
+	return labelList
+}
+
+// updateGPUInfoMetric exports the static identity labels of the gpu on the
+// gpu_info series in info metric mode
+func (ga *GPUAgentGPUClient) updateGPUInfoMetric(gpu *amdgpu.GPU, partitionMap map[string]*amdgpu.GPU) {
+	if !ga.infoMetrics {
+		return
+	}
+	allLabels := ga.populateAllLabelsFromGPU(nil, gpu, partitionMap)
+	labels := make(map[string]string)
+	for _, key := range ga.GetInfoLabels() {
+		labels[key] = allLabels[key]
+	}
+	ga.metrics.gpuInfo.With(labels).Set(1)
+}
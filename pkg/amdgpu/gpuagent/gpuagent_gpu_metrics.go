@@ -22,10 +22,12 @@ import (
 	"math"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/rocprofiler"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
@@ -33,6 +35,7 @@ import (
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/parserutil"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+	"github.com/ROCm/device-metrics-exporter/pkg/topology"
 )
 
 // local variables
@@ -64,6 +67,7 @@ const (
 
 type GpuMetrics struct {
 	gpuNodesTotal              prometheus.GaugeVec
+	gpuInfo                    prometheus.GaugeVec
 	gpuPackagePower            prometheus.GaugeVec
 	gpuAvgPkgPower             prometheus.GaugeVec
 	gpuEdgeTemp                prometheus.GaugeVec
@@ -221,6 +225,22 @@ type GpuMetrics struct {
 	gpuPcieBidirBandwidth prometheus.GaugeVec
 	gpuAfidErrors         prometheus.GaugeVec
 	gpuProcessCuOcc       prometheus.GaugeVec
+	gpuProcessUsedVram    prometheus.GaugeVec
+
+	// gpu settings
+	gpuFirmwareInfo  prometheus.GaugeVec
+	gpuPowerCap      prometheus.GaugeVec
+	gpuPowerCapMin   prometheus.GaugeVec
+	gpuPowerCapMax   prometheus.GaugeVec
+	gpuPerfLevelInfo prometheus.GaugeVec
+	gpuClockRangeMin prometheus.GaugeVec
+	gpuClockRangeMax prometheus.GaugeVec
+
+	// partition rollups of the physical gpus
+	gpuRollupUsedVram       prometheus.GaugeVec
+	gpuRollupGfxActivityAvg prometheus.GaugeVec
+	gpuRollupGfxActivityMax prometheus.GaugeVec
+	gpuRollupPackagePower   prometheus.GaugeVec
 
 	// profiler metrics
 	gpuGrbmGuiActivity               prometheus.GaugeVec
@@ -276,6 +296,11 @@ type GpuMetrics struct {
 	gpuOccPerActiveCU      prometheus.GaugeVec
 	gpuMeanOccPerCU        prometheus.GaugeVec
 	gpuSimdActive          prometheus.GaugeVec
+	gpuArithIntensity      prometheus.GaugeVec
+	gpuAchievedTFLOPs      prometheus.GaugeVec
+	gpuL2HitRate           prometheus.GaugeVec
+	gpuProfSampleAge       prometheus.GaugeVec
+	gpuProfSuppressed      prometheus.GaugeVec
 }
 
 func (ga *GPUAgentGPUClient) ResetMetrics() error {
@@ -283,6 +308,7 @@ func (ga *GPUAgentGPUClient) ResetMetrics() error {
 	for _, prommetric := range ga.fieldMetricsMap {
 		prommetric.Metric.Reset()
 	}
+	ga.metrics.gpuInfo.Reset()
 	return nil
 }
 
@@ -300,7 +326,7 @@ func (ga *GPUAgentGPUClient) GetExporterNonGPULabels() []string {
 func (ga *GPUAgentGPUClient) GetExportLabels() []string {
 	labelList := []string{}
 	for key, enabled := range ga.exportLabels {
-		if !enabled {
+		if !enabled || ga.isInfoLabel(key) {
 			continue
 		}
 		labelList = append(labelList, strings.ToLower(key))
@@ -319,6 +345,19 @@ func (ga *GPUAgentGPUClient) GetExportLabels() []string {
 		}
 	}
 
+	for key := range ga.extraWorkloadLabels {
+		exists := false
+		for _, label := range labelList {
+			if key == label {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			labelList = append(labelList, key)
+		}
+	}
+
 	for key := range ga.customLabelMap {
 		exists := false
 		for _, label := range labelList {
@@ -334,6 +373,9 @@ func (ga *GPUAgentGPUClient) GetExportLabels() []string {
 		}
 	}
 
+	if ga.isSysfsCollectorEnabled() {
+		labelList = append(labelList, sourceLabel)
+	}
 	return labelList
 }
 
@@ -364,6 +406,13 @@ func (ga *GPUAgentGPUClient) initLabelConfigs(config *exportermetrics.GPUMetricC
 		}
 	}
 
+	// container labels follow the container scheduler client
+	if ga.gpuHandler != nil && ga.gpuHandler.enableContainerScl {
+		for name := range scheduler.ContainerLabels {
+			ga.exportLabels[name] = true
+		}
+	}
+
 	if ga.exportLabels[exportermetrics.MetricLabel_POD_UUID.String()] {
 		ga.podInfoEnabled = true
 	}
@@ -501,19 +550,82 @@ func (ga *GPUAgentGPUClient) initFieldConfig(config *exportermetrics.GPUMetricCo
 	}
 }
 
-// getAllSupportedProfilerFields returns a list of all profiler metric field aliases
+// getAllSupportedProfilerFields returns a list of all profiler metric field
+// aliases, derived metrics are replaced by their raw counters when enabled
 func (ga *GPUAgentGPUClient) getAllSupportedProfilerFields() []string {
+	seen := map[string]bool{}
 	profilerFields := []string{}
-	for f := range ga.fieldMetricsMap {
-		if meta, ok := ga.fieldMetricsMap[f]; ok {
-			if meta.Alias != "" {
-				profilerFields = append(profilerFields, meta.Alias)
+	add := func(c string) {
+		if !seen[c] {
+			seen[c] = true
+			profilerFields = append(profilerFields, c)
+		}
+	}
+	for f, meta := range ga.fieldMetricsMap {
+		if meta.Alias == "" {
+			continue
+		}
+		// derived metrics are computed from raw counters, not profiled
+		if rocprofiler.IsDerivedMetric(meta.Alias) {
+			if ga.exportFieldMap[f] {
+				for _, c := range rocprofiler.DerivedMetricCounters(meta.Alias) {
+					add(c)
+				}
 			}
+			continue
 		}
+		add(meta.Alias)
 	}
 	return profilerFields
 }
 
+// resolveCounterGroups maps the configured counter groups to rocprofiler
+// counters, fields may be GPU_PROF_* field names or raw counter names
+func (ga *GPUAgentGPUClient) resolveCounterGroups(groups []*exportermetrics.ProfilerCounterGroup) []rocprofiler.CounterGroup {
+	resolved := []rocprofiler.CounterGroup{}
+	for _, g := range groups {
+		fields := g.GetFields()
+		if len(fields) == 0 {
+			builtin, ok := rocprofiler.BuiltinCounterGroup(g.GetName())
+			if !ok {
+				logger.Log.Printf("counter group %v has no fields and is not a builtin group, ignored", g.GetName())
+				continue
+			}
+			fields = builtin
+		}
+		seen := map[string]bool{}
+		counters := []string{}
+		add := func(c string) {
+			if !seen[c] {
+				seen[c] = true
+				counters = append(counters, c)
+			}
+		}
+		for _, f := range fields {
+			name := f
+			if meta, ok := ga.fieldMetricsMap[strings.ToUpper(f)]; ok {
+				if meta.Alias == "" {
+					logger.Log.Printf("counter group %v field %v is not a profiler field, ignored", g.GetName(), f)
+					continue
+				}
+				name = meta.Alias
+			}
+			if rocprofiler.IsDerivedMetric(name) {
+				for _, c := range rocprofiler.DerivedMetricCounters(name) {
+					add(c)
+				}
+				continue
+			}
+			add(name)
+		}
+		if len(counters) == 0 {
+			continue
+		}
+		resolved = append(resolved, rocprofiler.CounterGroup{Name: g.GetName(), Counters: counters})
+	}
+	return resolved
+}
+
 func (ga *GPUAgentGPUClient) initFieldMetricsMap() {
 	//nolint
 	ga.fieldMetricsMap = map[string]FieldMeta{
@@ -714,7 +826,24 @@ func (ga *GPUAgentGPUClient) initFieldMetricsMap() {
 		exportermetrics.GPUMetricField_GPU_PROF_OCCUPANCY_PER_ACTIVE_CU.String():            FieldMeta{Metric: ga.metrics.gpuOccPerActiveCU, Alias: "MeanOccupancyPerActiveCU"},
 		exportermetrics.GPUMetricField_GPU_PROF_OCCUPANCY_PER_CU.String():                   FieldMeta{Metric: ga.metrics.gpuMeanOccPerCU, Alias: "MeanOccupancyPerCU"},
 		exportermetrics.GPUMetricField_GPU_PROF_SIMD_UTILIZATION.String():                   FieldMeta{Metric: ga.metrics.gpuSimdActive, Alias: "SIMD_UTILIZATION"},
+		exportermetrics.GPUMetricField_GPU_PROF_ARITHMETIC_INTENSITY.String():               FieldMeta{Metric: ga.metrics.gpuArithIntensity, Alias: rocprofiler.ArithmeticIntensity},
+		exportermetrics.GPUMetricField_GPU_PROF_ACHIEVED_TFLOPS.String():                    FieldMeta{Metric: ga.metrics.gpuAchievedTFLOPs, Alias: rocprofiler.AchievedTFLOPs},
+		exportermetrics.GPUMetricField_GPU_PROF_L2_HIT_RATE.String():                        FieldMeta{Metric: ga.metrics.gpuL2HitRate, Alias: rocprofiler.L2HitRate},
+		exportermetrics.GPUMetricField_GPU_PROF_SAMPLE_AGE.String():                         FieldMeta{Metric: ga.metrics.gpuProfSampleAge},
+		exportermetrics.GPUMetricField_GPU_PROF_SUPPRESSED.String():                         FieldMeta{Metric: ga.metrics.gpuProfSuppressed},
 		exportermetrics.GPUMetricField_GPU_PROCESS_CU_OCCUPANCY.String():                    FieldMeta{Metric: ga.metrics.gpuProcessCuOcc},
+		exportermetrics.GPUMetricField_GPU_PROCESS_USED_VRAM.String():                       FieldMeta{Metric: ga.metrics.gpuProcessUsedVram},
+		exportermetrics.GPUMetricField_GPU_FIRMWARE_INFO.String():                           FieldMeta{Metric: ga.metrics.gpuFirmwareInfo},
+		exportermetrics.GPUMetricField_GPU_POWER_CAP.String():                               FieldMeta{Metric: ga.metrics.gpuPowerCap},
+		exportermetrics.GPUMetricField_GPU_POWER_CAP_MIN.String():                           FieldMeta{Metric: ga.metrics.gpuPowerCapMin},
+		exportermetrics.GPUMetricField_GPU_POWER_CAP_MAX.String():                           FieldMeta{Metric: ga.metrics.gpuPowerCapMax},
+		exportermetrics.GPUMetricField_GPU_PERF_LEVEL_INFO.String():                         FieldMeta{Metric: ga.metrics.gpuPerfLevelInfo},
+		exportermetrics.GPUMetricField_GPU_CLOCK_RANGE_MIN.String():                         FieldMeta{Metric: ga.metrics.gpuClockRangeMin},
+		exportermetrics.GPUMetricField_GPU_CLOCK_RANGE_MAX.String():                         FieldMeta{Metric: ga.metrics.gpuClockRangeMax},
+		exportermetrics.GPUMetricField_GPU_PARTITION_ROLLUP_USED_VRAM.String():              FieldMeta{Metric: ga.metrics.gpuRollupUsedVram},
+		exportermetrics.GPUMetricField_GPU_PARTITION_ROLLUP_GFX_ACTIVITY_AVG.String():       FieldMeta{Metric: ga.metrics.gpuRollupGfxActivityAvg},
+		exportermetrics.GPUMetricField_GPU_PARTITION_ROLLUP_GFX_ACTIVITY_MAX.String():       FieldMeta{Metric: ga.metrics.gpuRollupGfxActivityMax},
+		exportermetrics.GPUMetricField_GPU_PARTITION_ROLLUP_PACKAGE_POWER.String():          FieldMeta{Metric: ga.metrics.gpuRollupPackagePower},
 	}
 	logger.Log.Printf("Total GPU fields supported : %+v", len(ga.fieldMetricsMap))
 
@@ -728,6 +857,8 @@ func (ga *GPUAgentGPUClient) initProfilerMetricsField() {
 			ga.rocpclient.SetSamplingInterval(profilerConfig.GetSamplingInterval())
 			ga.rocpclient.SetPtlDelay(profilerConfig.GetPtlDelay())
 		}
+		ga.initProfilerScheduler(profilerConfig.GetScheduler())
+		ga.initProfilerOptOut(profilerConfig)
 
 		/* TBD: greedy packing fails if we pick and choose fields here
 		/* Need to revisit later
@@ -747,10 +878,24 @@ func (ga *GPUAgentGPUClient) initProfilerMetricsField() {
 		*/
 
 		// for PTL mode, enable all profiler fields and mask out in exporter
+		if profilerConfig != nil && len(profilerConfig.GetCounterGroups()) != 0 {
+			groups := ga.resolveCounterGroups(profilerConfig.GetCounterGroups())
+			if len(groups) != 0 {
+				rotation, _ := time.ParseDuration(profilerConfig.GetGroupRotationInterval())
+				logger.Log.Printf("profiling %d counter groups, rotation interval %v", len(groups), rotation)
+				ga.rocpclient.SetCounterGroups(groups, rotation)
+				return
+			}
+			logger.Log.Printf("no valid counter groups, profiling all supported fields")
+		}
 		profilerFields := ga.getAllSupportedProfilerFields()
 		ga.rocpclient.SetFields(profilerFields)
 		return
 	}
+	if ga.rocpclient != nil {
+		ga.initProfilerScheduler(nil)
+		ga.initProfilerOptOut(nil)
+	}
 	// to avoid exporting when disabled
 	// update the exporter fields map to disable performance register fields
 	for i := profilerStarIndex; i <= profilerEndIndex; i++ {
@@ -767,12 +912,18 @@ func (ga *GPUAgentGPUClient) initProfilerMetricsField() {
 func (ga *GPUAgentGPUClient) initPrometheusMetrics() {
 	nonGpuLabels := ga.GetExporterNonGPULabels()
 	labels := ga.GetExportLabels()
+	rollupLabels := ga.getPartitionRollupLabels()
 	ga.metrics = &GpuMetrics{
 		gpuNodesTotal: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "gpu_nodes_total",
 			Help: "Number of GPUs in the node",
 		},
 			nonGpuLabels),
+		gpuInfo: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_info",
+			Help: "Static identity labels of the GPU, always 1",
+		},
+			ga.GetInfoLabels()),
 		gpuPackagePower: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "gpu_package_power",
 			Help: "Current socket power in Watts",
@@ -1713,6 +1864,31 @@ func (ga *GPUAgentGPUClient) initPrometheusMetrics() {
 			Help: "Fraction of time the SIMDs are being utilized [0,1]",
 		},
 			labels),
+		gpuArithIntensity: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_prof_arithmetic_intensity",
+			Help: "Floating point operations per byte of memory traffic (derived)",
+		},
+			labels),
+		gpuAchievedTFLOPs: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_prof_achieved_tflops",
+			Help: "Achieved floating point throughput in TFLOPs (derived)",
+		},
+			labels),
+		gpuL2HitRate: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_prof_l2_hit_rate",
+			Help: "L2 cache hit rate in percent (derived)",
+		},
+			labels),
+		gpuProfSampleAge: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_prof_sample_age_seconds",
+			Help: "Age in seconds of the profiler sample exported",
+		},
+			nonGpuLabels),
+		gpuProfSuppressed: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_prof_suppressed",
+			Help: "Profiling of the GPU is suppressed by the workload holding it, reason label gives the opt out source",
+		},
+			append([]string{"reason"}, labels...)),
 		gpuPcieRx: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: "pcie_rx",
 			Help: "Accumulated bytes received from the PCIe link",
@@ -1737,7 +1913,67 @@ func (ga *GPUAgentGPUClient) initPrometheusMetrics() {
 			Name: "gpu_process_cu_occupancy",
 			Help: "Compute Unit occupancy for a process in percent",
 		},
-			append([]string{"process_id"}, labels...)),
+			append(append([]string{}, processLabels...), labels...)),
+		gpuProcessUsedVram: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_process_used_vram",
+			Help: "VRAM memory used by a process on the GPU (in MB)",
+		},
+			append(append([]string{}, processLabels...), labels...)),
+		gpuFirmwareInfo: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_firmware_info",
+			Help: "Firmware version of a GPU component, always 1",
+		},
+			append([]string{"component", "version"}, labels...)),
+		gpuPowerCap: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_power_cap",
+			Help: "Max package power the GPU can consume in Watts",
+		},
+			append([]string{"cap_type"}, labels...)),
+		gpuPowerCapMin: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_power_cap_min",
+			Help: "Minimum power cap that can be set in Watts",
+		},
+			labels),
+		gpuPowerCapMax: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_power_cap_max",
+			Help: "Maximum power cap that can be set in Watts",
+		},
+			labels),
+		gpuPerfLevelInfo: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_perf_level_info",
+			Help: "Performance level of the GPU, always 1",
+		},
+			append([]string{"perf_level"}, labels...)),
+		gpuClockRangeMin: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_clock_range_min",
+			Help: "Configured low frequency of the GPU clock in MHz",
+		},
+			append([]string{"clock_type"}, labels...)),
+		gpuClockRangeMax: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_clock_range_max",
+			Help: "Configured high frequency of the GPU clock in MHz",
+		},
+			append([]string{"clock_type"}, labels...)),
+		gpuRollupUsedVram: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_partition_rollup_used_vram",
+			Help: "VRAM used by all the partitions of the physical GPU (in MB)",
+		},
+			rollupLabels),
+		gpuRollupGfxActivityAvg: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_partition_rollup_gfx_activity_avg",
+			Help: "Mean graphics engine usage of the partitions of the physical GPU in percent",
+		},
+			rollupLabels),
+		gpuRollupGfxActivityMax: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_partition_rollup_gfx_activity_max",
+			Help: "Max graphics engine usage of the partitions of the physical GPU in percent",
+		},
+			rollupLabels),
+		gpuRollupPackagePower: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "gpu_partition_rollup_package_power",
+			Help: "Socket power of the physical GPU in Watts",
+		},
+			rollupLabels),
 	}
 	ga.initFieldMetricsMap()
 
@@ -1757,6 +1993,11 @@ func (ga *GPUAgentGPUClient) initFieldRegistration() error {
 			logger.Log.Printf("Field %v registration failed with err : %v", field, err)
 		}
 	}
+	if ga.infoMetrics {
+		if err := ga.gpuHandler.mh.RegisterMetric(ga.metrics.gpuInfo); err != nil {
+			logger.Log.Printf("gpu_info registration failed with err : %v", err)
+		}
+	}
 
 	return nil
 }
@@ -1770,9 +2011,14 @@ func (ga *GPUAgentGPUClient) InitConfigs() error {
 	ga.initLabelConfigs(filedConfigs)
 	ga.initFieldConfig(filedConfigs)
 	ga.InitPodExtraLabels(filedConfigs)
+	ga.initWorkloadLabels(filedConfigs)
+	ga.initProcessMetrics(filedConfigs)
 	ga.initProfilerMetrics(filedConfigs)
 	ga.initAfidMetrics(filedConfigs)
 	ga.initGPUSelectorConfig(filedConfigs)
+	ga.initSysfsCollector(filedConfigs)
+	ga.initInfoMetrics(filedConfigs)
+	ga.initPartitionRollup(filedConfigs)
 	ga.initPrometheusMetrics()
 	ga.initProfilerMetricsField()
 	return ga.initFieldRegistration()
@@ -1806,6 +2052,24 @@ func getPCIeBusID(gpu *amdgpu.GPU) string {
 	return ""
 }
 
+// getTopologyAddr returns the pcie address of the GPU device in sysfs,
+// partitions are mapped to function 0 of the GPU
+func getTopologyAddr(gpu *amdgpu.GPU) string {
+	addr := getPCIeBusID(gpu)
+	if addr == "" {
+		return ""
+	}
+	return utils.GetPCIeBaseAddress(addr) + ".0"
+}
+
+// getAffinityNIC returns the pcie bus id of the NIC closest to the GPU
+func getAffinityNIC(gpu *amdgpu.GPU) string {
+	if nic := topology.Closest(topology.GPU, getTopologyAddr(gpu)); nic != nil {
+		return nic.PCIeAddr
+	}
+	return ""
+}
+
 func getGPUInstanceID(gpu *amdgpu.GPU) int {
 	return int(gpu.Status.Index)
 }
@@ -1825,15 +2089,21 @@ func getGPUUUID(gpu *amdgpu.GPU) string {
 }
 
 func (ga *GPUAgentGPUClient) UpdateStaticMetrics(ctx context.Context) error {
+	if ga.sysfsCollector == globals.SysfsCollectorPrimary {
+		return ga.updateSysfsMetrics()
+	}
 	// send the req to gpuclient
 	resp, partitionMap, err := ga.getGPUs()
+	if err == nil && resp != nil && resp.ApiStatus != 0 {
+		logger.Log.Printf("resp status :%v", resp.ApiStatus)
+		err = fmt.Errorf("%v", resp.ApiStatus)
+	}
+	if ga.fallbackToSysfs(err) {
+		return ga.updateSysfsMetrics()
+	}
 	if err != nil {
 		return err
 	}
-	if resp != nil && resp.ApiStatus != 0 {
-		logger.Log.Printf("resp status :%v", resp.ApiStatus)
-		return fmt.Errorf("%v", resp.ApiStatus)
-	}
 	ga.initGPUMetadata(resp.Response)
 	wls, err := ga.gpuHandler.ListWorkloads()
 	if err != nil {
@@ -1848,6 +2118,7 @@ func (ga *GPUAgentGPUClient) UpdateStaticMetrics(ctx context.Context) error {
 	ga.k8PodInfoMap, _ = ga.FetchPodInfoForNode()
 	nonGpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
 	ga.metrics.gpuNodesTotal.With(nonGpuLabels).Set(float64(len(resp.Response)))
+	ga.updateProfilerSampleAge()
 	// do this only once as the health monitoring thread will
 	// update periodically. this is required only for first state
 	// of the metrics pull response from prometheus
@@ -1863,6 +2134,8 @@ func (ga *GPUAgentGPUClient) UpdateStaticMetrics(ctx context.Context) error {
 		}
 		ga.updateGPUInfoToMetrics(wls, gpu, partitionMap, gpuProfMetrics, nil)
 	}
+	ga.updateGPUSettingsEvents(resp.Response)
+	ga.updatePartitionRollupMetrics(resp.Response)
 	ga.fl.SetFilterDone()
 	return nil
 }
@@ -1949,12 +2222,30 @@ func (ga *GPUAgentGPUClient) getWorkloadInfo(wls map[string]scheduler.Workload,
 	return associatedWorkloads
 }
 
+// populateLabelsFromGPU returns the labels of the gpu series, the identity
+// labels are left to gpu_info in info metric mode
 func (ga *GPUAgentGPUClient) populateLabelsFromGPU(
+	wls map[string]scheduler.Workload,
+	gpu *amdgpu.GPU,
+	partitionMap map[string]*amdgpu.GPU) map[string]string {
+	labels := ga.populateAllLabelsFromGPU(wls, gpu, partitionMap)
+	if ga.infoMetrics {
+		for _, name := range gpuInfoLabels {
+			delete(labels, strings.ToLower(name))
+		}
+	}
+	return labels
+}
+
+// populateAllLabelsFromGPU returns all the enabled labels of the gpu
+func (ga *GPUAgentGPUClient) populateAllLabelsFromGPU(
 	wls map[string]scheduler.Workload,
 	gpu *amdgpu.GPU,
 	partitionMap map[string]*amdgpu.GPU) map[string]string {
 	var podInfo scheduler.PodResourceInfo
 	var jobInfo scheduler.JobInfo
+	var workloadInfo scheduler.WorkloadInfo
+	var containerInfo scheduler.ContainerInfo
 
 	if jobInfos := ga.getWorkloadInfo(wls, getGPUInstanceIDString(gpu)); jobInfos != nil {
 		for _, wl := range jobInfos {
@@ -1964,8 +2255,12 @@ func (ga *GPUAgentGPUClient) populateLabelsFromGPU(
 			switch wl.Type {
 			case scheduler.Kubernetes:
 				podInfo = wl.Info.(scheduler.PodResourceInfo)
-			case scheduler.Slurm:
+			case scheduler.Slurm, scheduler.Flux, scheduler.PBS:
 				jobInfo = wl.Info.(scheduler.JobInfo)
+			case scheduler.Generic:
+				workloadInfo = wl.Info.(scheduler.WorkloadInfo)
+			case scheduler.Container:
+				containerInfo = wl.Info.(scheduler.ContainerInfo)
 			}
 		}
 	}
@@ -2048,6 +2343,17 @@ func (ga *GPUAgentGPUClient) populateLabelsFromGPU(
 		case exportermetrics.MetricLabel_CONTAINER.String():
 			if gpu != nil {
 				labels[key] = podInfo.Container
+				if labels[key] == "" {
+					labels[key] = containerInfo.Name
+				}
+			}
+		case exportermetrics.GPUMetricLabel_CONTAINER_IMAGE.String():
+			if gpu != nil {
+				labels[key] = containerInfo.Image
+			}
+		case exportermetrics.GPUMetricLabel_COMPOSE_PROJECT.String():
+			if gpu != nil {
+				labels[key] = containerInfo.Project
 			}
 		case exportermetrics.MetricLabel_POD_UUID.String():
 			if gpu != nil {
@@ -2120,6 +2426,10 @@ func (ga *GPUAgentGPUClient) populateLabelsFromGPU(
 					labels[key] = ""
 				}
 			}
+		case exportermetrics.GPUMetricLabel_AFFINITY_NIC.String():
+			if gpu != nil {
+				labels[key] = getAffinityNIC(gpu)
+			}
 		case exportermetrics.GPUMetricLabel_DEPLOYMENT_MODE.String():
 			if gpu != nil {
 				virtualizationMode := gpu.Status.VirtualizationMode
@@ -2133,17 +2443,28 @@ func (ga *GPUAgentGPUClient) populateLabelsFromGPU(
 
 	// Add extra pod labels only if config has mapped any
 	if gpu != nil && len(ga.extraPodLabelsMap) > 0 {
-		podLabels := utils.GetPodLabels(&podInfo, ga.k8PodInfoMap)
+		podLabels := utils.GetPodMetadata(&podInfo, ga.k8PodInfoMap)
 		for prometheusPodlabel, k8Podlabel := range ga.extraPodLabelsMap {
 			label := strings.ToLower(prometheusPodlabel)
 			labels[label] = podLabels[k8Podlabel]
 		}
 	}
 
+	// Add generic workload labels only if config has mapped any
+	if gpu != nil {
+		for label, key := range ga.extraWorkloadLabels {
+			labels[label] = workloadInfo.Labels[key]
+		}
+	}
+
 	// Add custom labels
 	for label, value := range ga.customLabelMap {
 		labels[label] = value
 	}
+
+	if gpu != nil && ga.isSysfsCollectorEnabled() {
+		labels[sourceLabel] = sourceGPUAgent
+	}
 	return labels
 }
 
@@ -2181,6 +2502,8 @@ func (ga *GPUAgentGPUClient) updateGPUInfoToMetrics(
 			ga.metrics.gpuHealth.With(labels).Set(0)
 		}
 	}
+	ga.updateGPUSettingsMetrics(gpu, labels)
+	ga.updateGPUInfoMetric(gpu, partitionMap)
 	gpuuuid := getGPUUUID(gpu)
 
 	if !utils.IsNonZeroValue(stats.PackagePower) {
@@ -2739,20 +3062,10 @@ func (ga *GPUAgentGPUClient) updateGPUInfoToMetrics(
 		}
 	}
 
-	processStatus := status.GetProcessStatus()
-	if processStatus != nil && len(processStatus.GetProcessInfo()) != 0 {
-		// populate process info metrics if available and valid
-		for _, procInfo := range processStatus.GetProcessInfo() {
-			pid := procInfo.GetPId()
-			val := procInfo.GetCUOccupancy()
-			if pid == 0 || !utils.IsValueApplicable(val) {
-				continue
-			}
-			labelsWithIndex["process_id"] = fmt.Sprintf("%v", pid)
-			ga.metrics.gpuProcessCuOcc.With(labelsWithIndex).Set(float64(val))
-		}
-		delete(labelsWithIndex, "process_id")
-	}
+	// populate process info metrics if available and valid
+	ga.updateProcessMetrics(wls, gpu, labels)
+
+	ga.updateProfilerSuppressed(gpu, labels)
 
 	// populate prof metrics if available
 	if profMetrics == nil {
@@ -2881,6 +3194,12 @@ func (ga *GPUAgentGPUClient) updateGPUInfoToMetrics(
 			ga.metrics.gpuMeanOccPerCU.With(labels).Set(value)
 		case "SIMD_UTILIZATION":
 			ga.metrics.gpuSimdActive.With(labels).Set(utils.NormalizeFraction(value))
+		case rocprofiler.ArithmeticIntensity:
+			ga.metrics.gpuArithIntensity.With(labels).Set(value)
+		case rocprofiler.AchievedTFLOPs:
+			ga.metrics.gpuAchievedTFLOPs.With(labels).Set(value)
+		case rocprofiler.L2HitRate:
+			ga.metrics.gpuL2HitRate.With(labels).Set(utils.NormalizePercent(value))
 		}
 	}
 
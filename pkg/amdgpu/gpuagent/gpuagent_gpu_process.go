@@ -0,0 +1,224 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+const (
+	// kfd sysfs paths relative to the sysfs root
+	kfdTopologyNodesPath = "class/kfd/kfd/topology/nodes"
+	kfdProcPath          = "class/kfd/kfd/proc"
+)
+
+// per process metric labels, prepended to the gpu labels
+var processLabels = []string{
+	"process_id",
+	"process_name",
+	"process_pod",
+	"process_namespace",
+	"process_container",
+}
+
+// gpuProcess is a process running on a gpu along with its usage
+type gpuProcess struct {
+	pid       uint32
+	cuOcc     float64
+	cuOccOk   bool
+	vramUsed  float64
+	vramOk    bool
+	name      string
+	pod       string
+	namespace string
+	container string
+}
+
+func (ga *GPUAgentGPUClient) initProcessMetrics(config *exportermetrics.GPUMetricConfig) {
+	ga.processTopN = globals.DefaultProcessMetricsTopN
+	if config != nil && config.GetProcessMetricsTopN() != 0 {
+		ga.processTopN = int(config.GetProcessMetricsTopN())
+	}
+	// a negative top-n exports all the processes
+	if ga.processTopN < 0 {
+		logger.Log.Printf("process metrics top-n disabled, all processes are exported")
+		return
+	}
+	logger.Log.Printf("process metrics top-n set to %v", ga.processTopN)
+}
+
+// kfdGPUId returns the kfd gpu_id of the topology node, kfd per process
+// files are suffixed with it
+func (ga *GPUAgentGPUClient) kfdGPUId(nodeId string) string {
+	data, err := os.ReadFile(path.Join(ga.sysRoot, kfdTopologyNodesPath, nodeId, "gpu_id"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// processVRAMUsed returns the vram allocated by the process on the gpu
+func (ga *GPUAgentGPUClient) processVRAMUsed(pid uint32, kfdGPUId string) (float64, bool) {
+	if kfdGPUId == "" {
+		return 0, false
+	}
+	data, err := os.ReadFile(path.Join(ga.sysRoot, kfdProcPath, fmt.Sprintf("%v", pid), "vram_"+kfdGPUId))
+	if err != nil {
+		return 0, false
+	}
+	val, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	// kfd reports bytes, exported in MB as the gpu vram metrics
+	return float64(val) / (1024 * 1024), true
+}
+
+// podByUID looks up the pod name and namespace from the pod uid, the pod
+// list is fetched once per scrape when not already populated for pod labels
+func (ga *GPUAgentGPUClient) podByUID(uid string) (string, string) {
+	if len(ga.k8PodInfoMap) == 0 && ga.gpuHandler != nil && ga.gpuHandler.enabledK8sApi {
+		if k8sClient := ga.gpuHandler.GetK8sApiClient(); k8sClient != nil {
+			if pods, err := k8sClient.GetAllPods(); err == nil {
+				ga.k8PodInfoMap = pods
+			}
+		}
+	}
+	for _, pod := range ga.k8PodInfoMap {
+		if pod.UID == uid {
+			return pod.Name, pod.Namespace
+		}
+	}
+	return "", ""
+}
+
+// containerName resolves a container id to the name known by the container
+// scheduler client, falls back to the short id
+func containerName(wls map[string]scheduler.Workload, id string) string {
+	for _, wl := range wls {
+		if wl.Type != scheduler.Container {
+			continue
+		}
+		info, ok := wl.Info.(scheduler.ContainerInfo)
+		if !ok {
+			continue
+		}
+		ids := strings.Split(info.Id, ",")
+		names := strings.Split(info.Name, ",")
+		for i, cid := range ids {
+			if cid == id && i < len(names) {
+				return names[i]
+			}
+		}
+	}
+	if len(id) > 12 {
+		return id[:12]
+	}
+	return id
+}
+
+// getGPUProcesses returns the top-n processes on the gpu by cu occupancy
+// and vram usage along with the owning pod or container
+func (ga *GPUAgentGPUClient) getGPUProcesses(wls map[string]scheduler.Workload, gpu *amdgpu.GPU) []gpuProcess {
+	procInfos := gpu.GetStatus().GetProcessStatus().GetProcessInfo()
+	if len(procInfos) == 0 {
+		return nil
+	}
+	kfdGPUId := ""
+	if ga.exportFieldMap[exportermetrics.GPUMetricField_GPU_PROCESS_USED_VRAM.String()] {
+		kfdGPUId = ga.kfdGPUId(getGPUNodeId(gpu))
+	}
+	procs := []gpuProcess{}
+	for _, procInfo := range procInfos {
+		pid := procInfo.GetPId()
+		if pid == 0 {
+			continue
+		}
+		proc := gpuProcess{pid: pid}
+		if val := procInfo.GetCUOccupancy(); utils.IsValueApplicable(val) {
+			proc.cuOcc, proc.cuOccOk = float64(val), true
+		}
+		proc.vramUsed, proc.vramOk = ga.processVRAMUsed(pid, kfdGPUId)
+		if !proc.cuOccOk && !proc.vramOk {
+			continue
+		}
+		procs = append(procs, proc)
+	}
+
+	sort.Slice(procs, func(i, j int) bool {
+		if procs[i].cuOcc != procs[j].cuOcc {
+			return procs[i].cuOcc > procs[j].cuOcc
+		}
+		if procs[i].vramUsed != procs[j].vramUsed {
+			return procs[i].vramUsed > procs[j].vramUsed
+		}
+		return procs[i].pid < procs[j].pid
+	})
+	if ga.processTopN > 0 && len(procs) > ga.processTopN {
+		procs = procs[:ga.processTopN]
+	}
+
+	// resolve names and owners only for the exported processes
+	for i := range procs {
+		procs[i].name = utils.GetProcessName(ga.procRoot, procs[i].pid)
+		owner := utils.GetProcessOwner(ga.procRoot, procs[i].pid)
+		if owner.PodUID != "" {
+			procs[i].pod, procs[i].namespace = ga.podByUID(owner.PodUID)
+		}
+		if owner.ContainerID != "" {
+			procs[i].container = containerName(wls, owner.ContainerID)
+		}
+	}
+	return procs
+}
+
+// updateProcessMetrics exports the per process metrics of the gpu
+func (ga *GPUAgentGPUClient) updateProcessMetrics(wls map[string]scheduler.Workload, gpu *amdgpu.GPU, labels map[string]string) {
+	if !ga.exportFieldMap[exportermetrics.GPUMetricField_GPU_PROCESS_CU_OCCUPANCY.String()] &&
+		!ga.exportFieldMap[exportermetrics.GPUMetricField_GPU_PROCESS_USED_VRAM.String()] {
+		return
+	}
+	procLabels := make(map[string]string, len(labels)+len(processLabels))
+	for k, v := range labels {
+		procLabels[k] = v
+	}
+	for _, proc := range ga.getGPUProcesses(wls, gpu) {
+		procLabels["process_id"] = fmt.Sprintf("%v", proc.pid)
+		procLabels["process_name"] = proc.name
+		procLabels["process_pod"] = proc.pod
+		procLabels["process_namespace"] = proc.namespace
+		procLabels["process_container"] = proc.container
+		if proc.cuOccOk {
+			ga.metrics.gpuProcessCuOcc.With(procLabels).Set(proc.cuOcc)
+		}
+		if proc.vramOk {
+			ga.metrics.gpuProcessUsedVram.With(procLabels).Set(proc.vramUsed)
+		}
+	}
+}
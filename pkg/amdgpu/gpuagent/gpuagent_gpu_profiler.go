@@ -0,0 +1,235 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/rocprofiler"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+	"github.com/ROCm/device-metrics-exporter/pkg/types"
+)
+
+// reasons of the profiler suppression of a GPU
+const (
+	profilerSuppressedPodAnnotation = "pod_annotation"
+	profilerSuppressedPodLabel      = "pod_label"
+	profilerSuppressedJobEnv        = "job_env"
+)
+
+// initProfilerScheduler applies the scheduler config, the config is nil when
+// the scheduler is disabled
+func (ga *GPUAgentGPUClient) initProfilerScheduler(config *exportermetrics.ProfilerSchedulerConfig) {
+	ga.profilerSched.Store(config)
+	if config == nil {
+		ga.rocpclient.SetScheduler(nil)
+		return
+	}
+	// durations are validated by the config handler
+	period, _ := time.ParseDuration(config.GetPeriod())
+	maxBackoff, _ := time.ParseDuration(config.GetMaxBackoff())
+	ga.rocpclient.SetScheduler(&rocprofiler.SchedulerConfig{
+		Period:     period,
+		DutyCycle:  config.GetDutyCycle(),
+		MaxBackoff: maxBackoff,
+	})
+}
+
+// startProfilerScheduler samples the profiler in the background while the
+// scheduler is enabled
+func (ga *GPUAgentGPUClient) startProfilerScheduler(ctx context.Context) {
+	if ga.rocpclient == nil || !ga.enableProfileMetrics {
+		return
+	}
+	ga.rocpclient.StartScheduler(ctx, ga.profilerSkipReason)
+}
+
+// profilerSkipReason returns why the next scheduled profiler sample should be
+// skipped, empty to take it
+func (ga *GPUAgentGPUClient) profilerSkipReason() string {
+	config := ga.profilerSched.Load()
+	if config == nil {
+		return ""
+	}
+	if ga.updateProfilerSuppression() {
+		return rocprofiler.SkipOptOut
+	}
+	if config.GetSkipIdle() && ga.isGPUIdle() {
+		return rocprofiler.SkipIdle
+	}
+	return ""
+}
+
+// initProfilerOptOut applies the opt out config, the annotation of the
+// scheduler config is an opt out pod annotation
+func (ga *GPUAgentGPUClient) initProfilerOptOut(config *exportermetrics.ProfilerConfig) {
+	optOut := &exportermetrics.ProfilerOptOutConfig{}
+	if c := config.GetOptOut(); c != nil {
+		optOut.PodAnnotations = append(optOut.PodAnnotations, c.GetPodAnnotations()...)
+		optOut.PodLabels = append(optOut.PodLabels, c.GetPodLabels()...)
+		optOut.SlurmJobEnv = c.GetSlurmJobEnv()
+	}
+	if annotation := config.GetScheduler().GetOptOutAnnotation(); annotation != "" &&
+		!slices.Contains(optOut.PodAnnotations, annotation) {
+		optOut.PodAnnotations = append(optOut.PodAnnotations, annotation)
+	}
+	if len(optOut.PodAnnotations) == 0 && len(optOut.PodLabels) == 0 && !optOut.SlurmJobEnv {
+		optOut = nil
+	}
+	ga.profilerOptOut.Store(optOut)
+	if ga.rocpclient != nil && optOut == nil {
+		ga.rocpclient.SetSuppressedDevices(nil)
+	}
+}
+
+// updateProfilerSuppression excludes the GPUs of opted out workloads from
+// profiling, returns true when all the GPUs are suppressed
+func (ga *GPUAgentGPUClient) updateProfilerSuppression() bool {
+	if ga.profilerOptOut.Load() == nil {
+		return false
+	}
+	gpuIDMap := ga.gpuIDMapSnapshot()
+	suppressed := ga.profilerSuppression(gpuIDMap)
+	ga.rocpclient.SetSuppressedDevices(suppressed)
+	return len(suppressed) != 0 && len(suppressed) >= len(gpuIDMap)
+}
+
+// profilerSuppression returns the suppression reason of the GPUs held by
+// opted out workloads keyed by DRM render id
+func (ga *GPUAgentGPUClient) profilerSuppression(gpuIDMap map[string]GPUIDMeta) map[string]string {
+	optOut := ga.profilerOptOut.Load()
+	if optOut == nil || ga.gpuHandler == nil {
+		return nil
+	}
+	wls, _ := ga.gpuHandler.ListWorkloads()
+	if len(wls) == 0 {
+		return nil
+	}
+	var pods map[string]types.K8sPodInfo
+	getPods := func() map[string]types.K8sPodInfo {
+		if pods == nil && ga.gpuHandler.enabledK8sApi {
+			if k8sClient := ga.gpuHandler.GetK8sApiClient(); k8sClient != nil {
+				pods, _ = k8sClient.GetAllPods()
+			}
+		}
+		return pods
+	}
+	suppressed := map[string]string{}
+	for gpuID, meta := range gpuIDMap {
+		for _, wl := range ga.getWorkloadInfo(wls, gpuID) {
+			if reason := workloadOptOutReason(wl, optOut, getPods); reason != "" {
+				suppressed[meta.RenderID] = reason
+				break
+			}
+		}
+	}
+	return suppressed
+}
+
+// workloadOptOutReason returns the suppression reason when the workload opts
+// out of profiling
+func workloadOptOutReason(wl *scheduler.Workload, optOut *exportermetrics.ProfilerOptOutConfig,
+	getPods func() map[string]types.K8sPodInfo) string {
+	switch wl.Type {
+	case scheduler.Kubernetes:
+		podInfo, ok := wl.Info.(scheduler.PodResourceInfo)
+		if !ok || (len(optOut.GetPodAnnotations()) == 0 && len(optOut.GetPodLabels()) == 0) {
+			return ""
+		}
+		key := types.PodUniqueKey{PodName: podInfo.Pod, Namespace: podInfo.Namespace}
+		pod, ok := getPods()[key.String()]
+		if !ok {
+			return ""
+		}
+		for _, annotation := range optOut.GetPodAnnotations() {
+			if strings.EqualFold(pod.Annotations[annotation], "true") {
+				return profilerSuppressedPodAnnotation
+			}
+		}
+		for _, label := range optOut.GetPodLabels() {
+			if strings.EqualFold(pod.Labels[label], "true") {
+				return profilerSuppressedPodLabel
+			}
+		}
+	case scheduler.Slurm:
+		if jobInfo, ok := wl.Info.(scheduler.JobInfo); ok && optOut.GetSlurmJobEnv() && jobInfo.ProfilerOptOut {
+			return profilerSuppressedJobEnv
+		}
+	}
+	return ""
+}
+
+// isGPUIdle returns true when the latest GPU read reports no graphics
+// activity on any GPU, unknown activity is not idle
+func (ga *GPUAgentGPUClient) isGPUIdle() bool {
+	ga.gCache.RLock()
+	defer ga.gCache.RUnlock()
+	resp := ga.gCache.lastResponse
+	if resp == nil || len(resp.Response) == 0 {
+		return false
+	}
+	for _, gpu := range resp.Response {
+		usage := gpu.GetStats().GetUsage()
+		if usage == nil || !utils.IsValueApplicable(usage.GetGFXActivity()) || usage.GetGFXActivity() > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// updateProfilerSampleAge exports the age of the profiler sample
+func (ga *GPUAgentGPUClient) updateProfilerSampleAge() {
+	if !ga.isProfilerEnabled() {
+		return
+	}
+	if !ga.exportFieldMap[exportermetrics.GPUMetricField_GPU_PROF_SAMPLE_AGE.String()] {
+		return
+	}
+	age, ok := ga.rocpclient.SampleAge()
+	if !ok {
+		return
+	}
+	labels := ga.populateLabelsFromGPU(nil, nil, nil)
+	ga.metrics.gpuProfSampleAge.With(labels).Set(age.Seconds())
+}
+
+// updateProfilerSuppressed exports the suppression reason of a GPU excluded
+// from profiling
+func (ga *GPUAgentGPUClient) updateProfilerSuppressed(gpu *amdgpu.GPU, labels map[string]string) {
+	if !ga.isProfilerEnabled() {
+		return
+	}
+	if !ga.exportFieldMap[exportermetrics.GPUMetricField_GPU_PROF_SUPPRESSED.String()] {
+		return
+	}
+	reason, ok := ga.rocpclient.SuppressedReason(getGPURenderId(gpu))
+	if !ok {
+		return
+	}
+	infoLabels := make(map[string]string, len(labels)+1)
+	for k, v := range labels {
+		infoLabels[k] = v
+	}
+	infoLabels["reason"] = reason
+	ga.metrics.gpuProfSuppressed.With(infoLabels).Set(1)
+}
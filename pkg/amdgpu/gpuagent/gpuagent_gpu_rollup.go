@@ -0,0 +1,190 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+const (
+	rollupBusIDLabel         = "pcie_bus_id"
+	rollupPartitionModeLabel = "partition_mode"
+	partitionModeNone        = "none"
+)
+
+var partitionRollupFields = []string{
+	exportermetrics.GPUMetricField_GPU_PARTITION_ROLLUP_USED_VRAM.String(),
+	exportermetrics.GPUMetricField_GPU_PARTITION_ROLLUP_GFX_ACTIVITY_AVG.String(),
+	exportermetrics.GPUMetricField_GPU_PARTITION_ROLLUP_GFX_ACTIVITY_MAX.String(),
+	exportermetrics.GPUMetricField_GPU_PARTITION_ROLLUP_PACKAGE_POWER.String(),
+}
+
+// partitionRollup is the aggregate of the partitions of a physical gpu
+type partitionRollup struct {
+	busID       string
+	serial      string
+	mode        string
+	usedVRAM    float64
+	activitySum float64
+	activityMax float64
+	activityCnt int
+	power       float64
+	powerCnt    int
+}
+
+// initPartitionRollup disables the rollup fields unless enabled in the config,
+// should be called after initFieldConfig
+func (ga *GPUAgentGPUClient) initPartitionRollup(config *exportermetrics.GPUMetricConfig) {
+	ga.partitionRollup = config.GetPartitionRollupMetrics()
+	logger.Log.Printf("partition rollup metrics set to %v", ga.partitionRollup)
+	if ga.partitionRollup {
+		return
+	}
+	for _, field := range partitionRollupFields {
+		if _, ok := ga.exportFieldMap[field]; ok {
+			ga.exportFieldMap[field] = false
+		}
+	}
+}
+
+// getPartitionRollupLabels returns the labels of the rollup series, the
+// physical gpu is identified by its serial number and pcie base address
+func (ga *GPUAgentGPUClient) getPartitionRollupLabels() []string {
+	return append(ga.GetExporterNonGPULabels(),
+		strings.ToLower(exportermetrics.MetricLabel_SERIAL_NUMBER.String()),
+		rollupBusIDLabel,
+		rollupPartitionModeLabel)
+}
+
+// getComputePartitionMode returns the normalized compute partition type of
+// the gpu as exported in the gpu_compute_partition_type label
+func getComputePartitionMode(gpu *amdgpu.GPU) string {
+	return utils.NormalizeStringWithoutPrefix(gpu.GetSpec().GetComputePartitionType().String(), "GPU_COMPUTE_PARTITION_TYPE_")
+}
+
+// getPartitionRollups aggregates the gpus of a response per physical gpu,
+// keyed by pcie base address. A physical gpu is skipped while its partitions
+// are inconsistent, during a partition mode change the response can hold
+// partitions of both modes or miss the first partition.
+func getPartitionRollups(gpus []*amdgpu.GPU) []*partitionRollup {
+	devices := make(map[string][]*amdgpu.GPU)
+	for _, gpu := range gpus {
+		if gpu == nil || gpu.Status == nil || gpu.Status.PCIeStatus == nil {
+			continue
+		}
+		busID := utils.GetPCIeBaseAddress(strings.ToLower(gpu.Status.PCIeStatus.PCIeBusId))
+		devices[busID] = append(devices[busID], gpu)
+	}
+
+	rollups := []*partitionRollup{}
+	for busID, partitions := range devices {
+		var parent *amdgpu.GPU
+		for _, gpu := range partitions {
+			// gpus that are not partitioned report no partition id
+			if id := gpu.Status.GetPartitionId(); id == 0 || id == math.MaxUint32 {
+				parent = gpu
+				break
+			}
+		}
+		if parent == nil {
+			logger.Debugf("gpu %v first partition missing, skipping rollup", busID)
+			continue
+		}
+		r := &partitionRollup{
+			busID:  busID,
+			serial: parent.Status.SerialNum,
+			mode:   getComputePartitionMode(parent),
+		}
+		consistent := true
+		for _, gpu := range partitions {
+			// partitions other than the first may not report the mode
+			if mode := getComputePartitionMode(gpu); mode != partitionModeNone && mode != r.mode {
+				consistent = false
+				break
+			}
+			stats := gpu.Stats
+			if stats == nil {
+				continue
+			}
+			if stats.VRAMUsage != nil {
+				r.usedVRAM += utils.NormalizeUint64(stats.VRAMUsage.UsedVRAM)
+			}
+			if stats.Usage != nil && utils.IsValueApplicable(stats.Usage.GFXActivity) {
+				activity := float64(stats.Usage.GFXActivity)
+				r.activitySum += activity
+				if r.activityCnt == 0 || activity > r.activityMax {
+					r.activityMax = activity
+				}
+				r.activityCnt++
+			}
+			// power is only reported by the first partition of the gpu
+			if utils.IsNonZeroValue(stats.PackagePower) && utils.IsValueApplicable(stats.PackagePower) {
+				r.power += float64(stats.PackagePower)
+				r.powerCnt++
+			}
+		}
+		if !consistent {
+			logger.Debugf("gpu %v partitions in different modes, skipping rollup", busID)
+			continue
+		}
+		rollups = append(rollups, r)
+	}
+	sort.Slice(rollups, func(i, j int) bool { return rollups[i].busID < rollups[j].busID })
+	return rollups
+}
+
+// updatePartitionRollupMetrics exports the rollups of the physical gpus of
+// the response, the series are reset on every scrape so a partition mode
+// change replaces the series of the previous mode
+func (ga *GPUAgentGPUClient) updatePartitionRollupMetrics(gpus []*amdgpu.GPU) {
+	if !ga.partitionRollup {
+		return
+	}
+	// only the gpus exported by the selector are aggregated
+	selected := []*amdgpu.GPU{}
+	for _, gpu := range gpus {
+		if gpu != nil && gpu.Status != nil && ga.exporterEnabledGPU(getGPUInstanceID(gpu)) {
+			selected = append(selected, gpu)
+		}
+	}
+	nonGpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
+	for _, r := range getPartitionRollups(selected) {
+		labels := make(map[string]string, len(nonGpuLabels)+3)
+		for k, v := range nonGpuLabels {
+			labels[k] = v
+		}
+		labels[strings.ToLower(exportermetrics.MetricLabel_SERIAL_NUMBER.String())] = r.serial
+		labels[rollupBusIDLabel] = r.busID
+		labels[rollupPartitionModeLabel] = r.mode
+
+		ga.metrics.gpuRollupUsedVram.With(labels).Set(r.usedVRAM)
+		if r.activityCnt != 0 {
+			ga.metrics.gpuRollupGfxActivityAvg.With(labels).Set(r.activitySum / float64(r.activityCnt))
+			ga.metrics.gpuRollupGfxActivityMax.With(labels).Set(r.activityMax)
+		}
+		if r.powerCnt != 0 {
+			ga.metrics.gpuRollupPackagePower.With(labels).Set(r.power)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/fsysdevice"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/events"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+// clockRange is the configured frequency range of a clock in MHz
+type clockRange struct {
+	low  uint32
+	high uint32
+}
+
+// gpuSettings is the snapshot of the GPU settings compared between scrapes
+type gpuSettings struct {
+	firmware    map[string]string // component -> version
+	powerCaps   map[string]uint64 // cap type -> Watts
+	perfLevel   string
+	clockRanges map[string]clockRange // clock type -> range
+}
+
+// settingsChange is a GPU setting change reported as an event
+type settingsChange struct {
+	reason events.EventReason
+	msg    string
+}
+
+// getGPUSettings extracts the settings exported as metrics from the GPU,
+// unset and not applicable values are skipped
+func getGPUSettings(gpu *amdgpu.GPU) *gpuSettings {
+	settings := &gpuSettings{
+		firmware:    make(map[string]string),
+		powerCaps:   make(map[string]uint64),
+		clockRanges: make(map[string]clockRange),
+	}
+	if gpu == nil {
+		return settings
+	}
+	if gpu.Status != nil {
+		for _, fw := range gpu.Status.FirmwareVersion {
+			if fw == nil || fw.Firmware == "" || fw.Version == "" {
+				continue
+			}
+			settings.firmware[strings.ToLower(fw.Firmware)] = fw.Version
+		}
+	}
+	spec := gpu.Spec
+	if spec == nil {
+		return settings
+	}
+	for _, pcap := range spec.GPUPowerCap {
+		if pcap == nil || pcap.Type == amdgpu.GPUPowerCapType_GPU_POWER_CAP_TYPE_NONE ||
+			pcap.PowerCap == 0 || !utils.IsValueApplicable(pcap.PowerCap) {
+			continue
+		}
+		settings.powerCaps[utils.NormalizeStringWithoutPrefix(pcap.Type.String(), "GPU_POWER_CAP_TYPE_")] = pcap.PowerCap
+	}
+	if spec.PerformanceLevel != amdgpu.GPUPerformanceLevel_GPU_PERF_LEVEL_NONE {
+		settings.perfLevel = utils.NormalizeStringWithoutPrefix(spec.PerformanceLevel.String(), "GPU_PERF_LEVEL_")
+	}
+	for _, clock := range spec.ClockFrequency {
+		if clock == nil || (clock.LowFrequency == 0 && clock.HighFrequency == 0) ||
+			!utils.IsValueApplicable(clock.LowFrequency) || !utils.IsValueApplicable(clock.HighFrequency) {
+			continue
+		}
+		clockType := utils.NormalizeStringWithoutPrefix(clock.ClockType.String(), "GPU_CLOCK_TYPE_")
+		settings.clockRanges[clockType] = clockRange{low: clock.LowFrequency, high: clock.HighFrequency}
+	}
+	return settings
+}
+
+// diff returns the changes from the previous snapshot of the gpu settings,
+// sorted to report them in a stable order
+func (s *gpuSettings) diff(gpuid string, prev *gpuSettings) []settingsChange {
+	changes := []settingsChange{}
+	for _, comp := range sortedKeys(s.firmware, prev.firmware) {
+		if old, cur := prev.firmware[comp], s.firmware[comp]; old != cur {
+			changes = append(changes, settingsChange{events.GPUFirmwareChanged,
+				fmt.Sprintf("gpu %v %v firmware changed from %q to %q", gpuid, comp, old, cur)})
+		}
+	}
+	for _, capType := range sortedKeys(s.powerCaps, prev.powerCaps) {
+		if old, cur := prev.powerCaps[capType], s.powerCaps[capType]; old != cur {
+			changes = append(changes, settingsChange{events.GPUPowerCapChanged,
+				fmt.Sprintf("gpu %v %v power cap changed from %vW to %vW", gpuid, capType, old, cur)})
+		}
+	}
+	if prev.perfLevel != s.perfLevel {
+		changes = append(changes, settingsChange{events.GPUPerfLevelChanged,
+			fmt.Sprintf("gpu %v performance level changed from %q to %q", gpuid, prev.perfLevel, s.perfLevel)})
+	}
+	for _, clockType := range sortedKeys(s.clockRanges, prev.clockRanges) {
+		if old, cur := prev.clockRanges[clockType], s.clockRanges[clockType]; old != cur {
+			changes = append(changes, settingsChange{events.GPUClockRangeChanged,
+				fmt.Sprintf("gpu %v %v clock range changed from %v-%vMHz to %v-%vMHz",
+					gpuid, clockType, old.low, old.high, cur.low, cur.high)})
+		}
+	}
+	return changes
+}
+
+// sortedKeys returns the union of the keys of both maps in sorted order
+func sortedKeys[V any](a, b map[string]V) []string {
+	keys := []string{}
+	for k := range a {
+		keys = append(keys, k)
+	}
+	for k := range b {
+		if _, ok := a[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// getPowerCapRange returns the power cap limits of the gpu from hwmon, the
+// limits are fixed by the board and read once
+func (ga *GPUAgentGPUClient) getPowerCapRange(busID string) (fsysdevice.PowerCapRange, bool) {
+	ga.powerCapOnce.Do(func() {
+		ranges, err := fsysdevice.ReadPowerCapRanges(ga.sysRoot)
+		if err != nil {
+			logger.Log.Printf("power cap range not available, %v", err)
+		}
+		ga.powerCapRanges = ranges
+	})
+	capRange, ok := ga.powerCapRanges[strings.ToLower(busID)]
+	return capRange, ok
+}
+
+// updateGPUSettingsMetrics exports the firmware, power cap, performance level
+// and clock range of the gpu
+func (ga *GPUAgentGPUClient) updateGPUSettingsMetrics(gpu *amdgpu.GPU, labels map[string]string) {
+	settings := getGPUSettings(gpu)
+
+	labelsWith := func(key, value string) map[string]string {
+		l := make(map[string]string, len(labels)+1)
+		for k, v := range labels {
+			l[k] = v
+		}
+		l[key] = value
+		return l
+	}
+
+	for comp, version := range settings.firmware {
+		l := labelsWith("component", comp)
+		l["version"] = version
+		ga.metrics.gpuFirmwareInfo.With(l).Set(1)
+	}
+	for capType, powerCap := range settings.powerCaps {
+		ga.metrics.gpuPowerCap.With(labelsWith("cap_type", capType)).Set(float64(powerCap))
+	}
+	if capRange, ok := ga.getPowerCapRange(getPCIeBusID(gpu)); ok {
+		ga.metrics.gpuPowerCapMin.With(labels).Set(capRange.Min)
+		ga.metrics.gpuPowerCapMax.With(labels).Set(capRange.Max)
+	}
+	if settings.perfLevel != "" {
+		ga.metrics.gpuPerfLevelInfo.With(labelsWith("perf_level", settings.perfLevel)).Set(1)
+	}
+	for clockType, clock := range settings.clockRanges {
+		ga.metrics.gpuClockRangeMin.With(labelsWith("clock_type", clockType)).Set(float64(clock.low))
+		ga.metrics.gpuClockRangeMax.With(labelsWith("clock_type", clockType)).Set(float64(clock.high))
+	}
+}
+
+// getSettingsDevices returns the gpu reporting the settings of each physical
+// device keyed by the pcie base address, the partitions of a device share the
+// settings so only the first partition is compared
+func getSettingsDevices(gpus []*amdgpu.GPU) map[string]*amdgpu.GPU {
+	devices := make(map[string]*amdgpu.GPU)
+	for _, gpu := range gpus {
+		if gpu == nil || gpu.Status == nil {
+			continue
+		}
+		key := utils.GetPCIeBaseAddress(getPCIeBusID(gpu))
+		if key == "" {
+			key = fmt.Sprintf("%v", getGPUInstanceID(gpu))
+		}
+		// gpus that are not partitioned report no partition id
+		if id := gpu.Status.GetPartitionId(); id == 0 || id == math.MaxUint32 {
+			devices[key] = gpu
+		} else if _, ok := devices[key]; !ok {
+			devices[key] = gpu
+		}
+	}
+	return devices
+}
+
+// updateGPUSettingsEvents reports the settings changed since the last scrape
+// once per physical device, devices missing from the response are forgotten
+// and become a new baseline when they are reported again
+func (ga *GPUAgentGPUClient) updateGPUSettingsEvents(gpus []*amdgpu.GPU) {
+	selected := []*amdgpu.GPU{}
+	for _, gpu := range gpus {
+		if gpu != nil && ga.exporterEnabledGPU(getGPUInstanceID(gpu)) {
+			selected = append(selected, gpu)
+		}
+	}
+	devices := getSettingsDevices(selected)
+
+	changes := []settingsChange{}
+	ga.settingsMu.Lock()
+	for key := range ga.gpuSettings {
+		if _, ok := devices[key]; !ok {
+			delete(ga.gpuSettings, key)
+		}
+	}
+	for key, gpu := range devices {
+		settings := getGPUSettings(gpu)
+		prev, ok := ga.gpuSettings[key]
+		ga.gpuSettings[key] = settings
+		// first observation of the device is the baseline
+		if ok {
+			changes = append(changes, settings.diff(key, prev)...)
+		}
+	}
+	ga.settingsMu.Unlock()
+
+	for _, change := range changes {
+		logger.Log.Printf("%v: %v", change.reason, change.msg)
+		events.EmitWarning(ga.GetContext(), change.reason, change.msg)
+	}
+}
@@ -0,0 +1,250 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/fsysdevice"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/gofrs/uuid"
+)
+
+// source label of the GPU metrics while the sysfs collector is enabled
+const (
+	sourceLabel    = "source"
+	sourceGPUAgent = "gpuagent"
+	sourceSysfs    = "sysfs"
+)
+
+// hwmon clock labels to the gpuagent clock types
+var sysfsClockTypes = map[string]string{
+	"sclk": "system",
+	"mclk": "memory",
+}
+
+// RAS blocks named differently in sysfs
+var sysfsRASBlocks = map[string]string{
+	"pcie_bif": "BIF",
+}
+
+func (ga *GPUAgentGPUClient) initSysfsCollector(config *exportermetrics.GPUMetricConfig) {
+	name := strings.ToLower(config.GetSysfsCollector())
+	mode := globals.SysfsCollectorMode(name)
+	if name == "disabled" {
+		mode = globals.SysfsCollectorDisabled
+	}
+	if !mode.IsValid() {
+		logger.Log.Printf("invalid SysfsCollector %v, sysfs collector is disabled", config.GetSysfsCollector())
+		mode = globals.SysfsCollectorDisabled
+	}
+	if mode != ga.sysfsCollector {
+		logger.Log.Printf("sysfs collector mode updated to %q", mode)
+	}
+	ga.sysfsCollector = mode
+}
+
+func (ga *GPUAgentGPUClient) isSysfsCollectorEnabled() bool {
+	return ga.sysfsCollector != globals.SysfsCollectorDisabled
+}
+
+// fallbackToSysfs returns true if the GPU metrics are to be read from sysfs
+// on the gpuagent error
+func (ga *GPUAgentGPUClient) fallbackToSysfs(err error) bool {
+	if err == nil || ga.sysfsCollector != globals.SysfsCollectorFallback {
+		return false
+	}
+	logger.Log.Printf("gpuagent unavailable, falling back to sysfs: %v", err)
+	return true
+}
+
+// updateSysfsMetrics exports the subset of the GPU metrics available in the
+// amdgpu sysfs and hwmon attributes
+func (ga *GPUAgentGPUClient) updateSysfsMetrics() error {
+	stats, err := fsysdevice.ReadGPUSysfsStats(ga.sysRoot)
+	if err != nil {
+		return fmt.Errorf("sysfs collector failed, %v", err)
+	}
+	gpus := ga.sysfsGPUs(stats)
+	if len(ga.gpuIDMapSnapshot()) == 0 {
+		// gpuagent never responded, the workloads are associated by the
+		// sysfs ids
+		ga.initGPUMetadata(gpus)
+	}
+	wls, _ := ga.gpuHandler.ListWorkloads()
+	ga.k8PodInfoMap, err = ga.FetchPodInfoForNode()
+	if err != nil {
+		logger.Errorf("FetchPodInfoForNode failed with err : %v", err)
+	}
+
+	nonGpuLabels := ga.populateLabelsFromGPU(nil, nil, nil)
+	ga.metrics.gpuNodesTotal.With(nonGpuLabels).Set(float64(len(gpus)))
+	for i, gpu := range gpus {
+		if !ga.exporterEnabledGPU(getGPUInstanceID(gpu)) {
+			continue
+		}
+		labels := ga.populateLabelsFromGPU(wls, gpu, nil)
+		labels[sourceLabel] = sourceSysfs
+		ga.updateSysfsStatsToMetrics(labels, stats[i])
+		ga.updateGPUInfoMetric(gpu, nil)
+	}
+	return nil
+}
+
+// sysfsGPUs returns the GPUs carrying the ids used for the labels, the GPU
+// ids known from gpuagent are kept
+func (ga *GPUAgentGPUClient) sysfsGPUs(stats []*fsysdevice.GPUSysfsStats) []*amdgpu.GPU {
+	// partitions share the bus id of the GPU, the first partition is kept
+	knownIDs := map[string]GPUIDMeta{}
+	for _, meta := range ga.gpuIDMapSnapshot() {
+		busID := strings.ToLower(meta.PCIeBusId)
+		if known, ok := knownIDs[busID]; ok && gpuIDLess(known.GPUID, meta.GPUID) {
+			continue
+		}
+		knownIDs[busID] = meta
+	}
+	gpus := make([]*amdgpu.GPU, 0, len(stats))
+	for i, s := range stats {
+		gpu := &amdgpu.GPU{
+			Spec: &amdgpu.GPUSpec{},
+			Status: &amdgpu.GPUStatus{
+				Index:        uint32(i),
+				SerialNum:    s.SerialNumber,
+				CardSeries:   s.ProductName,
+				VBIOSVersion: s.VBIOSVersion,
+				PartitionId:  math.MaxUint32,
+				PCIeStatus:   &amdgpu.GPUPCIeStatus{PCIeBusId: s.PCIeBusID},
+			},
+		}
+		if id, err := strconv.ParseUint(s.CardID, 10, 32); err == nil {
+			gpu.Status.DRMCardId = uint32(id)
+		}
+		if id, err := strconv.ParseUint(s.RenderID, 10, 32); err == nil {
+			gpu.Status.DRMRenderId = uint32(id)
+		}
+		if meta, ok := knownIDs[strings.ToLower(s.PCIeBusID)]; ok {
+			if index, err := strconv.ParseUint(meta.GPUID, 10, 32); err == nil {
+				gpu.Status.Index = uint32(index)
+			}
+			if guid, err := uuid.FromString(meta.UUID); err == nil {
+				gpu.Spec.Id = guid.Bytes()
+			}
+		}
+		gpus = append(gpus, gpu)
+	}
+	return gpus
+}
+
+func (ga *GPUAgentGPUClient) updateSysfsStatsToMetrics(labels map[string]string, stats *fsysdevice.GPUSysfsStats) {
+	set := func(field string, value float64) {
+		if meta, ok := ga.fieldMetricsMap[field]; ok {
+			meta.Metric.With(labels).Set(value)
+		}
+	}
+	setValue := func(field, key string) {
+		if value, ok := stats.Values[key]; ok {
+			set(field, value)
+		}
+	}
+
+	for label, field := range map[string]exportermetrics.GPUMetricField{
+		"edge":     exportermetrics.GPUMetricField_GPU_EDGE_TEMPERATURE,
+		"junction": exportermetrics.GPUMetricField_GPU_JUNCTION_TEMPERATURE,
+		"mem":      exportermetrics.GPUMetricField_GPU_MEMORY_TEMPERATURE,
+	} {
+		if temp, ok := stats.Temperatures[label]; ok {
+			set(field.String(), temp)
+		}
+	}
+	setValue(exportermetrics.GPUMetricField_GPU_PACKAGE_POWER.String(), fsysdevice.SysfsPowerInput)
+	setValue(exportermetrics.GPUMetricField_GPU_AVERAGE_PACKAGE_POWER.String(), fsysdevice.SysfsPowerAverage)
+	setValue(exportermetrics.GPUMetricField_GPU_GFX_ACTIVITY.String(), fsysdevice.SysfsGPUBusy)
+	setValue(exportermetrics.GPUMetricField_GPU_UMC_ACTIVITY.String(), fsysdevice.SysfsMemBusy)
+	setValue(exportermetrics.GPUMetricField_PCIE_SPEED.String(), fsysdevice.SysfsPCIeSpeed)
+	setValue(exportermetrics.GPUMetricField_PCIE_MAX_SPEED.String(), fsysdevice.SysfsPCIeMaxSpeed)
+	if speed, ok := stats.Values[fsysdevice.SysfsPCIeSpeed]; ok {
+		if width, ok := stats.Values[fsysdevice.SysfsPCIeWidth]; ok {
+			// raw link rate in Mb/s, the encoding overhead is not accounted
+			set(exportermetrics.GPUMetricField_PCIE_BANDWIDTH.String(), speed*width*1000)
+		}
+	}
+
+	if powerCap, ok := stats.Values[fsysdevice.SysfsPowerCap]; ok {
+		// hwmon exposes the package power limit only
+		capLabels := make(map[string]string, len(labels)+1)
+		for k, v := range labels {
+			capLabels[k] = v
+		}
+		capLabels["cap_type"] = "ppt0"
+		ga.metrics.gpuPowerCap.With(capLabels).Set(powerCap)
+	}
+	setValue(exportermetrics.GPUMetricField_GPU_POWER_CAP_MIN.String(), fsysdevice.SysfsPowerCapMin)
+	setValue(exportermetrics.GPUMetricField_GPU_POWER_CAP_MAX.String(), fsysdevice.SysfsPowerCapMax)
+
+	if total, ok := stats.Values[fsysdevice.SysfsVRAMTotal]; ok && total != 0 {
+		used := stats.Values[fsysdevice.SysfsVRAMUsed]
+		set(exportermetrics.GPUMetricField_GPU_TOTAL_VRAM.String(), total)
+		set(exportermetrics.GPUMetricField_GPU_USED_VRAM.String(), used)
+		set(exportermetrics.GPUMetricField_GPU_FREE_VRAM.String(), total-used)
+	}
+
+	for j, clock := range stats.Clocks {
+		clockType, ok := sysfsClockTypes[clock.Label]
+		if !ok {
+			continue
+		}
+		labelsWithIndex := make(map[string]string, len(labels)+2)
+		for k, v := range labels {
+			labelsWithIndex[k] = v
+		}
+		labelsWithIndex["clock_index"] = fmt.Sprintf("%v", j)
+		labelsWithIndex["clock_type"] = clockType
+		ga.metrics.gpuClock.With(labelsWithIndex).Set(clock.Frequency)
+	}
+
+	if len(stats.RASErrors) == 0 {
+		return
+	}
+	var correctable, uncorrectable uint64
+	for block, count := range stats.RASErrors {
+		correctable += count.Correctable
+		uncorrectable += count.Uncorrectable
+		name, ok := sysfsRASBlocks[block]
+		if !ok {
+			name = strings.ToUpper(block)
+		}
+		set("GPU_ECC_CORRECT_"+name, float64(count.Correctable))
+		set("GPU_ECC_UNCORRECT_"+name, float64(count.Uncorrectable))
+	}
+	set(exportermetrics.GPUMetricField_GPU_ECC_CORRECT_TOTAL.String(), float64(correctable))
+	set(exportermetrics.GPUMetricField_GPU_ECC_UNCORRECT_TOTAL.String(), float64(uncorrectable))
+}
+
+func gpuIDLess(a, b string) bool {
+	ai, aerr := strconv.Atoi(a)
+	bi, berr := strconv.Atoi(b)
+	if aerr != nil || berr != nil {
+		return a < b
+	}
+	return ai < bi
+}
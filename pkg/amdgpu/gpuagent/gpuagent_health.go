@@ -399,7 +399,7 @@ func (ga *GPUAgentGPUClient) updateAllGPUsHealthState(healthStr string) {
 	logger.Log.Printf("fetch GPUs and set health state")
 	// If health state is not set, fetch GPUs and mark them as unhealthy
 	wls, _ := ga.gpuHandler.ListWorkloads()
-	for gpuid, gpuIdMeta := range ga.gpuIDMap {
+	for gpuid, gpuIdMeta := range ga.gpuIDMapSnapshot() {
 		workloadInfo := ga.getWorkloadsListString(wls, gpuid)
 		ga.healthState[gpuid] = &metricssvc.GPUState{
 			ID:                 gpuIdMeta.GPUID,
@@ -22,8 +22,10 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetricssvc"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
@@ -47,6 +49,11 @@ type GPUAgentIFOEClient struct {
 	fieldMetricsMap        map[string]FieldMeta
 	staticHostLabels       map[string]string
 	podInfoEnabled         bool
+	healthState            map[string]*nicmetricssvc.NICState // by station and port uuid
+	health                 *ifoeHealthEvaluator
+	nodeHealthLabellerCfg  *utils.NodeHealthLabellerConfig
+	rates                  *ifoeRateTracker
+	infoMetrics            bool // identity labels on ifoe_info only
 }
 
 func NewGPUAgentIFOEClient(gpuHandler *GPUAgentClient) (*GPUAgentIFOEClient, error) {
@@ -57,7 +64,13 @@ func NewGPUAgentIFOEClient(gpuHandler *GPUAgentClient) (*GPUAgentIFOEClient, err
 		allowedCustomLabels: []string{
 			exportermetrics.MetricLabel_CLUSTER_NAME.String(),
 		},
-		fl: gpuHandler.fl,
+		fl:          gpuHandler.fl,
+		healthState: map[string]*nicmetricssvc.NICState{},
+		health:      newIFOEHealthEvaluator(),
+		rates:       newIFOERateTracker(),
+		nodeHealthLabellerCfg: &utils.NodeHealthLabellerConfig{
+			LabelPrefix: globals.IFOEHealthLabelPrefix,
+		},
 	}
 	return ifoeClient, nil
 }
@@ -77,26 +90,11 @@ func (ga *GPUAgentIFOEClient) InitClients() error {
 	return nil
 }
 
-// GetGPUHealthStates - no op
-func (ga *GPUAgentIFOEClient) GetHealthStates() (map[string]interface{}, error) {
-	return nil, nil
-}
-
 // SetError - no op
 func (ga *GPUAgentIFOEClient) SetError(id string, fields []string, counts []uint32) error {
 	return nil
 }
 
-// processHealthValidation - no op
-func (ga *GPUAgentIFOEClient) processHealthValidation() error {
-	return nil
-}
-
-// sendNodeLabelUpdate - no op
-func (ga *GPUAgentIFOEClient) sendNodeLabelUpdate() error {
-	return nil
-}
-
 // IsActive checks if the IFOE client is active
 func (ga *GPUAgentIFOEClient) isActive() bool {
 	return ga.ualClient != nil
@@ -128,7 +126,7 @@ func (ga *GPUAgentIFOEClient) GetExporterNonIFOELabels() []string {
 func (ga *GPUAgentIFOEClient) GetExportLabels() []string {
 	labelList := []string{}
 	for key, enabled := range ga.exportLabels {
-		if !enabled {
+		if !enabled || ga.isInfoLabel(key) {
 			continue
 		}
 		labelList = append(labelList, strings.ToLower(key))
@@ -257,8 +255,14 @@ func (ga *GPUAgentIFOEClient) updateMetrics(ctx context.Context) error {
 	ga.metrics.totalDevices.With(labels).Set(float64(len(dresp.Response)))
 	ga.metrics.totalStations.With(labels).Set(float64(len(sresp.Response)))
 
+	affinities := ifoeDeviceAffinities(dresp.Response)
+	stationTotals := make(map[string]*ifoeStationTotals, len(sresp.Response))
+	seen := make(map[string]bool, len(resp.Response))
+	now := time.Now()
+
 	for _, ualPort := range resp.Response {
 		ifoeLabels := ga.populateLabelsFromObject(nil, nil, ualPort)
+		ga.updateIFOEInfoMetric(ualPort)
 		portUuid := utils.UUIDToString(ualPort.Spec.Id)
 		portName := ""
 		if ualPort.Status != nil {
@@ -277,8 +281,39 @@ func (ga *GPUAgentIFOEClient) updateMetrics(ctx context.Context) error {
 		ifoeLabels["port_name"] = portName
 		ifoeLabels["device_uuid"] = devUuid
 
+		portLabels := make(map[string]string, len(ifoeLabels)+len(ifoePortLabels))
+		for k, v := range ifoeLabels {
+			portLabels[k] = v
+		}
+		ifoeAffinityLabels(portLabels, affinities[devUuid], station, ualPort.GetStatus().GetLocalPortIndex())
+		portUp := ualPort.GetStatus().GetOperState() == amdgpu.UALPortState_UAL_PORT_STATE_ENABLED
+		ga.metrics.portOperStatus.With(portLabels).Set(ifoeStatus(portUp))
+
+		totals, ok := stationTotals[stationUuid]
+		if !ok {
+			totals = &ifoeStationTotals{}
+			stationTotals[stationUuid] = totals
+		}
+		if portUp {
+			totals.activePorts++
+		}
+
 		stats := ualPort.Stats
 		if stats != nil {
+			totals.failedoverStreams += stats.NumFailedoverStreams
+			totals.pausedStreams += stats.NumPausedStreams
+			for bin, count := range fecCodeWordBins(stats) {
+				portLabels["bin"] = fmt.Sprintf("%d", bin)
+				ga.metrics.fecCodeWordSymbolErrors.With(portLabels).Set(float64(count))
+			}
+			delete(portLabels, "bin")
+			seen[portUuid] = true
+			if rates, ok := ga.rates.update(portUuid, stats, now); ok {
+				ga.metrics.portFECCodeWordRate.With(portLabels).Set(rates.codeWords)
+				ga.metrics.portFECErroredCodeWordRate.With(portLabels).Set(rates.erroredCodeWords)
+				totals.fecErroredCodeWordRate += rates.erroredCodeWords
+			}
+
 			ga.metrics.numFailedoverStreams.With(ifoeLabels).Set(float64(stats.NumFailedoverStreams))
 			ga.metrics.numPausedStreams.With(ifoeLabels).Set(float64(stats.NumPausedStreams))
 			ga.metrics.bitErrorRate.With(ifoeLabels).Set(float64(stats.BitErrorRate))
@@ -300,5 +335,30 @@ func (ga *GPUAgentIFOEClient) updateMetrics(ctx context.Context) error {
 			ga.metrics.fecCodeWordSymbolErrors15.With(ifoeLabels).Set(float64(stats.FECCodeWordSymbolErrors15))
 		}
 	}
+	ga.rates.prune(seen)
+
+	for stationUuid, station := range ualStationMap {
+		devUuid := utils.UUIDToString(station.Spec.UALDevice)
+		stationLabels := map[string]string{
+			"station_uuid": stationUuid,
+			"station_name": station.GetStatus().GetName(),
+			"device_uuid":  devUuid,
+		}
+		for k, v := range labels {
+			stationLabels[k] = v
+		}
+		ifoeAffinityLabels(stationLabels, affinities[devUuid], station)
+		totals, ok := stationTotals[stationUuid]
+		if !ok {
+			totals = &ifoeStationTotals{}
+		}
+		linkUp := station.GetStatus().GetOperState() == amdgpu.UALLinkState_UAL_LINK_STATE_UP
+		ga.metrics.stationOperStatus.With(stationLabels).Set(ifoeStatus(linkUp))
+		ga.metrics.stationAvailableBandwidth.With(stationLabels).Set(float64(station.GetStatus().GetCurrentAvailableBandwidth()))
+		ga.metrics.stationActivePorts.With(stationLabels).Set(float64(totals.activePorts))
+		ga.metrics.stationFailedoverStreams.With(stationLabels).Set(float64(totals.failedoverStreams))
+		ga.metrics.stationPausedStreams.With(stationLabels).Set(float64(totals.pausedStreams))
+		ga.metrics.stationFECErroredCodeWordRate.With(stationLabels).Set(totals.fecErroredCodeWordRate)
+	}
 	return nil
 }
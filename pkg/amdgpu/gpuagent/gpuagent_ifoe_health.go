@@ -0,0 +1,261 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+// ifoeHealthID returns the node label compatible id of a station as
+// <accelerator id>_<station index>, and of a port of the station as
+// <accelerator id>_<station index>_<port index>
+func ifoeHealthID(acceleratorID, stationIndex uint32, portIndex ...uint32) string {
+	id := fmt.Sprintf("%d_%d", acceleratorID, stationIndex)
+	for _, idx := range portIndex {
+		id = fmt.Sprintf("%s_%d", id, idx)
+	}
+	return id
+}
+
+// fecSymbolErrors returns the codewords with at least bin symbol errors
+func fecSymbolErrors(stats *amdgpu.UALNetworkPortStats, bin uint32) uint64 {
+	bins := fecCodeWordBins(stats)
+	var total uint64
+	for i := int(bin); i < len(bins); i++ {
+		total += bins[i]
+	}
+	return total
+}
+
+func ualStateName(state string, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(state, prefix))
+}
+
+// ifoeHealthEvaluator derives the station and port health states, the FEC
+// symbol error counters of the ports are kept between the health polls
+type ifoeHealthEvaluator struct {
+	fecErrors map[string]uint64 // by port uuid
+}
+
+func newIFOEHealthEvaluator() *ifoeHealthEvaluator {
+	return &ifoeHealthEvaluator{
+		fecErrors: make(map[string]uint64),
+	}
+}
+
+// evaluate returns the health states of the stations and ports by uuid.
+// Stations and ports that are not administratively enabled are not evaluated
+// and reported as healthy.
+func (e *ifoeHealthEvaluator) evaluate(cfg *exportermetrics.IFOEHealthCheckConfig, devices []*amdgpu.UALDevice,
+	stations []*amdgpu.UALStation, ports []*amdgpu.UALNetworkPort) map[string]*nicmetricssvc.NICState {
+	healthy := strings.ToLower(nicmetricssvc.Health_HEALTHY.String())
+	unhealthy := strings.ToLower(nicmetricssvc.Health_UNHEALTHY.String())
+	fecBin := cfg.GetFECSymbolErrorBin()
+	if fecBin == 0 || fecBin > 15 {
+		fecBin = globals.DefaultIFOEFECSymbolErrorBin
+	}
+
+	acceleratorIDs := make(map[string]uint32, len(devices))
+	for _, dev := range devices {
+		acceleratorIDs[utils.UUIDToString(dev.GetSpec().GetId())] = dev.GetSpec().GetAcceleratorId()
+	}
+
+	states := make(map[string]*nicmetricssvc.NICState)
+	stationMap := make(map[string]*amdgpu.UALStation, len(stations))
+	for _, station := range stations {
+		uuid := utils.UUIDToString(station.GetSpec().GetId())
+		stationMap[uuid] = station
+		status := station.GetStatus()
+		state := &nicmetricssvc.NICState{
+			Device: ifoeHealthID(acceleratorIDs[utils.UUIDToString(station.GetSpec().GetUALDevice())], status.GetLogicalIndex()),
+			UUID:   uuid,
+			Health: healthy,
+		}
+		if station.GetSpec().GetAdminState() == amdgpu.UALStationState_UAL_STATION_STATE_ACTIVE &&
+			status.GetOperState() != amdgpu.UALLinkState_UAL_LINK_STATE_UP {
+			state.Health = unhealthy
+			state.Reason = fmt.Sprintf("station %s link %s", status.GetName(),
+				ualStateName(status.GetOperState().String(), "UAL_LINK_STATE_"))
+		}
+		states[uuid] = state
+	}
+
+	seen := make(map[string]bool, len(ports))
+	for _, port := range ports {
+		uuid := utils.UUIDToString(port.GetSpec().GetId())
+		station, ok := stationMap[utils.UUIDToString(port.GetSpec().GetUALStation())]
+		if !ok {
+			continue
+		}
+		status := port.GetStatus()
+		state := &nicmetricssvc.NICState{
+			Device: ifoeHealthID(acceleratorIDs[utils.UUIDToString(station.GetSpec().GetUALDevice())],
+				station.GetStatus().GetLogicalIndex(), status.GetLocalPortIndex()),
+			UUID:   uuid,
+			Health: healthy,
+		}
+		states[uuid] = state
+		if station.GetSpec().GetAdminState() != amdgpu.UALStationState_UAL_STATION_STATE_ACTIVE ||
+			port.GetSpec().GetAdminState() != amdgpu.UALPortState_UAL_PORT_STATE_ENABLED {
+			continue
+		}
+
+		reasons := []string{}
+		if status.GetOperState() != amdgpu.UALPortState_UAL_PORT_STATE_ENABLED {
+			reasons = append(reasons, fmt.Sprintf("port %s oper state %s", status.GetName(),
+				ualStateName(status.GetOperState().String(), "UAL_PORT_STATE_")))
+		}
+		if stats := port.GetStats(); stats != nil {
+			if th := cfg.GetBitErrorRateThreshold(); th != 0 && stats.BitErrorRate >= th {
+				reasons = append(reasons, fmt.Sprintf("bit error rate %d per 10^12 bits on %s", stats.BitErrorRate, status.GetName()))
+			}
+			if th := cfg.GetFailedoverStreamsThreshold(); th != 0 && stats.NumFailedoverStreams >= th {
+				reasons = append(reasons, fmt.Sprintf("%d failed over streams on %s", stats.NumFailedoverStreams, status.GetName()))
+			}
+			if th := cfg.GetPausedStreamsThreshold(); th != 0 && stats.NumPausedStreams >= th {
+				reasons = append(reasons, fmt.Sprintf("%d paused streams on %s", stats.NumPausedStreams, status.GetName()))
+			}
+			if th := cfg.GetFECSymbolErrorThreshold(); th != 0 {
+				cur := fecSymbolErrors(stats, fecBin)
+				// the first poll and counter resets only set the baseline
+				if prev, ok := e.fecErrors[uuid]; ok && cur >= prev && cur-prev >= th {
+					reasons = append(reasons, fmt.Sprintf("%d fec codewords with %d or more symbol errors on %s",
+						cur-prev, fecBin, status.GetName()))
+				}
+				e.fecErrors[uuid] = cur
+				seen[uuid] = true
+			}
+		}
+		if len(reasons) != 0 {
+			state.Health = unhealthy
+			state.Reason = strings.Join(reasons, "; ")
+		}
+	}
+	// drop the counters of removed or disabled ports
+	for uuid := range e.fecErrors {
+		if !seen[uuid] {
+			delete(e.fecErrors, uuid)
+		}
+	}
+	return states
+}
+
+// processHealthValidation updates the health states of the IFOE stations and
+// ports, the states are set to unknown when the UAL state is not available
+func (ga *GPUAgentIFOEClient) processHealthValidation() error {
+	if !ga.isActive() {
+		if err := ga.InitClients(); err != nil {
+			ga.setHealthStatesUnknown()
+			return err
+		}
+	}
+	ctx := ga.GetContext()
+	presp, err := ga.listNetworkPort(ctx)
+	if err != nil {
+		ga.setHealthStatesUnknown()
+		return fmt.Errorf("failed to get UAL network ports, %v", err)
+	}
+	sresp, err := ga.listStation(ctx)
+	if err != nil {
+		ga.setHealthStatesUnknown()
+		return fmt.Errorf("failed to get UAL stations, %v", err)
+	}
+	dresp, err := ga.listDevice(ctx)
+	if err != nil {
+		ga.setHealthStatesUnknown()
+		return fmt.Errorf("failed to get UAL devices, %v", err)
+	}
+	cfg := ga.gpuHandler.mh.GetIFOEMetricsConfig().GetHealthCheckConfig()
+
+	ga.Lock()
+	defer ga.Unlock()
+	ga.healthState = ga.health.evaluate(cfg, dresp.GetResponse(), sresp.GetResponse(), presp.GetResponse())
+	for uuid, hs := range ga.healthState {
+		if hs.Reason != "" {
+			logger.Debugf("IFOE %s (%s) is %s, reason: %s", hs.Device, uuid, hs.Health, hs.Reason)
+		}
+	}
+	return nil
+}
+
+func (ga *GPUAgentIFOEClient) setHealthStatesUnknown() {
+	ga.Lock()
+	defer ga.Unlock()
+	for _, hs := range ga.healthState {
+		hs.Health = strings.ToLower(nicmetricssvc.Health_UNKNOWN.String())
+		hs.Reason = ""
+	}
+}
+
+// GetHealthStates returns the health states of the IFOE stations and ports
+// by uuid
+func (ga *GPUAgentIFOEClient) GetHealthStates() (map[string]interface{}, error) {
+	ga.Lock()
+	defer ga.Unlock()
+	healthMap := make(map[string]interface{}, len(ga.healthState))
+	for uuid, hs := range ga.healthState {
+		healthMap[uuid] = &nicmetricssvc.NICState{
+			Device: hs.Device,
+			UUID:   hs.UUID,
+			Health: hs.Health,
+			Reason: hs.Reason,
+		}
+	}
+	return healthMap, nil
+}
+
+// GetNICHealthStates returns the health states for the IFOE health service,
+// which serves the NIC health service API
+func (ga *GPUAgentIFOEClient) GetNICHealthStates() (map[string]interface{}, error) {
+	return ga.GetHealthStates()
+}
+
+// sendNodeLabelUpdate sets the node labels of the stations and ports that
+// are not healthy
+func (ga *GPUAgentIFOEClient) sendNodeLabelUpdate() error {
+	if !ga.gpuHandler.enabledK8sApi {
+		return nil
+	}
+	nodeName := utils.GetNodeName()
+	if nodeName == "" {
+		logger.Errorf("error getting node name on k8s deployment, skip label update")
+		return fmt.Errorf("node name not found")
+	}
+	ifoeHealthStates := make(map[string]string)
+	ga.Lock()
+	for _, hs := range ga.healthState {
+		if hs.Health == strings.ToLower(nicmetricssvc.Health_HEALTHY.String()) {
+			continue // skip healthy state
+		}
+		ifoeHealthStates[hs.Device] = hs.Health
+	}
+	ga.Unlock()
+
+	k8sClient := ga.gpuHandler.GetK8sApiClient()
+	if k8sClient != nil {
+		_ = k8sClient.UpdateHealthLabel(ga.nodeHealthLabellerCfg, nodeName, ifoeHealthStates)
+	}
+	return nil
+}
@@ -0,0 +1,202 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+)
+
+func testUUID(s string) []byte {
+	id := uuid.NewSHA1(uuid.NameSpaceOID, []byte(s))
+	return id[:]
+}
+
+// testUALState returns one device of accelerator 2 with an active and a
+// disabled station, the active station has two ports
+func testUALState() ([]*amdgpu.UALDevice, []*amdgpu.UALStation, []*amdgpu.UALNetworkPort) {
+	devices := []*amdgpu.UALDevice{
+		{Spec: &amdgpu.UALDeviceSpec{Id: testUUID("dev"), AcceleratorId: 2}},
+	}
+	stations := []*amdgpu.UALStation{
+		{
+			Spec: &amdgpu.UALStationSpec{Id: testUUID("st0"), UALDevice: testUUID("dev"),
+				AdminState: amdgpu.UALStationState_UAL_STATION_STATE_ACTIVE},
+			Status: &amdgpu.UALStationStatus{Name: "st0", LogicalIndex: 0, OperState: amdgpu.UALLinkState_UAL_LINK_STATE_UP},
+		},
+		{
+			Spec: &amdgpu.UALStationSpec{Id: testUUID("st1"), UALDevice: testUUID("dev"),
+				AdminState: amdgpu.UALStationState_UAL_STATION_STATE_DISABLED},
+			Status: &amdgpu.UALStationStatus{Name: "st1", LogicalIndex: 1, OperState: amdgpu.UALLinkState_UAL_LINK_STATE_DOWN},
+		},
+	}
+	ports := []*amdgpu.UALNetworkPort{}
+	for i, name := range []string{"p0", "p1"} {
+		ports = append(ports, &amdgpu.UALNetworkPort{
+			Spec: &amdgpu.UALNetworkPortSpec{Id: testUUID(name), UALStation: testUUID("st0"),
+				AdminState: amdgpu.UALPortState_UAL_PORT_STATE_ENABLED},
+			Status: &amdgpu.UALNetworkPortStatus{Name: name, LocalPortIndex: uint32(i),
+				OperState: amdgpu.UALPortState_UAL_PORT_STATE_ENABLED},
+			Stats: &amdgpu.UALNetworkPortStats{},
+		})
+	}
+	// port of the disabled station
+	ports = append(ports, &amdgpu.UALNetworkPort{
+		Spec: &amdgpu.UALNetworkPortSpec{Id: testUUID("p2"), UALStation: testUUID("st1"),
+			AdminState: amdgpu.UALPortState_UAL_PORT_STATE_ENABLED},
+		Status: &amdgpu.UALNetworkPortStatus{Name: "p2", OperState: amdgpu.UALPortState_UAL_PORT_STATE_POWER_OFF},
+	})
+	return devices, stations, ports
+}
+
+func TestIFOEHealthEvaluate(t *testing.T) {
+	cfg := &exportermetrics.IFOEHealthCheckConfig{
+		BitErrorRateThreshold:      100,
+		FailedoverStreamsThreshold: 2,
+		PausedStreamsThreshold:     1,
+	}
+	st0 := uuid.Must(uuid.FromBytes(testUUID("st0"))).String()
+	p0 := uuid.Must(uuid.FromBytes(testUUID("p0"))).String()
+	p1 := uuid.Must(uuid.FromBytes(testUUID("p1"))).String()
+	p2 := uuid.Must(uuid.FromBytes(testUUID("p2"))).String()
+
+	tests := []struct {
+		name     string
+		modify   func([]*amdgpu.UALStation, []*amdgpu.UALNetworkPort)
+		cfg      *exportermetrics.IFOEHealthCheckConfig
+		expected map[string]string // reason by uuid, empty for healthy
+	}{
+		{
+			name:     "all healthy",
+			modify:   func([]*amdgpu.UALStation, []*amdgpu.UALNetworkPort) {},
+			cfg:      cfg,
+			expected: map[string]string{},
+		},
+		{
+			name: "station link down",
+			modify: func(s []*amdgpu.UALStation, _ []*amdgpu.UALNetworkPort) {
+				s[0].Status.OperState = amdgpu.UALLinkState_UAL_LINK_STATE_DOWN
+			},
+			cfg:      cfg,
+			expected: map[string]string{st0: "station st0 link down"},
+		},
+		{
+			name: "port idle and paused streams",
+			modify: func(_ []*amdgpu.UALStation, p []*amdgpu.UALNetworkPort) {
+				p[1].Status.OperState = amdgpu.UALPortState_UAL_PORT_STATE_IDLE
+				p[1].Stats.NumPausedStreams = 3
+			},
+			cfg:      cfg,
+			expected: map[string]string{p1: "port p1 oper state idle; 3 paused streams on p1"},
+		},
+		{
+			name: "bit error rate and failed over streams thresholds",
+			modify: func(_ []*amdgpu.UALStation, p []*amdgpu.UALNetworkPort) {
+				p[0].Stats.BitErrorRate = 100
+				p[0].Stats.NumFailedoverStreams = 1
+				p[1].Stats.BitErrorRate = 99
+				p[1].Stats.NumFailedoverStreams = 2
+			},
+			cfg: cfg,
+			expected: map[string]string{
+				p0: "bit error rate 100 per 10^12 bits on p0",
+				p1: "2 failed over streams on p1",
+			},
+		},
+		{
+			name: "disabled checks",
+			modify: func(_ []*amdgpu.UALStation, p []*amdgpu.UALNetworkPort) {
+				p[0].Stats.BitErrorRate = 1000
+				p[0].Stats.NumPausedStreams = 5
+			},
+			cfg:      nil,
+			expected: map[string]string{},
+		},
+		{
+			name: "admin disabled port is not evaluated",
+			modify: func(_ []*amdgpu.UALStation, p []*amdgpu.UALNetworkPort) {
+				p[0].Spec.AdminState = amdgpu.UALPortState_UAL_PORT_STATE_POWER_OFF
+				p[0].Status.OperState = amdgpu.UALPortState_UAL_PORT_STATE_POWER_OFF
+			},
+			cfg:      cfg,
+			expected: map[string]string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devices, stations, ports := testUALState()
+			tt.modify(stations, ports)
+			states := newIFOEHealthEvaluator().evaluate(tt.cfg, devices, stations, ports)
+			assert.Equal(t, 5, len(states))
+			for id, state := range states {
+				expected := tt.expected[id]
+				assert.Equal(t, expected, state.Reason, "reason of %s", state.Device)
+				if expected == "" {
+					assert.Equal(t, "healthy", state.Health)
+				} else {
+					assert.Equal(t, "unhealthy", state.Health)
+				}
+			}
+			assert.Equal(t, "2_0", states[st0].Device)
+			assert.Equal(t, "2_0_1", states[p1].Device)
+			assert.Equal(t, "2_1_0", states[p2].Device)
+		})
+	}
+}
+
+func TestIFOEHealthFECSymbolErrors(t *testing.T) {
+	cfg := &exportermetrics.IFOEHealthCheckConfig{
+		FECSymbolErrorThreshold: 10,
+		FECSymbolErrorBin:       14,
+	}
+	p0 := uuid.Must(uuid.FromBytes(testUUID("p0"))).String()
+	e := newIFOEHealthEvaluator()
+
+	polls := []struct {
+		bin13, bin14, bin15 uint64
+		reason              string
+	}{
+		{bin13: 100, bin14: 50, bin15: 50, reason: ""}, // baseline
+		{bin13: 200, bin14: 55, bin15: 54, reason: ""}, // 9 within the bins
+		{bin13: 200, bin14: 60, bin15: 59, reason: "10 fec codewords with 14 or more symbol errors on p0"},
+		{bin13: 0, bin14: 0, bin15: 20, reason: ""}, // counter reset
+		{bin13: 0, bin14: 0, bin15: 25, reason: ""},
+	}
+	for i, poll := range polls {
+		devices, stations, ports := testUALState()
+		ports[0].Stats.FECCodeWordSymbolErrors13 = poll.bin13
+		ports[0].Stats.FECCodeWordSymbolErrors14 = poll.bin14
+		ports[0].Stats.FECCodeWordSymbolErrors15 = poll.bin15
+		states := e.evaluate(cfg, devices, stations, ports)
+		assert.Equal(t, poll.reason, states[p0].Reason, "poll %d", i)
+	}
+	assert.Equal(t, 2, len(e.fecErrors))
+
+	// ports of disabled checks drop their history
+	devices, stations, ports := testUALState()
+	e.evaluate(nil, devices, stations, ports)
+	assert.Equal(t, 0, len(e.fecErrors))
+
+	stats := &amdgpu.UALNetworkPortStats{FECCodeWordSymbolErrors0: 1000, FECCodeWordSymbolErrors8: 3, FECCodeWordSymbolErrors15: 4}
+	assert.Equal(t, uint64(7), fecSymbolErrors(stats, 8))
+	assert.Equal(t, uint64(1007), fecSymbolErrors(stats, 0))
+}
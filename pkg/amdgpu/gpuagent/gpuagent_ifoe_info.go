@@ -0,0 +1,83 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+var (
+	// labels identifying the ifoe port series, kept on every series in info
+	// metric mode to join with ifoe_info
+	ifoeInfoJoinLabels = []string{
+		exportermetrics.MetricLabel_HOSTNAME.String(),
+		exportermetrics.GPUMetricLabel_GPU_UUID.String(),
+	}
+	// static identity labels only exported on ifoe_info in info metric mode
+	ifoeInfoLabels = []string{
+		exportermetrics.MetricLabel_SERIAL_NUMBER.String(),
+		exportermetrics.MetricLabel_CARD_SERIES.String(),
+		exportermetrics.MetricLabel_CARD_MODEL.String(),
+		exportermetrics.MetricLabel_CARD_VENDOR.String(),
+		exportermetrics.MetricLabel_DRIVER_VERSION.String(),
+		exportermetrics.MetricLabel_VBIOS_VERSION.String(),
+	}
+)
+
+func (ga *GPUAgentIFOEClient) initInfoMetrics(config *exportermetrics.IFOEMetricConfig) {
+	ga.infoMetrics = config.GetInfoMetrics()
+	logger.Log.Printf("info metric mode set to %v", ga.infoMetrics)
+}
+
+// isInfoLabel returns true if the label is only exported on ifoe_info
+func (ga *GPUAgentIFOEClient) isInfoLabel(name string) bool {
+	return ga.infoMetrics && slices.Contains(ifoeInfoLabels, strings.ToUpper(name))
+}
+
+// GetInfoLabels returns the labels of the ifoe_info series, the join labels
+// followed by the enabled identity labels and the custom labels
+func (ga *GPUAgentIFOEClient) GetInfoLabels() []string {
+	labelList := []string{}
+	for _, name := range append(slices.Clone(ifoeInfoJoinLabels), ifoeInfoLabels...) {
+		if ga.exportLabels[name] {
+			labelList = append(labelList, strings.ToLower(name))
+		}
+	}
+	for key := range ga.customLabelMap {
+		labelList = append(labelList, key)
+	}
+	return labelList
+}
+
+// updateIFOEInfoMetric exports the static identity labels of the ifoe port on
+// the ifoe_info series in info metric mode
+func (ga *GPUAgentIFOEClient) updateIFOEInfoMetric(ualPort *amdgpu.UALNetworkPort) {
+	if !ga.infoMetrics {
+		return
+	}
+	allLabels := ga.populateAllLabelsFromObject(nil, nil, ualPort)
+	labels := make(map[string]string)
+	for _, key := range ga.GetInfoLabels() {
+		labels[key] = allLabels[key]
+	}
+	ga.metrics.ifoeInfo.With(labels).Set(1)
+}
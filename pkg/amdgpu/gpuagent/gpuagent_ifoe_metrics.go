@@ -43,33 +43,45 @@ var (
 // model for IFOE metrics
 // Device->Station->NetworkPort
 type IFOEMetrics struct {
+	// static identity labels of the IFOE ports in info metric mode
+	ifoeInfo prometheus.GaugeVec
 	// IFOE network port stats
-	totalNetworkPorts         prometheus.GaugeVec
-	numFailedoverStreams      prometheus.GaugeVec
-	numPausedStreams          prometheus.GaugeVec
-	bitErrorRate              prometheus.GaugeVec
-	fecCodeWordSymbolErrors0  prometheus.GaugeVec
-	fecCodeWordSymbolErrors1  prometheus.GaugeVec
-	fecCodeWordSymbolErrors2  prometheus.GaugeVec
-	fecCodeWordSymbolErrors3  prometheus.GaugeVec
-	fecCodeWordSymbolErrors4  prometheus.GaugeVec
-	fecCodeWordSymbolErrors5  prometheus.GaugeVec
-	fecCodeWordSymbolErrors6  prometheus.GaugeVec
-	fecCodeWordSymbolErrors7  prometheus.GaugeVec
-	fecCodeWordSymbolErrors8  prometheus.GaugeVec
-	fecCodeWordSymbolErrors9  prometheus.GaugeVec
-	fecCodeWordSymbolErrors10 prometheus.GaugeVec
-	fecCodeWordSymbolErrors11 prometheus.GaugeVec
-	fecCodeWordSymbolErrors12 prometheus.GaugeVec
-	fecCodeWordSymbolErrors13 prometheus.GaugeVec
-	fecCodeWordSymbolErrors14 prometheus.GaugeVec
-	fecCodeWordSymbolErrors15 prometheus.GaugeVec
+	totalNetworkPorts          prometheus.GaugeVec
+	numFailedoverStreams       prometheus.GaugeVec
+	numPausedStreams           prometheus.GaugeVec
+	bitErrorRate               prometheus.GaugeVec
+	fecCodeWordSymbolErrors0   prometheus.GaugeVec
+	fecCodeWordSymbolErrors1   prometheus.GaugeVec
+	fecCodeWordSymbolErrors2   prometheus.GaugeVec
+	fecCodeWordSymbolErrors3   prometheus.GaugeVec
+	fecCodeWordSymbolErrors4   prometheus.GaugeVec
+	fecCodeWordSymbolErrors5   prometheus.GaugeVec
+	fecCodeWordSymbolErrors6   prometheus.GaugeVec
+	fecCodeWordSymbolErrors7   prometheus.GaugeVec
+	fecCodeWordSymbolErrors8   prometheus.GaugeVec
+	fecCodeWordSymbolErrors9   prometheus.GaugeVec
+	fecCodeWordSymbolErrors10  prometheus.GaugeVec
+	fecCodeWordSymbolErrors11  prometheus.GaugeVec
+	fecCodeWordSymbolErrors12  prometheus.GaugeVec
+	fecCodeWordSymbolErrors13  prometheus.GaugeVec
+	fecCodeWordSymbolErrors14  prometheus.GaugeVec
+	fecCodeWordSymbolErrors15  prometheus.GaugeVec
+	fecCodeWordSymbolErrors    prometheus.GaugeVec
+	portOperStatus             prometheus.GaugeVec
+	portFECCodeWordRate        prometheus.GaugeVec
+	portFECErroredCodeWordRate prometheus.GaugeVec
 
 	// IFOE device stats
 	totalDevices prometheus.GaugeVec
 
 	// IFOE station stats
-	totalStations prometheus.GaugeVec
+	totalStations                 prometheus.GaugeVec
+	stationOperStatus             prometheus.GaugeVec
+	stationAvailableBandwidth     prometheus.GaugeVec
+	stationActivePorts            prometheus.GaugeVec
+	stationFailedoverStreams      prometheus.GaugeVec
+	stationPausedStreams          prometheus.GaugeVec
+	stationFECErroredCodeWordRate prometheus.GaugeVec
 }
 
 func GetIFOEMandatoryLabels() []string {
@@ -163,6 +175,7 @@ func (ga *GPUAgentIFOEClient) initFieldConfig(config *exportermetrics.IFOEMetric
 		ga.exportFieldMap[name] = enable_default
 	}
 	if config == nil || len(config.GetFields()) == 0 {
+		ga.disableLegacyFECFields(config)
 		return
 	}
 	for _, fieldName := range config.GetFields() {
@@ -171,6 +184,7 @@ func (ga *GPUAgentIFOEClient) initFieldConfig(config *exportermetrics.IFOEMetric
 			ga.exportFieldMap[fieldName] = true
 		}
 	}
+	ga.disableLegacyFECFields(config)
 	// print disabled short list
 	for k, v := range ga.exportFieldMap {
 		if !v {
@@ -179,6 +193,21 @@ func (ga *GPUAgentIFOEClient) initFieldConfig(config *exportermetrics.IFOEMetric
 	}
 }
 
+// disableLegacyFECFields disables the per bin FEC codeword fields unless the
+// legacy FEC metrics are configured
+func (ga *GPUAgentIFOEClient) disableLegacyFECFields(config *exportermetrics.IFOEMetricConfig) {
+	if config.GetLegacyFECMetrics() {
+		return
+	}
+	for bin := 0; bin < ifoeFECBins; bin++ {
+		fieldName := fmt.Sprintf("%v%d", exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS.String(), bin)
+		if ga.exportFieldMap[fieldName] && len(config.GetFields()) != 0 {
+			logger.Log.Printf("%v field requires LegacyFECMetrics, ignoring", fieldName)
+		}
+		ga.exportFieldMap[fieldName] = false
+	}
+}
+
 func (ga *GPUAgentIFOEClient) initPrometheusMetrics() {
 	labels := ga.GetExportLabels()
 	nonIfoeLabels := ga.GetExporterNonIFOELabels()
@@ -206,6 +235,13 @@ func (ga *GPUAgentIFOEClient) initPrometheusMetrics() {
 			},
 			nonIfoeLabels,
 		),
+		ifoeInfo: *prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "ifoe_info",
+				Help: "Static identity labels of the IFOE network port, always 1",
+			},
+			ga.GetInfoLabels(),
+		),
 		numFailedoverStreams: *prometheus.NewGaugeVec(
 			prometheus.GaugeOpts{
 				Name: "ifoe_num_failedover_streams",
@@ -320,6 +356,66 @@ func (ga *GPUAgentIFOEClient) initPrometheusMetrics() {
 				Help: "Total number of FEC codewords with 15 symbol errors",
 			},
 			append([]string{"station_uuid", "port_name", "device_uuid"}, labels...)),
+		fecCodeWordSymbolErrors: *prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "ifoe_fec_codeword_symbol_errors",
+				Help: "Total number of FEC codewords with bin symbol errors",
+			},
+			append(append([]string{"bin"}, ifoePortLabels...), labels...)),
+		portOperStatus: *prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "ifoe_port_oper_status",
+				Help: "Operational status of the IFOE network port (1 = enabled, 0 = otherwise)",
+			},
+			append(append([]string{}, ifoePortLabels...), labels...)),
+		portFECCodeWordRate: *prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "ifoe_port_fec_codeword_rate",
+				Help: "FEC codewords received by the IFOE network port per second",
+			},
+			append(append([]string{}, ifoePortLabels...), labels...)),
+		portFECErroredCodeWordRate: *prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "ifoe_port_fec_errored_codeword_rate",
+				Help: "FEC codewords with symbol errors received by the IFOE network port per second",
+			},
+			append(append([]string{}, ifoePortLabels...), labels...)),
+		stationOperStatus: *prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "ifoe_station_oper_status",
+				Help: "Logical link status of the IFOE station (1 = up, 0 = otherwise)",
+			},
+			append(append([]string{}, ifoeStationLabels...), nonIfoeLabels...)),
+		stationAvailableBandwidth: *prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "ifoe_station_available_bandwidth",
+				Help: "Current available bandwidth of the IFOE station logical link in Gbits/s",
+			},
+			append(append([]string{}, ifoeStationLabels...), nonIfoeLabels...)),
+		stationActivePorts: *prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "ifoe_station_active_ports",
+				Help: "Number of operationally enabled network ports of the IFOE station",
+			},
+			append(append([]string{}, ifoeStationLabels...), nonIfoeLabels...)),
+		stationFailedoverStreams: *prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "ifoe_station_failedover_streams",
+				Help: "Number of failed over streams of the IFOE station network ports",
+			},
+			append(append([]string{}, ifoeStationLabels...), nonIfoeLabels...)),
+		stationPausedStreams: *prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "ifoe_station_paused_streams",
+				Help: "Number of paused streams of the IFOE station network ports",
+			},
+			append(append([]string{}, ifoeStationLabels...), nonIfoeLabels...)),
+		stationFECErroredCodeWordRate: *prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: "ifoe_station_fec_errored_codeword_rate",
+				Help: "FEC codewords with symbol errors received by the IFOE station network ports per second",
+			},
+			append(append([]string{}, ifoeStationLabels...), nonIfoeLabels...)),
 	}
 	ga.initFieldMetricsMap()
 }
@@ -327,28 +423,38 @@ func (ga *GPUAgentIFOEClient) initPrometheusMetrics() {
 func (ga *GPUAgentIFOEClient) initFieldMetricsMap() {
 	// nolint
 	ga.fieldMetricsMap = map[string]FieldMeta{
-		exportermetrics.IFOEMetricField_IFOE_TOTAL_DEVICES.String():                FieldMeta{Metric: ga.metrics.totalDevices},
-		exportermetrics.IFOEMetricField_IFOE_TOTAL_STATIONS.String():               FieldMeta{Metric: ga.metrics.totalStations},
-		exportermetrics.IFOEMetricField_IFOE_TOTAL_PORTS.String():                  FieldMeta{Metric: ga.metrics.totalNetworkPorts},
-		exportermetrics.IFOEMetricField_IFOE_NUMBER_FAILEDOVER_STREAMS.String():    FieldMeta{Metric: ga.metrics.numFailedoverStreams},
-		exportermetrics.IFOEMetricField_IFOE_NUMBER_PAUSED_STREAMS.String():        FieldMeta{Metric: ga.metrics.numPausedStreams},
-		exportermetrics.IFOEMetricField_IFOE_BIT_ERROR_RATE.String():               FieldMeta{Metric: ga.metrics.bitErrorRate},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS0.String():  FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors0},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS1.String():  FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors1},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS2.String():  FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors2},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS3.String():  FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors3},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS4.String():  FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors4},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS5.String():  FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors5},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS6.String():  FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors6},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS7.String():  FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors7},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS8.String():  FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors8},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS9.String():  FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors9},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS10.String(): FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors10},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS11.String(): FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors11},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS12.String(): FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors12},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS13.String(): FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors13},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS14.String(): FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors14},
-		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS15.String(): FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors15},
+		exportermetrics.IFOEMetricField_IFOE_TOTAL_DEVICES.String():                     FieldMeta{Metric: ga.metrics.totalDevices},
+		exportermetrics.IFOEMetricField_IFOE_TOTAL_STATIONS.String():                    FieldMeta{Metric: ga.metrics.totalStations},
+		exportermetrics.IFOEMetricField_IFOE_TOTAL_PORTS.String():                       FieldMeta{Metric: ga.metrics.totalNetworkPorts},
+		exportermetrics.IFOEMetricField_IFOE_NUMBER_FAILEDOVER_STREAMS.String():         FieldMeta{Metric: ga.metrics.numFailedoverStreams},
+		exportermetrics.IFOEMetricField_IFOE_NUMBER_PAUSED_STREAMS.String():             FieldMeta{Metric: ga.metrics.numPausedStreams},
+		exportermetrics.IFOEMetricField_IFOE_BIT_ERROR_RATE.String():                    FieldMeta{Metric: ga.metrics.bitErrorRate},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS0.String():       FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors0},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS1.String():       FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors1},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS2.String():       FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors2},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS3.String():       FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors3},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS4.String():       FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors4},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS5.String():       FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors5},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS6.String():       FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors6},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS7.String():       FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors7},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS8.String():       FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors8},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS9.String():       FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors9},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS10.String():      FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors10},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS11.String():      FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors11},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS12.String():      FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors12},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS13.String():      FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors13},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS14.String():      FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors14},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS15.String():      FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors15},
+		exportermetrics.IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS.String():        FieldMeta{Metric: ga.metrics.fecCodeWordSymbolErrors},
+		exportermetrics.IFOEMetricField_IFOE_PORT_OPER_STATUS.String():                  FieldMeta{Metric: ga.metrics.portOperStatus},
+		exportermetrics.IFOEMetricField_IFOE_PORT_FEC_CODEWORD_RATE.String():            FieldMeta{Metric: ga.metrics.portFECCodeWordRate},
+		exportermetrics.IFOEMetricField_IFOE_PORT_FEC_ERRORED_CODEWORD_RATE.String():    FieldMeta{Metric: ga.metrics.portFECErroredCodeWordRate},
+		exportermetrics.IFOEMetricField_IFOE_STATION_OPER_STATUS.String():               FieldMeta{Metric: ga.metrics.stationOperStatus},
+		exportermetrics.IFOEMetricField_IFOE_STATION_AVAILABLE_BANDWIDTH.String():       FieldMeta{Metric: ga.metrics.stationAvailableBandwidth},
+		exportermetrics.IFOEMetricField_IFOE_STATION_ACTIVE_PORTS.String():              FieldMeta{Metric: ga.metrics.stationActivePorts},
+		exportermetrics.IFOEMetricField_IFOE_STATION_FAILEDOVER_STREAMS.String():        FieldMeta{Metric: ga.metrics.stationFailedoverStreams},
+		exportermetrics.IFOEMetricField_IFOE_STATION_PAUSED_STREAMS.String():            FieldMeta{Metric: ga.metrics.stationPausedStreams},
+		exportermetrics.IFOEMetricField_IFOE_STATION_FEC_ERRORED_CODEWORD_RATE.String(): FieldMeta{Metric: ga.metrics.stationFECErroredCodeWordRate},
 	}
 }
 
@@ -366,6 +472,11 @@ func (ga *GPUAgentIFOEClient) initFieldRegistration() error {
 			logger.Log.Printf("Field %v registration failed with err : %v", field, err)
 		}
 	}
+	if ga.infoMetrics {
+		if err := ga.gpuHandler.mh.RegisterMetric(ga.metrics.ifoeInfo); err != nil {
+			logger.Log.Printf("ifoe_info registration failed with err : %v", err)
+		}
+	}
 
 	return nil
 }
@@ -378,6 +489,7 @@ func (ga *GPUAgentIFOEClient) InitConfigs() error {
 	ga.initLabelConfigs(filedConfigs)
 	ga.initFieldConfig(filedConfigs)
 	ga.InitPodExtraLabels(filedConfigs)
+	ga.initInfoMetrics(filedConfigs)
 	ga.initPrometheusMetrics()
 	return ga.initFieldRegistration()
 }
@@ -386,7 +498,8 @@ func (ga *GPUAgentIFOEClient) InitPodExtraLabels(config *exportermetrics.IFOEMet
 	// initialize pod labels maps
 	ga.k8PodInfoMap = make(map[string]types.K8sPodInfo)
 	if config != nil {
-		ga.extraPodLabelsMap = utils.NormalizeExtraPodLabels(config.GetExtraPodLabels())
+		ga.extraPodLabelsMap = utils.NormalizePodMetadataLabels(config.GetExtraPodLabels(),
+			config.GetExtraPodAnnotations(), config.GetExtraNamespaceLabels(), config.GetOwnerKindLabel())
 		if len(ga.extraPodLabelsMap) > 0 {
 			ga.podInfoEnabled = true
 		}
@@ -405,10 +518,26 @@ func (ga *GPUAgentIFOEClient) PopulateStaticHostLabels() error {
 	return nil
 }
 
+// populateLabelsFromObject returns the labels of the ifoe series, the
+// identity labels are left to ifoe_info in info metric mode
 func (ga *GPUAgentIFOEClient) populateLabelsFromObject(
 	wls map[string]scheduler.Workload,
 	ualStationMap map[string]*amdgpu.UALStation,
 	ualPort *amdgpu.UALNetworkPort) map[string]string {
+	labels := ga.populateAllLabelsFromObject(wls, ualStationMap, ualPort)
+	if ga.infoMetrics {
+		for _, name := range ifoeInfoLabels {
+			delete(labels, strings.ToLower(name))
+		}
+	}
+	return labels
+}
+
+// populateAllLabelsFromObject returns all the enabled labels of the ifoe port
+func (ga *GPUAgentIFOEClient) populateAllLabelsFromObject(
+	wls map[string]scheduler.Workload,
+	ualStationMap map[string]*amdgpu.UALStation,
+	ualPort *amdgpu.UALNetworkPort) map[string]string {
 
 	var podInfo scheduler.PodResourceInfo
 
@@ -486,7 +615,7 @@ func (ga *GPUAgentIFOEClient) populateLabelsFromObject(
 
 	// Add extra pod labels only if config has mapped any
 	if ualPort != nil && len(ga.extraPodLabelsMap) > 0 {
-		podLabels := utils.GetPodLabels(&podInfo, ga.k8PodInfoMap)
+		podLabels := utils.GetPodMetadata(&podInfo, ga.k8PodInfoMap)
 		for prometheusPodlabel, k8Podlabel := range ga.extraPodLabelsMap {
 			label := strings.ToLower(prometheusPodlabel)
 			labels[label] = podLabels[k8Podlabel]
@@ -505,6 +634,7 @@ func (ga *GPUAgentIFOEClient) ResetMetrics() error {
 	for _, prommetric := range ga.fieldMetricsMap {
 		prommetric.Metric.Reset()
 	}
+	ga.metrics.ifoeInfo.Reset()
 	return nil
 }
 
@@ -531,6 +661,7 @@ func (ga *GPUAgentIFOEClient) SetComputeNodeHealthState(state bool) {
 	ga.Lock()
 
 	if ga.computeNodeHealthState == state {
+		ga.Unlock()
 		return
 	}
 	logger.Log.Printf("updating compute node health from: %v, to: %v", ga.computeNodeHealthState, state)
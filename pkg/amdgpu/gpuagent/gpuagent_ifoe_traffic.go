@@ -0,0 +1,178 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+const (
+	// number of FEC symbol error bins of a UAL network port
+	ifoeFECBins = 16
+)
+
+var (
+	// labels of the per port metrics, the affinity labels correlate the port
+	// with the GPU (local accelerator) and the GPUs of its vPod (XGMI/UAL
+	// scale-up domain)
+	ifoePortLabels = []string{"station_uuid", "port_name", "device_uuid",
+		"gpu_id", "station_index", "port_index", "vpod_gpu_ids"}
+
+	// labels of the per station metrics
+	ifoeStationLabels = []string{"station_uuid", "station_name", "device_uuid",
+		"gpu_id", "station_index", "vpod_gpu_ids"}
+)
+
+// fecCodeWordBins returns the FEC codewords of the port by symbol errors,
+// index i holds the codewords with i symbol errors
+func fecCodeWordBins(stats *amdgpu.UALNetworkPortStats) [ifoeFECBins]uint64 {
+	return [ifoeFECBins]uint64{
+		stats.GetFECCodeWordSymbolErrors0(), stats.GetFECCodeWordSymbolErrors1(),
+		stats.GetFECCodeWordSymbolErrors2(), stats.GetFECCodeWordSymbolErrors3(),
+		stats.GetFECCodeWordSymbolErrors4(), stats.GetFECCodeWordSymbolErrors5(),
+		stats.GetFECCodeWordSymbolErrors6(), stats.GetFECCodeWordSymbolErrors7(),
+		stats.GetFECCodeWordSymbolErrors8(), stats.GetFECCodeWordSymbolErrors9(),
+		stats.GetFECCodeWordSymbolErrors10(), stats.GetFECCodeWordSymbolErrors11(),
+		stats.GetFECCodeWordSymbolErrors12(), stats.GetFECCodeWordSymbolErrors13(),
+		stats.GetFECCodeWordSymbolErrors14(), stats.GetFECCodeWordSymbolErrors15(),
+	}
+}
+
+// ifoeDeviceAffinity is the GPU affinity of a UAL device
+type ifoeDeviceAffinity struct {
+	gpuID      string
+	vpodGPUIDs string
+}
+
+// newIFOEDeviceAffinity returns the affinity of the device, the vPod GPUs
+// are sorted and comma separated
+func newIFOEDeviceAffinity(dev *amdgpu.UALDevice) ifoeDeviceAffinity {
+	ids := append([]uint32{}, dev.GetSpec().GetVPodAccelerators()...)
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	vpod := make([]string, 0, len(ids))
+	for _, id := range ids {
+		vpod = append(vpod, fmt.Sprintf("%d", id))
+	}
+	return ifoeDeviceAffinity{
+		gpuID:      fmt.Sprintf("%d", dev.GetSpec().GetAcceleratorId()),
+		vpodGPUIDs: strings.Join(vpod, ","),
+	}
+}
+
+// ifoePortSample is the FEC codeword sample of a port used for the rates
+type ifoePortSample struct {
+	codeWords        uint64
+	erroredCodeWords uint64
+	ts               time.Time
+}
+
+// ifoePortRates holds the FEC codeword rates of a port in codewords per second
+type ifoePortRates struct {
+	codeWords        float64
+	erroredCodeWords float64
+}
+
+// ifoeStationTotals are the port stats summed per station
+type ifoeStationTotals struct {
+	activePorts            uint32
+	failedoverStreams      uint32
+	pausedStreams          uint32
+	fecErroredCodeWordRate float64
+}
+
+// ifoeRateTracker derives the per port rates from the FEC codeword counters
+// between two polls, all received codewords are counted in one of the
+// symbol error bins so the codeword rate follows the port traffic
+type ifoeRateTracker struct {
+	samples map[string]ifoePortSample // by port uuid
+}
+
+func newIFOERateTracker() *ifoeRateTracker {
+	return &ifoeRateTracker{
+		samples: make(map[string]ifoePortSample),
+	}
+}
+
+// update records the sample of the port and returns its rates since the
+// previous sample, the first sample and counter resets return false
+func (t *ifoeRateTracker) update(uuid string, stats *amdgpu.UALNetworkPortStats, now time.Time) (ifoePortRates, bool) {
+	bins := fecCodeWordBins(stats)
+	cur := ifoePortSample{ts: now}
+	for i, count := range bins {
+		cur.codeWords += count
+		if i != 0 {
+			cur.erroredCodeWords += count
+		}
+	}
+	prev, ok := t.samples[uuid]
+	t.samples[uuid] = cur
+	if !ok {
+		return ifoePortRates{}, false
+	}
+	elapsed := cur.ts.Sub(prev.ts).Seconds()
+	if elapsed <= 0 || cur.codeWords < prev.codeWords || cur.erroredCodeWords < prev.erroredCodeWords {
+		return ifoePortRates{}, false
+	}
+	return ifoePortRates{
+		codeWords:        float64(cur.codeWords-prev.codeWords) / elapsed,
+		erroredCodeWords: float64(cur.erroredCodeWords-prev.erroredCodeWords) / elapsed,
+	}, true
+}
+
+// prune drops the samples of the ports that are no longer reported
+func (t *ifoeRateTracker) prune(ports map[string]bool) {
+	for uuid := range t.samples {
+		if !ports[uuid] {
+			delete(t.samples, uuid)
+		}
+	}
+}
+
+// ifoeAffinityLabels sets the affinity labels of a station, and of a port
+// when portIndex is set
+func ifoeAffinityLabels(labels map[string]string, affinity ifoeDeviceAffinity,
+	station *amdgpu.UALStation, portIndex ...uint32) {
+	labels["gpu_id"] = affinity.gpuID
+	labels["vpod_gpu_ids"] = affinity.vpodGPUIDs
+	labels["station_index"] = fmt.Sprintf("%d", station.GetStatus().GetLogicalIndex())
+	for _, idx := range portIndex {
+		labels["port_index"] = fmt.Sprintf("%d", idx)
+	}
+}
+
+// ifoeStatus returns the status metric value, 1 for up and 0 otherwise
+func ifoeStatus(up bool) float64 {
+	if up {
+		return 1
+	}
+	return 0
+}
+
+// ifoeDeviceAffinities returns the affinity of the devices by uuid
+func ifoeDeviceAffinities(devices []*amdgpu.UALDevice) map[string]ifoeDeviceAffinity {
+	affinities := make(map[string]ifoeDeviceAffinity, len(devices))
+	for _, dev := range devices {
+		affinities[utils.UUIDToString(dev.GetSpec().GetId())] = newIFOEDeviceAffinity(dev)
+	}
+	return affinities
+}
@@ -0,0 +1,156 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package gpuagent
+
+import (
+	"testing"
+	"time"
+
+	dto "github.com/prometheus/client_model/go"
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+func TestIFOERateTracker(t *testing.T) {
+	rt := newIFOERateTracker()
+	start := time.Unix(1000, 0)
+	stats := func(clean, errored uint64) *amdgpu.UALNetworkPortStats {
+		return &amdgpu.UALNetworkPortStats{
+			FECCodeWordSymbolErrors0: clean,
+			FECCodeWordSymbolErrors1: errored,
+		}
+	}
+
+	_, ok := rt.update("port", stats(1000, 10), start)
+	assert.Assert(t, !ok, "first sample only sets the baseline")
+
+	rates, ok := rt.update("port", stats(3000, 30), start.Add(10*time.Second))
+	assert.Assert(t, ok)
+	assert.Equal(t, rates.codeWords, 202.0)
+	assert.Equal(t, rates.erroredCodeWords, 2.0)
+
+	_, ok = rt.update("port", stats(10, 0), start.Add(20*time.Second))
+	assert.Assert(t, !ok, "counter reset only sets the baseline")
+
+	rt.prune(map[string]bool{})
+	assert.Equal(t, len(rt.samples), 0)
+}
+
+func TestIFOEAffinityLabels(t *testing.T) {
+	affinity := newIFOEDeviceAffinity(&amdgpu.UALDevice{
+		Spec: &amdgpu.UALDeviceSpec{AcceleratorId: 3, VPodAccelerators: []uint32{7, 3, 1}},
+	})
+	station := &amdgpu.UALStation{Status: &amdgpu.UALStationStatus{LogicalIndex: 2}}
+
+	labels := map[string]string{}
+	ifoeAffinityLabels(labels, affinity, station, 5)
+	assert.DeepEqual(t, labels, map[string]string{
+		"gpu_id":        "3",
+		"vpod_gpu_ids":  "1,3,7",
+		"station_index": "2",
+		"port_index":    "5",
+	})
+
+	labels = map[string]string{}
+	ifoeAffinityLabels(labels, affinity, station)
+	_, ok := labels["port_index"]
+	assert.Assert(t, !ok, "station labels have no port index")
+}
+
+func TestIFOELegacyFECFields(t *testing.T) {
+	logger.Init(true)
+	tests := []struct {
+		name   string
+		config *exportermetrics.IFOEMetricConfig
+		family bool
+		legacy bool
+	}{
+		{
+			name:   "default",
+			config: nil,
+			family: true,
+			legacy: false,
+		},
+		{
+			name:   "legacy",
+			config: &exportermetrics.IFOEMetricConfig{LegacyFECMetrics: true},
+			family: true,
+			legacy: true,
+		},
+		{
+			name: "legacy field without option",
+			config: &exportermetrics.IFOEMetricConfig{
+				Fields: []string{"IFOE_FEC_CODEWORD_SYMBOL_ERRORS0"},
+			},
+			family: false,
+			legacy: false,
+		},
+		{
+			name: "legacy field with option",
+			config: &exportermetrics.IFOEMetricConfig{
+				Fields:           []string{"IFOE_FEC_CODEWORD_SYMBOL_ERRORS0"},
+				LegacyFECMetrics: true,
+			},
+			family: false,
+			legacy: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ga := &GPUAgentIFOEClient{}
+			ga.initFieldConfig(tc.config)
+			assert.Equal(t, ga.exportFieldMap["IFOE_FEC_CODEWORD_SYMBOL_ERRORS"], tc.family)
+			assert.Equal(t, ga.exportFieldMap["IFOE_FEC_CODEWORD_SYMBOL_ERRORS0"], tc.legacy)
+		})
+	}
+}
+
+func TestIFOEInfoMetrics(t *testing.T) {
+	logger.Init(true)
+	ga := &GPUAgentIFOEClient{
+		customLabelMap:   map[string]string{},
+		staticHostLabels: map[string]string{exportermetrics.MetricLabel_HOSTNAME.String(): "node1"},
+	}
+	ga.initLabelConfigs(&exportermetrics.IFOEMetricConfig{Labels: []string{"CARD_VENDOR"}})
+	ga.initInfoMetrics(&exportermetrics.IFOEMetricConfig{InfoMetrics: true})
+	ga.initPrometheusMetrics()
+
+	assert.DeepEqual(t, ga.GetInfoLabels(), []string{"hostname", "gpu_uuid", "card_vendor"})
+	for _, label := range ga.GetExportLabels() {
+		assert.Assert(t, !ga.isInfoLabel(label), "identity label %v exported on the series", label)
+	}
+
+	port := &amdgpu.UALNetworkPort{Spec: &amdgpu.UALNetworkPortSpec{Id: []byte("0123456789abcdef")}}
+	labels := ga.populateLabelsFromObject(nil, nil, port)
+	_, ok := labels["card_vendor"]
+	assert.Assert(t, !ok, "identity label populated on the series")
+	assert.Equal(t, labels["hostname"], "node1")
+
+	ga.updateIFOEInfoMetric(port)
+	var m dto.Metric
+	gauge, err := ga.metrics.ifoeInfo.GetMetricWith(map[string]string{
+		"hostname":    "node1",
+		"gpu_uuid":    labels["gpu_uuid"],
+		"card_vendor": "AMD",
+	})
+	assert.NilError(t, err)
+	assert.NilError(t, gauge.Write(&m))
+	assert.Equal(t, m.GetGauge().GetValue(), float64(1))
+}
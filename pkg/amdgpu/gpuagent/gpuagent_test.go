@@ -20,11 +20,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
+	"os"
+	"path"
+	"slices"
 	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
 	dto "github.com/prometheus/client_model/go"
 
 	"github.com/google/uuid"
@@ -36,11 +41,14 @@ import (
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/metricssvc"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
 
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/fsysdevice"
 	amdgpu "github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/mock_gen"
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/rocprofiler"
 	"github.com/ROCm/device-metrics-exporter/pkg/events"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+	"github.com/ROCm/device-metrics-exporter/pkg/types"
 )
 
 func TestGpuAgent(t *testing.T) {
@@ -111,6 +119,40 @@ func TestGpuAgentSlurm(t *testing.T) {
 	ga.Close()
 
 }
+
+// TestGpuAgentWorkloadPrecedence verifies that a generic workload claiming a
+// GPU already held by a slurm job does not replace the slurm entry.
+func TestGpuAgentWorkloadPrecedence(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+
+	genericSchedMockCl := mock_gen.NewMockSchedulerClient(mockCtl)
+	genericSchedMockCl.EXPECT().ListWorkloads().Return(map[string]scheduler.Workload{
+		"1": {
+			Type: scheduler.Generic,
+			Info: scheduler.JobInfo{Id: "GENERIC_JOB_ID1"},
+		},
+		"2": {
+			Type: scheduler.Generic,
+			Info: scheduler.JobInfo{Id: "GENERIC_JOB_ID2"},
+		},
+	}, nil).AnyTimes()
+	genericSchedMockCl.EXPECT().Type().Return(scheduler.Generic).AnyTimes()
+	genericSchedMockCl.EXPECT().Close().Return(nil).Times(1)
+
+	ga.slurmScheduler = newSlurmMockClient()
+	ga.jobSchedulers = []scheduler.SchedulerClient{genericSchedMockCl}
+	wls, err := ga.ListWorkloads()
+	assert.Assert(t, err == nil, "expecting success workload list")
+	assert.Equal(t, len(wls), 3)
+	assert.Equal(t, wls["1"].Type, scheduler.Slurm)
+	assert.Equal(t, wls["1"].Info.(scheduler.JobInfo).Id, "SLURM_JOB_ID1")
+	assert.Equal(t, wls["2"].Type, scheduler.Generic)
+	ga.Close()
+}
+
 func TestGpuAgentK8s(t *testing.T) {
 	teardownSuite := setupTest(t)
 	defer teardownSuite(t)
@@ -565,6 +607,100 @@ func TestOccupancyElapsedCalculation(t *testing.T) {
 		got, meanOccPerActiveCU, grbmActive)
 }
 
+// TestGenericWorkloadLabels validates that ExtraWorkloadLabels are exported
+// from the generic workload attached to the gpu and empty otherwise
+func TestGenericWorkloadLabels(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	defer ga.Close()
+
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+
+	var gpuclient *GPUAgentGPUClient
+	for _, client := range ga.clients {
+		if client.GetDeviceType() == globals.GPUDevice {
+			gpuclient = client.(*GPUAgentGPUClient)
+			break
+		}
+	}
+	gpuclient.extraWorkloadLabels = map[string]string{"team": "team", "run": "experiment"}
+	gpuclient.gpuIDMap["0"] = GPUIDMeta{GPUID: "0", PCIeBusId: "pcie0"}
+	gpuclient.gpuIDMap["1"] = GPUIDMeta{GPUID: "1", PCIeBusId: "pcie1"}
+
+	labelList := gpuclient.GetExportLabels()
+	assert.Assert(t, slices.Contains(labelList, "team") && slices.Contains(labelList, "run"),
+		"workload labels missing from export labels %v", labelList)
+
+	wls := map[string]scheduler.Workload{
+		"pcie0": {
+			Type: scheduler.Generic,
+			Info: scheduler.WorkloadInfo{Name: "train.json", Labels: map[string]string{"team": "research", "experiment": "llm"}},
+		},
+	}
+	newGPU := func(index uint32, busId string) *amdgpu.GPU {
+		return &amdgpu.GPU{
+			Spec: &amdgpu.GPUSpec{Id: []byte(uuid.New().String())},
+			Status: &amdgpu.GPUStatus{
+				Index:      index,
+				PCIeStatus: &amdgpu.GPUPCIeStatus{PCIeBusId: busId},
+			},
+		}
+	}
+
+	labels := gpuclient.populateLabelsFromGPU(wls, newGPU(0, "pcie0"), nil)
+	assert.Equal(t, labels["team"], "research")
+	assert.Equal(t, labels["run"], "llm")
+
+	labels = gpuclient.populateLabelsFromGPU(wls, newGPU(1, "pcie1"), nil)
+	assert.Equal(t, labels["team"], "")
+	assert.Equal(t, labels["run"], "")
+}
+
+// TestContainerLabels validates the container name, image and compose
+// project labels from the container scheduler client
+func TestContainerLabels(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	defer ga.Close()
+	ga.enableContainerScl = true
+
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+
+	var gpuclient *GPUAgentGPUClient
+	for _, client := range ga.clients {
+		if client.GetDeviceType() == globals.GPUDevice {
+			gpuclient = client.(*GPUAgentGPUClient)
+			break
+		}
+	}
+	gpuclient.gpuIDMap["0"] = GPUIDMeta{GPUID: "0", RenderID: "128"}
+
+	labelList := gpuclient.GetExportLabels()
+	assert.Assert(t, slices.Contains(labelList, "container_image") && slices.Contains(labelList, "compose_project"),
+		"container labels missing from export labels %v", labelList)
+
+	wls := map[string]scheduler.Workload{
+		"128": {
+			Type: scheduler.Container,
+			Info: scheduler.ContainerInfo{Name: "train-worker-1", Image: "rocm/pytorch:latest", Project: "llm"},
+		},
+	}
+	gpu := &amdgpu.GPU{
+		Spec:   &amdgpu.GPUSpec{Id: []byte(uuid.New().String())},
+		Status: &amdgpu.GPUStatus{Index: 0, DRMRenderId: 128},
+	}
+	labels := gpuclient.populateLabelsFromGPU(wls, gpu, nil)
+	assert.Equal(t, labels["container"], "train-worker-1")
+	assert.Equal(t, labels["container_image"], "rocm/pytorch:latest")
+	assert.Equal(t, labels["compose_project"], "llm")
+}
+
 // TestExitOnAgentDownExitsAfterConsecutiveFailures verifies that the exit logic
 // fires after maxConsecutiveFailures consecutive failures, matching the logic in
 // StartMonitor for the processHealthValidation() failure path.
@@ -1346,3 +1482,770 @@ func TestProfilerDisabledEvent_FatalFailure(t *testing.T) {
 	assert.Assert(t, gpuclient.rocpclient.GetDisabledReason() != "",
 		"disabled reason must be set after fatal failure (crash/abort)")
 }
+
+// fakeProcRoot creates procfs and kfd sysfs entries for the given processes
+func fakeProcRoot(t *testing.T, nodeId, kfdGPUId string, procs map[uint32][3]string) (string, string) {
+	t.Helper()
+	procRoot, sysRoot := t.TempDir(), t.TempDir()
+	write := func(file, content string) {
+		assert.NilError(t, os.MkdirAll(path.Dir(file), 0755))
+		assert.NilError(t, os.WriteFile(file, []byte(content), 0644))
+	}
+	write(path.Join(sysRoot, kfdTopologyNodesPath, nodeId, "gpu_id"), kfdGPUId+"\n")
+	for pid, p := range procs {
+		// comm, cgroup, vram bytes
+		write(path.Join(procRoot, fmt.Sprintf("%v", pid), "comm"), p[0]+"\n")
+		write(path.Join(procRoot, fmt.Sprintf("%v", pid), "cgroup"), p[1])
+		if p[2] != "" {
+			write(path.Join(sysRoot, kfdProcPath, fmt.Sprintf("%v", pid), "vram_"+kfdGPUId), p[2])
+		}
+	}
+	return procRoot, sysRoot
+}
+
+func TestProcessMetrics(t *testing.T) {
+	teardownSuite := setupTest(t)
+	defer teardownSuite(t)
+
+	ga := getNewAgent(t)
+	defer ga.Close()
+
+	err := ga.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init")
+
+	var gpuclient *GPUAgentGPUClient
+	for _, client := range ga.clients {
+		if client.GetDeviceType() == globals.GPUDevice {
+			gpuclient = client.(*GPUAgentGPUClient)
+			break
+		}
+	}
+
+	cid := "7ab01c22de99ff00112233445566778899aabbccddeeff00112233445566aabb"
+	gpuclient.procRoot, gpuclient.sysRoot = fakeProcRoot(t, "2", "48512", map[uint32][3]string{
+		100: {"python3", "0::/kubepods.slice/kubepods-pod0a1b2c3d_1111_2222_3333_444455556666.slice/cri-containerd-" + cid + ".scope\n", "2147483648"},
+		200: {"vllm", "0::/system.slice/docker-" + cid + ".scope\n", "1048576"},
+		300: {"bash", "0::/user.slice\n", ""},
+	})
+	gpuclient.processTopN = 2
+	gpuclient.k8PodInfoMap = map[string]types.K8sPodInfo{
+		"train/pytorch-0": {Name: "pytorch-0", Namespace: "train", UID: "0a1b2c3d-1111-2222-3333-444455556666"},
+	}
+
+	wls := map[string]scheduler.Workload{
+		"128": {
+			Type: scheduler.Container,
+			Info: scheduler.ContainerInfo{Id: cid, Name: "infer"},
+		},
+	}
+	gpu := &amdgpu.GPU{
+		Status: &amdgpu.GPUStatus{
+			Index:  0,
+			NodeId: 2,
+			ProcessStatus: &amdgpu.GPUProcessStatus{
+				ProcessInfo: []*amdgpu.ProcessInfo{
+					{PId: 100, CUOccupancy: 40},
+					{PId: 200, CUOccupancy: 60},
+					{PId: 300, CUOccupancy: 10},
+					{PId: 0, CUOccupancy: 5},
+				},
+			},
+		},
+	}
+
+	procs := gpuclient.getGPUProcesses(wls, gpu)
+	assert.Equal(t, len(procs), 2, "expected top-n processes, got %+v", procs)
+	assert.Equal(t, procs[0], gpuProcess{pid: 200, cuOcc: 60, cuOccOk: true, vramUsed: 1, vramOk: true,
+		name: "vllm", container: "infer"})
+	assert.Equal(t, procs[1], gpuProcess{pid: 100, cuOcc: 40, cuOccOk: true, vramUsed: 2048, vramOk: true,
+		name: "python3", pod: "pytorch-0", namespace: "train", container: "infer"})
+
+	// vram is not read when the field is disabled
+	gpuclient.exportFieldMap[exportermetrics.GPUMetricField_GPU_PROCESS_USED_VRAM.String()] = false
+	procs = gpuclient.getGPUProcesses(wls, gpu)
+	assert.Assert(t, !procs[0].vramOk && !procs[1].vramOk, "unexpected vram %+v", procs)
+
+	for _, tc := range []struct {
+		topN int32
+		want int
+	}{
+		{0, globals.DefaultProcessMetricsTopN},
+		{2, 2},
+		{-1, -1},
+	} {
+		gpuclient.initProcessMetrics(&exportermetrics.GPUMetricConfig{ProcessMetricsTopN: tc.topN})
+		assert.Equal(t, gpuclient.processTopN, tc.want, "top-n %v", tc.topN)
+	}
+	gpuclient.initProcessMetrics(nil)
+	assert.Equal(t, gpuclient.processTopN, globals.DefaultProcessMetricsTopN)
+
+	// a negative top-n exports all the processes
+	gpuclient.processTopN = -1
+	procs = gpuclient.getGPUProcesses(wls, gpu)
+	assert.Equal(t, len(procs), 3, "expected all processes, got %+v", procs)
+}
+
+func TestResolveCounterGroups(t *testing.T) {
+	teardown := setupTest(t)
+	defer teardown(t)
+
+	gpuclient := getGPUClient(t)
+	err := gpuclient.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init, got %v", err)
+
+	groups := gpuclient.resolveCounterGroups([]*exportermetrics.ProfilerCounterGroup{
+		{Name: "cache"},
+		{Name: "custom", Fields: []string{"GPU_PROF_L2_HIT_RATE", "gpu_prof_sm_active", "TCC_HIT_sum", "SQ_WAVES"}},
+		{Name: "unknown"},
+		{Name: "not-profiler", Fields: []string{"GPU_PACKAGE_POWER"}},
+	})
+	assert.Equal(t, len(groups), 2, "unexpected groups %+v", groups)
+
+	builtin, _ := rocprofiler.BuiltinCounterGroup("cache")
+	assert.DeepEqual(t, groups[0].Counters, builtin)
+	assert.DeepEqual(t, groups[1].Counters, []string{"TCC_HIT_sum", "TCC_MISS_sum", "VALUBusy", "SQ_WAVES"})
+
+	// derived metrics are not profiled directly
+	for _, f := range gpuclient.getAllSupportedProfilerFields() {
+		assert.Assert(t, !rocprofiler.IsDerivedMetric(f), "derived field %v profiled", f)
+	}
+}
+
+func TestProfilerFieldsDerivedCounters(t *testing.T) {
+	teardown := setupTest(t)
+	defer teardown(t)
+
+	gpuclient := getGPUClient(t)
+	err := gpuclient.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init, got %v", err)
+
+	fields := func() map[string]int {
+		count := map[string]int{}
+		for _, f := range gpuclient.getAllSupportedProfilerFields() {
+			count[f]++
+		}
+		return count
+	}
+
+	// without counter groups the counters of the enabled derived metrics are
+	// profiled once
+	l2HitRate := exportermetrics.GPUMetricField_GPU_PROF_L2_HIT_RATE.String()
+	gpuclient.exportFieldMap[l2HitRate] = true
+	profiled := fields()
+	for _, c := range rocprofiler.DerivedMetricCounters(rocprofiler.L2HitRate) {
+		assert.Equal(t, profiled[c], 1, "counter %v of %v", c, l2HitRate)
+	}
+	for f, n := range profiled {
+		assert.Equal(t, n, 1, "field %v profiled %v times", f, n)
+		assert.Assert(t, !rocprofiler.IsDerivedMetric(f), "derived field %v profiled", f)
+	}
+
+	// the counters are not profiled when the derived metric is disabled
+	gpuclient.exportFieldMap[l2HitRate] = false
+	profiled = fields()
+	for _, c := range rocprofiler.DerivedMetricCounters(rocprofiler.L2HitRate) {
+		assert.Equal(t, profiled[c], 0, "counter %v of disabled %v", c, l2HitRate)
+	}
+}
+
+func TestProfilerSkipReason(t *testing.T) {
+	teardown := setupTest(t)
+	defer teardown(t)
+
+	gpuclient := getGPUClient(t)
+
+	gpuWithActivity := func(activity uint32) *amdgpu.GPU {
+		return &amdgpu.GPU{Stats: &amdgpu.GPUStats{Usage: &amdgpu.GPUUsage{GFXActivity: activity}}}
+	}
+	setGPUs := func(gpus ...*amdgpu.GPU) {
+		gpuclient.gCache.Lock()
+		defer gpuclient.gCache.Unlock()
+		gpuclient.gCache.lastResponse = &amdgpu.GPUGetResponse{Response: gpus}
+	}
+
+	// scheduler disabled
+	gpuclient.initProfilerScheduler(nil)
+	setGPUs(gpuWithActivity(0))
+	assert.Equal(t, gpuclient.profilerSkipReason(), "")
+	assert.Assert(t, !gpuclient.rocpclient.IsScheduled())
+
+	gpuclient.initProfilerScheduler(&exportermetrics.ProfilerSchedulerConfig{
+		Enable: true, Period: "10s", DutyCycle: 100, MaxBackoff: "5m0s", SkipIdle: true,
+	})
+	assert.Assert(t, gpuclient.rocpclient.IsScheduled())
+	assert.Equal(t, gpuclient.profilerSkipReason(), rocprofiler.SkipIdle)
+
+	setGPUs(gpuWithActivity(0), gpuWithActivity(12))
+	assert.Equal(t, gpuclient.profilerSkipReason(), "")
+
+	// unsupported activity is not idle
+	setGPUs(gpuWithActivity(0xFFFF))
+	assert.Equal(t, gpuclient.profilerSkipReason(), "")
+
+	gpuclient.initProfilerScheduler(nil)
+}
+
+func TestWorkloadOptOutReason(t *testing.T) {
+	optOut := &exportermetrics.ProfilerOptOutConfig{
+		PodAnnotations: []string{"amd.com/profiler-opt-out"},
+		PodLabels:      []string{"no-profiling"},
+		SlurmJobEnv:    true,
+	}
+	podKey := func(name string) string {
+		key := types.PodUniqueKey{PodName: name, Namespace: "ns"}
+		return key.String()
+	}
+	pods := map[string]types.K8sPodInfo{
+		podKey("a"): {
+			Name: "a", Namespace: "ns", Annotations: map[string]string{"amd.com/profiler-opt-out": "True"},
+		},
+		podKey("b"): {
+			Name: "b", Namespace: "ns", Labels: map[string]string{"no-profiling": "true"},
+		},
+		podKey("c"): {
+			Name: "c", Namespace: "ns", Labels: map[string]string{"no-profiling": "false"},
+		},
+	}
+	getPods := func() map[string]types.K8sPodInfo { return pods }
+	pod := func(name string) *scheduler.Workload {
+		return &scheduler.Workload{Type: scheduler.Kubernetes, Info: scheduler.PodResourceInfo{Pod: name, Namespace: "ns"}}
+	}
+	job := func(optOut bool) *scheduler.Workload {
+		return &scheduler.Workload{Type: scheduler.Slurm, Info: scheduler.JobInfo{Id: "1", ProfilerOptOut: optOut}}
+	}
+
+	tests := []struct {
+		name   string
+		wl     *scheduler.Workload
+		optOut *exportermetrics.ProfilerOptOutConfig
+		want   string
+	}{
+		{"pod annotation", pod("a"), optOut, profilerSuppressedPodAnnotation},
+		{"pod label", pod("b"), optOut, profilerSuppressedPodLabel},
+		{"pod not opted out", pod("c"), optOut, ""},
+		{"unknown pod", pod("d"), optOut, ""},
+		{"slurm job env", job(true), optOut, profilerSuppressedJobEnv},
+		{"slurm job", job(false), optOut, ""},
+		{"slurm job env not honored", job(true), &exportermetrics.ProfilerOptOutConfig{}, ""},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, workloadOptOutReason(tc.wl, tc.optOut, getPods), tc.want)
+		})
+	}
+}
+
+func TestInitProfilerOptOut(t *testing.T) {
+	teardown := setupTest(t)
+	defer teardown(t)
+
+	gpuclient := getGPUClient(t)
+
+	gpuclient.initProfilerOptOut(&exportermetrics.ProfilerConfig{})
+	assert.Assert(t, gpuclient.profilerOptOut.Load() == nil)
+	assert.Assert(t, !gpuclient.updateProfilerSuppression())
+
+	gpuclient.initProfilerOptOut(&exportermetrics.ProfilerConfig{
+		OptOut:    &exportermetrics.ProfilerOptOutConfig{PodAnnotations: []string{"a"}},
+		Scheduler: &exportermetrics.ProfilerSchedulerConfig{Enable: true, OptOutAnnotation: "b"},
+	})
+	assert.DeepEqual(t, gpuclient.profilerOptOut.Load().GetPodAnnotations(), []string{"a", "b"})
+
+	gpuclient.initProfilerOptOut(nil)
+	assert.Assert(t, gpuclient.profilerOptOut.Load() == nil)
+}
+
+// TestProfilerSuppressionConcurrentMetadata verifies the profiler scheduler
+// reads the GPU metadata safely while a scrape updates it
+func TestProfilerSuppressionConcurrentMetadata(t *testing.T) {
+	teardown := setupTest(t)
+	defer teardown(t)
+
+	gpuclient := getGPUClient(t)
+	optOutSchedMockCl := mock_gen.NewMockSchedulerClient(mockCtl)
+	optOutSchedMockCl.EXPECT().ListWorkloads().Return(map[string]scheduler.Workload{
+		"pcie0": {Type: scheduler.Slurm, Info: scheduler.JobInfo{Id: "1", ProfilerOptOut: true}},
+	}, nil).AnyTimes()
+	gpuclient.gpuHandler.jobSchedulers = []scheduler.SchedulerClient{optOutSchedMockCl}
+	gpuclient.initProfilerOptOut(&exportermetrics.ProfilerConfig{
+		OptOut: &exportermetrics.ProfilerOptOutConfig{SlurmJobEnv: true},
+	})
+	defer gpuclient.initProfilerOptOut(nil)
+
+	gpus := []*amdgpu.GPU{}
+	for i, busID := range []string{"pcie0", "pcie1"} {
+		gpus = append(gpus, &amdgpu.GPU{
+			Spec:   &amdgpu.GPUSpec{Id: []byte(uuid.New().String())},
+			Status: &amdgpu.GPUStatus{Index: uint32(i), PCIeStatus: &amdgpu.GPUPCIeStatus{PCIeBusId: busID}},
+		})
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			gpuclient.initGPUMetadata(gpus)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		gpuclient.updateProfilerSuppression()
+	}
+	<-done
+
+	assert.Equal(t, len(gpuclient.profilerSuppression(gpuclient.gpuIDMapSnapshot())), 1)
+	assert.Assert(t, !gpuclient.updateProfilerSuppression(), "only one of the GPUs is opted out")
+}
+
+func TestSysfsCollector(t *testing.T) {
+	teardown := setupTest(t)
+	defer teardown(t)
+
+	gpuclient := getGPUClient(t)
+	err := gpuclient.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init, got %v", err)
+	assert.Assert(t, !slices.Contains(gpuclient.GetExportLabels(), sourceLabel),
+		"source label exported with sysfs collector disabled")
+
+	for _, tc := range []struct {
+		mode     string
+		want     globals.SysfsCollectorMode
+		fallback bool
+	}{
+		{"", globals.SysfsCollectorDisabled, false},
+		{"disabled", globals.SysfsCollectorDisabled, false},
+		{"Fallback", globals.SysfsCollectorFallback, true},
+		{"primary", globals.SysfsCollectorPrimary, false},
+		{"always", globals.SysfsCollectorDisabled, false},
+	} {
+		gpuclient.initSysfsCollector(&exportermetrics.GPUMetricConfig{SysfsCollector: tc.mode})
+		assert.Equal(t, gpuclient.sysfsCollector, tc.want, "mode %q", tc.mode)
+		assert.Equal(t, gpuclient.fallbackToSysfs(errors.New("unavailable")), tc.fallback, "mode %q", tc.mode)
+		assert.Assert(t, !gpuclient.fallbackToSysfs(nil), "mode %q", tc.mode)
+	}
+
+	gpuclient.initSysfsCollector(&exportermetrics.GPUMetricConfig{SysfsCollector: "fallback"})
+	gpuclient.initPrometheusMetrics()
+	assert.Assert(t, slices.Contains(gpuclient.GetExportLabels(), sourceLabel),
+		"source label missing with sysfs collector enabled")
+	gpuclient.sysRoot = path.Join("..", "fsysdevice", "testdata", "sysfs")
+	// the gpu id and uuid known from gpuagent are kept
+	guid := uuid.New()
+	gpuclient.gpuIDMap = map[string]GPUIDMeta{
+		"3": {GPUID: "3", PCIeBusId: "0000:05:00.0", UUID: guid.String()},
+		"4": {GPUID: "4", PCIeBusId: "0000:05:00.0"},
+	}
+	assert.NilError(t, gpuclient.updateSysfsMetrics())
+
+	stats, err := fsysdevice.ReadGPUSysfsStats(gpuclient.sysRoot)
+	assert.NilError(t, err)
+	gpus := gpuclient.sysfsGPUs(stats)
+	assert.Equal(t, getGPUInstanceID(gpus[0]), 0)
+	assert.Equal(t, getGPUInstanceID(gpus[1]), 3)
+	assert.Equal(t, getGPUUUID(gpus[1]), guid.String())
+
+	value := func(metric prometheus.GaugeVec, gpuID string, extra map[string]string) float64 {
+		t.Helper()
+		for _, gpu := range gpus {
+			if getGPUInstanceIDString(gpu) != gpuID {
+				continue
+			}
+			labels := gpuclient.populateLabelsFromGPU(nil, gpu, nil)
+			assert.Equal(t, labels[sourceLabel], sourceGPUAgent)
+			labels[sourceLabel] = sourceSysfs
+			for k, v := range extra {
+				labels[k] = v
+			}
+			var m dto.Metric
+			gauge, err := metric.GetMetricWith(labels)
+			assert.NilError(t, err)
+			assert.NilError(t, gauge.Write(&m))
+			return m.GetGauge().GetValue()
+		}
+		t.Fatalf("gpu %v not found", gpuID)
+		return 0
+	}
+	// card0 is 0000:05:00.0, gpu 3
+	for _, tc := range []struct {
+		name   string
+		metric prometheus.GaugeVec
+		extra  map[string]string
+		want   float64
+	}{
+		{"edge temperature", gpuclient.metrics.gpuEdgeTemp, nil, 41},
+		{"junction temperature", gpuclient.metrics.gpuJunctionTemp, nil, 48},
+		{"memory temperature", gpuclient.metrics.gpuMemoryTemp, nil, 39},
+		{"package power", gpuclient.metrics.gpuPackagePower, nil, 158},
+		{"average package power", gpuclient.metrics.gpuAvgPkgPower, nil, 152},
+		{"gfx activity", gpuclient.metrics.gpuGFXActivity, nil, 42},
+		{"umc activity", gpuclient.metrics.gpuUMCActivity, nil, 7},
+		{"total vram", gpuclient.metrics.gpuTotalVram, nil, 196592},
+		{"used vram", gpuclient.metrics.gpuUsedVram, nil, 10240},
+		{"free vram", gpuclient.metrics.gpuFreeVram, nil, 186352},
+		{"pcie speed", gpuclient.metrics.gpuPCIeSpeed, nil, 32},
+		{"pcie bandwidth", gpuclient.metrics.gpuPCIeBandwidth, nil, 512000},
+		{"gfx clock", gpuclient.metrics.gpuClock, map[string]string{"clock_index": "0", "clock_type": "system"}, 2100},
+		{"memory clock", gpuclient.metrics.gpuClock, map[string]string{"clock_index": "1", "clock_type": "memory"}, 1300},
+		{"umc correctable", gpuclient.metrics.gpuEccCorrectUMC, nil, 4},
+		{"bif correctable", gpuclient.metrics.gpuEccCorrectBIF, nil, 1},
+		{"total correctable", gpuclient.metrics.gpuEccCorrectTotal, nil, 7},
+		{"total uncorrectable", gpuclient.metrics.gpuEccUncorrectTotal, nil, 1},
+		{"power cap", gpuclient.metrics.gpuPowerCap, map[string]string{"cap_type": "ppt0"}, 750},
+		{"power cap min", gpuclient.metrics.gpuPowerCapMin, nil, 0},
+		{"power cap max", gpuclient.metrics.gpuPowerCapMax, nil, 750},
+	} {
+		assert.Equal(t, value(tc.metric, "3", tc.extra), tc.want, tc.name)
+	}
+	assert.Equal(t, value(gpuclient.metrics.gpuEdgeTemp, "0", nil), float64(35))
+}
+
+func TestGPUSettingsMetrics(t *testing.T) {
+	teardown := setupTest(t)
+	defer teardown(t)
+
+	gpuclient := getGPUClient(t)
+	err := gpuclient.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init, got %v", err)
+	gpuclient.sysRoot = path.Join("..", "fsysdevice", "testdata", "sysfs")
+
+	newGPU := func(version string, powerCap uint64, level amdgpu.GPUPerformanceLevel, high uint32) *amdgpu.GPU {
+		return &amdgpu.GPU{
+			Spec: &amdgpu.GPUSpec{
+				GPUPowerCap: []*amdgpu.GPUPowerCap{
+					{Type: amdgpu.GPUPowerCapType_GPU_POWER_CAP_TYPE_PPT0, PowerCap: powerCap},
+					{Type: amdgpu.GPUPowerCapType_GPU_POWER_CAP_TYPE_PPT1, PowerCap: math.MaxUint64},
+				},
+				PerformanceLevel: level,
+				ClockFrequency: []*amdgpu.GPUClockFrequencyRange{
+					{ClockType: amdgpu.GPUClockType_GPU_CLOCK_TYPE_SYSTEM, LowFrequency: 500, HighFrequency: high},
+					{ClockType: amdgpu.GPUClockType_GPU_CLOCK_TYPE_MEMORY},
+				},
+			},
+			Status: &amdgpu.GPUStatus{
+				PCIeStatus: &amdgpu.GPUPCIeStatus{PCIeBusId: "0000:05:00.0"},
+				FirmwareVersion: []*amdgpu.GPUFirmwareVersion{
+					{Firmware: "SMC", Version: version},
+					{Firmware: "VCN"},
+				},
+			},
+		}
+	}
+
+	gpu := newGPU("00.85.112.00", 750, amdgpu.GPUPerformanceLevel_GPU_PERF_LEVEL_AUTO, 2100)
+	labels := map[string]string{}
+	for _, label := range gpuclient.GetExportLabels() {
+		labels[label] = ""
+	}
+	gpuclient.updateGPUSettingsMetrics(gpu, labels)
+	gpuclient.updateGPUSettingsEvents([]*amdgpu.GPU{gpu})
+
+	value := func(metric prometheus.GaugeVec, extra map[string]string) float64 {
+		t.Helper()
+		l := map[string]string{}
+		for k, v := range labels {
+			l[k] = v
+		}
+		for k, v := range extra {
+			l[k] = v
+		}
+		var m dto.Metric
+		gauge, err := metric.GetMetricWith(l)
+		assert.NilError(t, err)
+		assert.NilError(t, gauge.Write(&m))
+		return m.GetGauge().GetValue()
+	}
+	for _, tc := range []struct {
+		name   string
+		metric prometheus.GaugeVec
+		extra  map[string]string
+		want   float64
+	}{
+		{"firmware", gpuclient.metrics.gpuFirmwareInfo, map[string]string{"component": "smc", "version": "00.85.112.00"}, 1},
+		{"power cap", gpuclient.metrics.gpuPowerCap, map[string]string{"cap_type": "ppt0"}, 750},
+		{"power cap min", gpuclient.metrics.gpuPowerCapMin, nil, 0},
+		{"power cap max", gpuclient.metrics.gpuPowerCapMax, nil, 750},
+		{"perf level", gpuclient.metrics.gpuPerfLevelInfo, map[string]string{"perf_level": "auto"}, 1},
+		{"clock range min", gpuclient.metrics.gpuClockRangeMin, map[string]string{"clock_type": "system"}, 500},
+		{"clock range max", gpuclient.metrics.gpuClockRangeMax, map[string]string{"clock_type": "system"}, 2100},
+	} {
+		assert.Equal(t, value(tc.metric, tc.extra), tc.want, tc.name)
+	}
+	// unset and not applicable values are not exported
+	for _, tc := range []struct {
+		metric prometheus.GaugeVec
+		extra  map[string]string
+	}{
+		{gpuclient.metrics.gpuFirmwareInfo, map[string]string{"component": "vcn", "version": ""}},
+		{gpuclient.metrics.gpuPowerCap, map[string]string{"cap_type": "ppt1"}},
+		{gpuclient.metrics.gpuClockRangeMax, map[string]string{"clock_type": "memory"}},
+	} {
+		l := map[string]string{}
+		for k, v := range labels {
+			l[k] = v
+		}
+		for k, v := range tc.extra {
+			l[k] = v
+		}
+		assert.Assert(t, !tc.metric.Delete(l), "unexpected series %v", tc.extra)
+	}
+
+	prev := gpuclient.gpuSettings["0000:05:00"]
+	assert.Assert(t, prev != nil)
+	assert.Equal(t, len(getGPUSettings(gpu).diff("0", prev)), 0)
+
+	for _, tc := range []struct {
+		name string
+		gpu  *amdgpu.GPU
+		want []events.EventReason
+	}{
+		{"firmware", newGPU("00.85.113.00", 750, amdgpu.GPUPerformanceLevel_GPU_PERF_LEVEL_AUTO, 2100),
+			[]events.EventReason{events.GPUFirmwareChanged}},
+		{"power cap", newGPU("00.85.112.00", 600, amdgpu.GPUPerformanceLevel_GPU_PERF_LEVEL_AUTO, 2100),
+			[]events.EventReason{events.GPUPowerCapChanged}},
+		{"perf level", newGPU("00.85.112.00", 750, amdgpu.GPUPerformanceLevel_GPU_PERF_LEVEL_DETERMINISTIC, 2100),
+			[]events.EventReason{events.GPUPerfLevelChanged}},
+		{"clock range", newGPU("00.85.112.00", 750, amdgpu.GPUPerformanceLevel_GPU_PERF_LEVEL_AUTO, 1800),
+			[]events.EventReason{events.GPUClockRangeChanged}},
+		{"all", newGPU("00.85.113.00", 600, amdgpu.GPUPerformanceLevel_GPU_PERF_LEVEL_MANUAL, 1800),
+			[]events.EventReason{events.GPUFirmwareChanged, events.GPUPowerCapChanged,
+				events.GPUPerfLevelChanged, events.GPUClockRangeChanged}},
+	} {
+		reasons := []events.EventReason{}
+		for _, change := range getGPUSettings(tc.gpu).diff("0", prev) {
+			reasons = append(reasons, change.reason)
+		}
+		assert.DeepEqual(t, reasons, tc.want)
+	}
+
+	// the changed settings become the baseline of the next scrape
+	changed := newGPU("00.85.112.00", 600, amdgpu.GPUPerformanceLevel_GPU_PERF_LEVEL_AUTO, 2100)
+	gpuclient.updateGPUSettingsEvents([]*amdgpu.GPU{changed})
+	assert.Equal(t, gpuclient.gpuSettings["0000:05:00"].powerCaps["ppt0"], uint64(600))
+
+	// gpus missing from the response are forgotten
+	gpuclient.updateGPUSettingsEvents([]*amdgpu.GPU{})
+	assert.Equal(t, len(gpuclient.gpuSettings), 0)
+}
+
+func TestGPUSettingsDevices(t *testing.T) {
+	newPartition := func(busID string, id uint32, level amdgpu.GPUPerformanceLevel) *amdgpu.GPU {
+		return &amdgpu.GPU{
+			Spec: &amdgpu.GPUSpec{PerformanceLevel: level},
+			Status: &amdgpu.GPUStatus{
+				PCIeStatus:  &amdgpu.GPUPCIeStatus{PCIeBusId: busID},
+				PartitionId: id,
+			},
+		}
+	}
+	auto := amdgpu.GPUPerformanceLevel_GPU_PERF_LEVEL_AUTO
+	manual := amdgpu.GPUPerformanceLevel_GPU_PERF_LEVEL_MANUAL
+
+	// the partitions of a device are compared once through the first partition
+	devices := getSettingsDevices([]*amdgpu.GPU{
+		newPartition("0000:05:00.1", 1, manual),
+		newPartition("0000:05:00.0", 0, auto),
+		newPartition("0000:05:00.2", 2, manual),
+		newPartition("0000:15:00.0", math.MaxUint32, manual),
+		nil,
+	})
+	assert.Equal(t, len(devices), 2)
+	assert.Equal(t, devices["0000:05:00"].Status.PartitionId, uint32(0))
+	assert.Equal(t, devices["0000:15:00"].Status.PartitionId, uint32(math.MaxUint32))
+
+	// without the first partition another partition of the device is used
+	devices = getSettingsDevices([]*amdgpu.GPU{
+		newPartition("0000:05:00.3", 3, auto),
+		newPartition("0000:05:00.2", 2, manual),
+	})
+	assert.Equal(t, len(devices), 1)
+	assert.Equal(t, devices["0000:05:00"].Status.PartitionId, uint32(3))
+}
+
+func TestGPUInfoMetrics(t *testing.T) {
+	teardown := setupTest(t)
+	defer teardown(t)
+
+	gpuclient := getGPUClient(t)
+	err := gpuclient.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init, got %v", err)
+	assert.Assert(t, slices.Contains(gpuclient.GetExportLabels(), "card_model"),
+		"identity labels expected on the series by default")
+
+	gpuclient.initInfoMetrics(&exportermetrics.GPUMetricConfig{InfoMetrics: true})
+	gpuclient.initPrometheusMetrics()
+	exportLabels := gpuclient.GetExportLabels()
+	infoLabels := gpuclient.GetInfoLabels()
+	for _, label := range []string{"hostname", "gpu_id", "gpu_partition_id"} {
+		assert.Assert(t, slices.Contains(exportLabels, label), "join label %v missing on the series", label)
+		assert.Assert(t, slices.Contains(infoLabels, label), "join label %v missing on gpu_info", label)
+	}
+	for _, label := range []string{"serial_number", "card_model", "gpu_compute_partition_type", "deployment_mode"} {
+		assert.Assert(t, !slices.Contains(exportLabels, label), "identity label %v exported on the series", label)
+		assert.Assert(t, slices.Contains(infoLabels, label), "identity label %v missing on gpu_info", label)
+	}
+	// workload labels change with the allocation and stay on the series
+	assert.Assert(t, slices.Contains(exportLabels, "pod"))
+	assert.Assert(t, !slices.Contains(infoLabels, "pod"))
+
+	gpu := &amdgpu.GPU{
+		Spec: &amdgpu.GPUSpec{
+			Id: []byte(uuid.New().String()),
+		},
+		Status: &amdgpu.GPUStatus{
+			Index:      2,
+			SerialNum:  "test-serial",
+			CardModel:  "test-model",
+			CardSeries: "test-series",
+			CardVendor: "test-vendor",
+			PCIeStatus: &amdgpu.GPUPCIeStatus{
+				PCIeBusId: "0000:01:00.0",
+			},
+		},
+		Stats: &amdgpu.GPUStats{
+			PackagePower: 100,
+		},
+	}
+	labels := gpuclient.populateLabelsFromGPU(nil, gpu, nil)
+	assert.Equal(t, labels["gpu_id"], "2")
+	_, ok := labels["serial_number"]
+	assert.Assert(t, !ok, "identity label populated on the series")
+
+	// series and gpu_info are exported with consistent label sets
+	gpuclient.updateGPUInfoToMetrics(nil, gpu, nil, nil, nil)
+	value := func(metric prometheus.GaugeVec, labels map[string]string) float64 {
+		t.Helper()
+		var m dto.Metric
+		gauge, err := metric.GetMetricWith(labels)
+		assert.NilError(t, err)
+		assert.NilError(t, gauge.Write(&m))
+		return m.GetGauge().GetValue()
+	}
+	assert.Equal(t, value(gpuclient.metrics.gpuPackagePower, labels), float64(100))
+
+	allLabels := gpuclient.populateAllLabelsFromGPU(nil, gpu, nil)
+	infoSeries := map[string]string{}
+	for _, label := range infoLabels {
+		infoSeries[label] = allLabels[label]
+	}
+	assert.Equal(t, infoSeries["gpu_id"], "2")
+	assert.Equal(t, infoSeries["serial_number"], "test-serial")
+	assert.Equal(t, infoSeries["card_model"], "test-model")
+	assert.Equal(t, value(gpuclient.metrics.gpuInfo, infoSeries), float64(1))
+}
+
+func TestPartitionRollupMetrics(t *testing.T) {
+	teardown := setupTest(t)
+	defer teardown(t)
+
+	gpuclient := getGPUClient(t)
+	err := gpuclient.InitConfigs()
+	assert.Assert(t, err == nil, "expecting success config init, got %v", err)
+	// rollups are opt-in
+	for _, field := range partitionRollupFields {
+		assert.Assert(t, !gpuclient.getExporterFieldState(field), "%v enabled by default", field)
+	}
+	gpuclient.initPartitionRollup(&exportermetrics.GPUMetricConfig{PartitionRollupMetrics: true})
+
+	partition := func(busID string, id uint32, mode amdgpu.GPUComputePartitionType,
+		usedVRAM uint64, activity uint32, power uint64) *amdgpu.GPU {
+		return &amdgpu.GPU{
+			Spec: &amdgpu.GPUSpec{
+				Id:                   []byte(uuid.New().String()),
+				ComputePartitionType: mode,
+			},
+			Status: &amdgpu.GPUStatus{
+				SerialNum:   "serial-" + busID[5:7],
+				PartitionId: id,
+				PCIeStatus:  &amdgpu.GPUPCIeStatus{PCIeBusId: busID},
+			},
+			Stats: &amdgpu.GPUStats{
+				PackagePower: power,
+				Usage:        &amdgpu.GPUUsage{GFXActivity: activity},
+				VRAMUsage:    &amdgpu.GPUVRAMUsage{UsedVRAM: usedVRAM},
+			},
+		}
+	}
+	cpx := amdgpu.GPUComputePartitionType_GPU_COMPUTE_PARTITION_TYPE_CPX
+	spx := amdgpu.GPUComputePartitionType_GPU_COMPUTE_PARTITION_TYPE_SPX
+
+	series := func(metric prometheus.GaugeVec) map[string]float64 {
+		t.Helper()
+		ch := make(chan prometheus.Metric, 16)
+		metric.Collect(ch)
+		close(ch)
+		values := map[string]float64{}
+		for m := range ch {
+			var d dto.Metric
+			assert.NilError(t, m.Write(&d))
+			var bus, mode string
+			for _, l := range d.GetLabel() {
+				switch l.GetName() {
+				case rollupBusIDLabel:
+					bus = l.GetValue()
+				case rollupPartitionModeLabel:
+					mode = l.GetValue()
+				}
+			}
+			values[bus+"/"+mode] = d.GetGauge().GetValue()
+		}
+		return values
+	}
+
+	tests := []struct {
+		name  string
+		gpus  []*amdgpu.GPU
+		vram  map[string]float64
+		avg   map[string]float64
+		max   map[string]float64
+		power map[string]float64
+	}{
+		{
+			name: "cpx and spx gpus",
+			gpus: []*amdgpu.GPU{
+				partition("0000:03:00.0", 0, cpx, 1024, 10, 400),
+				// power only reported by the first partition
+				partition("0000:03:00.1", 1, amdgpu.GPUComputePartitionType_GPU_COMPUTE_PARTITION_TYPE_NONE, 2048, 50, math.MaxUint64),
+				partition("0000:03:00.2", 2, amdgpu.GPUComputePartitionType_GPU_COMPUTE_PARTITION_TYPE_NONE, 0, math.MaxUint32, 0),
+				partition("0000:04:00.0", 0, spx, 512, 70, 300),
+				// not partitioned
+				partition("0000:05:00.0", math.MaxUint32, amdgpu.GPUComputePartitionType_GPU_COMPUTE_PARTITION_TYPE_NONE, 256, 5, 100),
+			},
+			vram:  map[string]float64{"0000:03:00/cpx": 3072, "0000:04:00/spx": 512, "0000:05:00/none": 256},
+			avg:   map[string]float64{"0000:03:00/cpx": 30, "0000:04:00/spx": 70, "0000:05:00/none": 5},
+			max:   map[string]float64{"0000:03:00/cpx": 50, "0000:04:00/spx": 70, "0000:05:00/none": 5},
+			power: map[string]float64{"0000:03:00/cpx": 400, "0000:04:00/spx": 300, "0000:05:00/none": 100},
+		},
+		{
+			name: "partition mode changed to spx",
+			gpus: []*amdgpu.GPU{
+				partition("0000:03:00.0", 0, spx, 4096, 20, 450),
+				partition("0000:04:00.0", 0, spx, 512, 70, 300),
+			},
+			vram:  map[string]float64{"0000:03:00/spx": 4096, "0000:04:00/spx": 512},
+			avg:   map[string]float64{"0000:03:00/spx": 20, "0000:04:00/spx": 70},
+			max:   map[string]float64{"0000:03:00/spx": 20, "0000:04:00/spx": 70},
+			power: map[string]float64{"0000:03:00/spx": 450, "0000:04:00/spx": 300},
+		},
+		{
+			name: "partition mode change in progress",
+			gpus: []*amdgpu.GPU{
+				partition("0000:03:00.0", 0, spx, 4096, 20, 450),
+				partition("0000:03:00.1", 1, cpx, 1024, 10, 0),
+				partition("0000:04:00.1", 1, cpx, 1024, 10, 0),
+			},
+			vram:  map[string]float64{},
+			avg:   map[string]float64{},
+			max:   map[string]float64{},
+			power: map[string]float64{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.NilError(t, gpuclient.ResetMetrics())
+			gpuclient.updatePartitionRollupMetrics(tc.gpus)
+			assert.DeepEqual(t, series(gpuclient.metrics.gpuRollupUsedVram), tc.vram)
+			assert.DeepEqual(t, series(gpuclient.metrics.gpuRollupGfxActivityAvg), tc.avg)
+			assert.DeepEqual(t, series(gpuclient.metrics.gpuRollupGfxActivityMax), tc.max)
+			assert.DeepEqual(t, series(gpuclient.metrics.gpuRollupPackagePower), tc.power)
+		})
+	}
+}
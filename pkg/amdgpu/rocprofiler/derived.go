@@ -0,0 +1,146 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package rocprofiler
+
+import (
+	"strconv"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+)
+
+// profiler field names of the derived metrics
+const (
+	ArithmeticIntensity = "ArithmeticIntensity"
+	AchievedTFLOPs      = "AchievedTFLOPs"
+	L2HitRate           = "L2HitRate"
+)
+
+// derivedMetric computes a metric from raw counters of the same sample
+type derivedMetric struct {
+	name     string
+	counters []string
+	// formula returns the metric from the counters and the sampling
+	// duration in seconds, false when it is not defined for the sample
+	formula func(c map[string]float64, durationSec float64) (float64, bool)
+}
+
+var totalOpsCounters = []string{"TOTAL_16_OPS", "TOTAL_32_OPS", "TOTAL_64_OPS"}
+
+func totalOps(c map[string]float64) float64 {
+	return c["TOTAL_16_OPS"] + c["TOTAL_32_OPS"] + c["TOTAL_64_OPS"]
+}
+
+// derivedMetrics is the formula table of the derived metrics
+var derivedMetrics = []derivedMetric{
+	{
+		// floating point ops per byte read from or written to memory,
+		// FETCH_SIZE and WRITE_SIZE are in KB
+		name:     ArithmeticIntensity,
+		counters: append(append([]string{}, totalOpsCounters...), "FETCH_SIZE", "WRITE_SIZE"),
+		formula: func(c map[string]float64, _ float64) (float64, bool) {
+			bytes := (c["FETCH_SIZE"] + c["WRITE_SIZE"]) * 1024
+			if bytes <= 0 {
+				return 0, false
+			}
+			return totalOps(c) / bytes, true
+		},
+	},
+	{
+		// floating point ops per second over the sampling duration in TFLOPs
+		name:     AchievedTFLOPs,
+		counters: totalOpsCounters,
+		formula: func(c map[string]float64, durationSec float64) (float64, bool) {
+			if durationSec <= 0 {
+				return 0, false
+			}
+			return totalOps(c) / durationSec / 1e12, true
+		},
+	},
+	{
+		// percent of the L2 (TCC) requests that hit
+		name:     L2HitRate,
+		counters: []string{"TCC_HIT_sum", "TCC_MISS_sum"},
+		formula: func(c map[string]float64, _ float64) (float64, bool) {
+			total := c["TCC_HIT_sum"] + c["TCC_MISS_sum"]
+			if total <= 0 {
+				return 0, false
+			}
+			return c["TCC_HIT_sum"] * 100 / total, true
+		},
+	},
+}
+
+// IsDerivedMetric returns true when the profiler field is computed by the
+// exporter instead of being read by rocpctl
+func IsDerivedMetric(name string) bool {
+	return DerivedMetricCounters(name) != nil
+}
+
+// DerivedMetricCounters returns the raw counters of a derived metric
+func DerivedMetricCounters(name string) []string {
+	for _, dm := range derivedMetrics {
+		if dm.name == name {
+			return dm.counters
+		}
+	}
+	return nil
+}
+
+// computeDerivedMetrics returns the derived metrics whose counters are all
+// present in the sample
+func computeDerivedMetrics(counters map[string]float64, durationSec float64) map[string]float64 {
+	derived := make(map[string]float64)
+	for _, dm := range derivedMetrics {
+		found := true
+		for _, c := range dm.counters {
+			if _, ok := counters[c]; !ok {
+				found = false
+				break
+			}
+		}
+		if !found {
+			continue
+		}
+		if v, ok := dm.formula(counters, durationSec); ok {
+			derived[dm.name] = v
+		}
+	}
+	return derived
+}
+
+// addDerivedMetrics appends the derived metrics of every GPU of the sample
+func addDerivedMetrics(gpus *amdgpu.GpuProfiler, durationSec float64) {
+	for _, gpu := range gpus.GpuMetrics {
+		counters := make(map[string]float64, len(gpu.Metrics))
+		for _, m := range gpu.Metrics {
+			v, err := strconv.ParseFloat(m.Value, 64)
+			if err != nil {
+				continue
+			}
+			counters[m.Field] = v
+		}
+		derived := computeDerivedMetrics(counters, durationSec)
+		for _, dm := range derivedMetrics {
+			if v, ok := derived[dm.name]; ok {
+				gpu.Metrics = append(gpu.Metrics, &amdgpu.MetricValue{
+					Field: dm.name,
+					Value: strconv.FormatFloat(v, 'g', -1, 64),
+				})
+			}
+		}
+	}
+}
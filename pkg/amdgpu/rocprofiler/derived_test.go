@@ -0,0 +1,146 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package rocprofiler
+
+import (
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+)
+
+func TestComputeDerivedMetrics(t *testing.T) {
+	tests := []struct {
+		name     string
+		counters map[string]float64
+		duration float64
+		want     map[string]float64
+	}{
+		{
+			name: "all derived metrics",
+			counters: map[string]float64{
+				"TOTAL_16_OPS": 1024, "TOTAL_32_OPS": 2048, "TOTAL_64_OPS": 1024,
+				"FETCH_SIZE": 3, "WRITE_SIZE": 1,
+				"TCC_HIT_sum": 75, "TCC_MISS_sum": 25,
+			},
+			duration: 0.001,
+			want: map[string]float64{
+				ArithmeticIntensity: 1,
+				AchievedTFLOPs:      4096 / 0.001 / 1e12,
+				L2HitRate:           75,
+			},
+		},
+		{
+			name: "missing counters",
+			counters: map[string]float64{
+				"TOTAL_16_OPS": 1024, "TOTAL_32_OPS": 2048,
+				"TCC_HIT_sum": 10,
+			},
+			duration: 0.001,
+			want:     map[string]float64{},
+		},
+		{
+			name: "zero denominators",
+			counters: map[string]float64{
+				"TOTAL_16_OPS": 0, "TOTAL_32_OPS": 0, "TOTAL_64_OPS": 0,
+				"FETCH_SIZE": 0, "WRITE_SIZE": 0,
+				"TCC_HIT_sum": 0, "TCC_MISS_sum": 0,
+			},
+			duration: 0,
+			want:     map[string]float64{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.DeepEqual(t, computeDerivedMetrics(tc.counters, tc.duration), tc.want)
+		})
+	}
+}
+
+func TestAddDerivedMetrics(t *testing.T) {
+	gpus := &amdgpu.GpuProfiler{
+		GpuMetrics: []*amdgpu.GpuMetric{
+			{
+				DrmRenderId: "128",
+				Metrics: []*amdgpu.MetricValue{
+					{Field: "TCC_HIT_sum", Value: "9"},
+					{Field: "TCC_MISS_sum", Value: "1"},
+				},
+			},
+		},
+	}
+	addDerivedMetrics(gpus, 0.001)
+	metrics := gpus.GpuMetrics[0].Metrics
+	assert.Equal(t, len(metrics), 3)
+	assert.Equal(t, metrics[2].Field, L2HitRate)
+	assert.Equal(t, metrics[2].Value, "90")
+}
+
+func TestDerivedMetricCounters(t *testing.T) {
+	assert.Assert(t, IsDerivedMetric(L2HitRate))
+	assert.Assert(t, !IsDerivedMetric("TCC_HIT_sum"))
+	assert.DeepEqual(t, DerivedMetricCounters(L2HitRate), []string{"TCC_HIT_sum", "TCC_MISS_sum"})
+	assert.Assert(t, DerivedMetricCounters("TCC_HIT_sum") == nil)
+}
+
+func profilerSample(render string, values map[string]string) *amdgpu.GpuProfiler {
+	gpu := &amdgpu.GpuMetric{DrmRenderId: render}
+	for f, v := range values {
+		gpu.Metrics = append(gpu.Metrics, &amdgpu.MetricValue{Field: f, Value: v})
+	}
+	return &amdgpu.GpuProfiler{GpuMetrics: []*amdgpu.GpuMetric{gpu}}
+}
+
+func TestGroupRotation(t *testing.T) {
+	gr := newGroupRotation()
+	now := time.Now()
+	interval := time.Minute
+
+	assert.Equal(t, gr.next(3, interval, now), 0)
+	assert.Equal(t, gr.next(3, interval, now.Add(30*time.Second)), 0)
+	assert.Equal(t, gr.next(3, interval, now.Add(time.Minute)), 1)
+	assert.Equal(t, gr.next(3, interval, now.Add(2*time.Minute)), 2)
+	assert.Equal(t, gr.next(3, interval, now.Add(3*time.Minute)), 0)
+	// fewer groups than the current index restarts the rotation
+	gr.current = 2
+	assert.Equal(t, gr.next(2, interval, now.Add(3*time.Minute)), 0)
+}
+
+func TestGroupRotationMerge(t *testing.T) {
+	gr := newGroupRotation()
+	now := time.Now()
+
+	gr.merge(0, profilerSample("128", map[string]string{"FETCH_SIZE": "1"}), now)
+	merged := gr.merge(1, profilerSample("128", map[string]string{"FETCH_SIZE": "2", "TCC_HIT_sum": "3"}), now.Add(time.Minute))
+	assert.Equal(t, len(merged.GpuMetrics), 1)
+
+	values := map[string]string{}
+	for _, m := range merged.GpuMetrics[0].Metrics {
+		values[m.Field] = m.Value
+	}
+	assert.DeepEqual(t, values, map[string]string{"FETCH_SIZE": "2", "TCC_HIT_sum": "3"})
+
+	// a newer sample of the first group wins again
+	merged = gr.merge(0, profilerSample("128", map[string]string{"FETCH_SIZE": "5"}), now.Add(2*time.Minute))
+	values = map[string]string{}
+	for _, m := range merged.GpuMetrics[0].Metrics {
+		values[m.Field] = m.Value
+	}
+	assert.DeepEqual(t, values, map[string]string{"FETCH_SIZE": "5", "TCC_HIT_sum": "3"})
+}
@@ -0,0 +1,128 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package rocprofiler
+
+import (
+	"sort"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+)
+
+// CounterGroup is a named set of rocprofiler counters read together by one
+// rocpctl command
+type CounterGroup struct {
+	Name     string
+	Counters []string
+	cmd      string
+}
+
+// builtinCounterGroups are the counter groups used when a configured group
+// has no fields, each group holds the counters of its derived metrics
+var builtinCounterGroups = map[string][]string{
+	"memory-bound": {
+		"FETCH_SIZE", "WRITE_SIZE", "TOTAL_16_OPS", "TOTAL_32_OPS", "TOTAL_64_OPS",
+		"GRBM_GUI_ACTIVE", "GRBM_COUNT",
+	},
+	"compute-bound": {
+		"TOTAL_16_OPS", "TOTAL_32_OPS", "TOTAL_64_OPS", "MfmaUtil", "ValuPipeIssueUtil",
+		"VALUBusy", "SIMD_UTILIZATION", "OccupancyPercent",
+	},
+	"cache": {
+		"TCC_HIT_sum", "TCC_MISS_sum", "FETCH_SIZE", "WRITE_SIZE",
+	},
+}
+
+// BuiltinCounterGroup returns the counters of a built-in counter group
+func BuiltinCounterGroup(name string) ([]string, bool) {
+	counters, ok := builtinCounterGroups[name]
+	return counters, ok
+}
+
+// groupRotation tracks the counter group profiled and the latest sample of
+// every group
+type groupRotation struct {
+	current   int
+	started   time.Time
+	samples   map[int]*amdgpu.GpuProfiler
+	sampledAt map[int]time.Time
+}
+
+func newGroupRotation() *groupRotation {
+	return &groupRotation{
+		samples:   make(map[int]*amdgpu.GpuProfiler),
+		sampledAt: make(map[int]time.Time),
+	}
+}
+
+// next returns the group to profile at now, moving to the following group
+// once the current one has been profiled for the rotation interval
+func (gr *groupRotation) next(groups int, interval time.Duration, now time.Time) int {
+	if gr.started.IsZero() || gr.current >= groups {
+		gr.current = 0
+		gr.started = now
+	} else if now.Sub(gr.started) >= interval {
+		gr.current = (gr.current + 1) % groups
+		gr.started = now
+	}
+	return gr.current
+}
+
+// merge stores the sample of a group and returns the latest samples of all
+// groups merged per GPU, a field read by several groups takes the value of
+// the most recent sample
+func (gr *groupRotation) merge(group int, sample *amdgpu.GpuProfiler, now time.Time) *amdgpu.GpuProfiler {
+	gr.samples[group] = sample
+	gr.sampledAt[group] = now
+
+	order := make([]int, 0, len(gr.samples))
+	for g := range gr.samples {
+		order = append(order, g)
+	}
+	// oldest first so that newer values override
+	sort.Slice(order, func(i, j int) bool {
+		return gr.sampledAt[order[i]].Before(gr.sampledAt[order[j]])
+	})
+
+	merged := &amdgpu.GpuProfiler{}
+	gpus := map[string]*amdgpu.GpuMetric{}
+	fields := map[string]map[string]int{}
+	for _, g := range order {
+		for _, gpu := range gr.samples[g].GetGpuMetrics() {
+			m, ok := gpus[gpu.DrmRenderId]
+			if !ok {
+				m = &amdgpu.GpuMetric{
+					GpuId:         gpu.GpuId,
+					DrmRenderId:   gpu.DrmRenderId,
+					LogicalNodeId: gpu.LogicalNodeId,
+				}
+				gpus[gpu.DrmRenderId] = m
+				fields[gpu.DrmRenderId] = map[string]int{}
+				merged.GpuMetrics = append(merged.GpuMetrics, m)
+			}
+			for _, v := range gpu.Metrics {
+				if idx, ok := fields[gpu.DrmRenderId][v.Field]; ok {
+					m.Metrics[idx] = v
+					continue
+				}
+				fields[gpu.DrmRenderId][v.Field] = len(m.Metrics)
+				m.Metrics = append(m.Metrics, v)
+			}
+		}
+	}
+	return merged
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"sort"
 	"strings"
 	"sync"
 	"time"
@@ -50,6 +51,8 @@ type ROCProfilerClient struct {
 	SamplingInterval uint64
 	PtlDelayMs       uint32
 	cmd              string
+	groups           []CounterGroup
+	groupRotation    time.Duration
 	pCache           *profilerCache
 	emitEvent        EventEmitFunc
 }
@@ -61,6 +64,9 @@ type profilerCache struct {
 	consecutiveFailures int
 	fatalFailure        bool
 	disabledReason      string // set when profiler is disabled; cleared on recovery
+	rotation            *groupRotation
+	sched               schedulerState
+	suppressed          map[string]string // DRM render id -> suppression reason
 }
 
 func NewRocProfilerClient(name string) *ROCProfilerClient {
@@ -70,6 +76,7 @@ func NewRocProfilerClient(name string) *ROCProfilerClient {
 		MetricFields: []string{},
 		pCache: &profilerCache{
 			fatalFailure: false,
+			rotation:     newGroupRotation(),
 		},
 	}
 }
@@ -103,15 +110,73 @@ func (rpc *ROCProfilerClient) SetPtlDelay(delayMs uint32) {
 	rpc.PtlDelayMs = delayMs
 }
 
+func (rpc *ROCProfilerClient) buildCmd(fields []string) string {
+	return fmt.Sprintf("rocpctl -d %d -p %d %v", rpc.SamplingInterval, rpc.PtlDelayMs, strings.Join(fields, " "))
+}
+
 func (rpc *ROCProfilerClient) SetFields(fields []string) {
 	logger.Log.Printf("rocprofiler fields pulled for %v", strings.Join(fields, ","))
 	rpc.MetricFields = fields
-	durationUs := rpc.SamplingInterval
-	ptlDelayMs := rpc.PtlDelayMs
-	rpc.cmd = fmt.Sprintf("rocpctl -d %d -p %d %v", durationUs, ptlDelayMs, strings.Join(fields, " "))
+	rpc.groups = nil
+	rpc.resetRotation()
+	rpc.cmd = rpc.buildCmd(fields)
 	logger.Log.Printf("rocpctl command: %v", rpc.cmd)
 }
 
+// SetCounterGroups profiles one counter group at a time, rotating to the
+// next group every rotation interval, the latest values of all groups are
+// returned
+func (rpc *ROCProfilerClient) SetCounterGroups(groups []CounterGroup, rotation time.Duration) {
+	rpc.groups = make([]CounterGroup, 0, len(groups))
+	rpc.MetricFields = []string{}
+	seen := map[string]bool{}
+	for _, g := range groups {
+		if len(g.Counters) == 0 {
+			continue
+		}
+		g.cmd = rpc.buildCmd(g.Counters)
+		logger.Log.Printf("rocpctl command of counter group %v: %v", g.Name, g.cmd)
+		rpc.groups = append(rpc.groups, g)
+		for _, c := range g.Counters {
+			if !seen[c] {
+				seen[c] = true
+				rpc.MetricFields = append(rpc.MetricFields, c)
+			}
+		}
+	}
+	rpc.groupRotation = rotation
+	rpc.resetRotation()
+	rpc.cmd = ""
+}
+
+func (rpc *ROCProfilerClient) resetRotation() {
+	rpc.pCache.Lock()
+	defer rpc.pCache.Unlock()
+	rpc.pCache.rotation = newGroupRotation()
+	rpc.pCache.cachedMetrics = nil
+}
+
+// samplingDuration returns the sampling duration of rocpctl in seconds
+func (rpc *ROCProfilerClient) samplingDuration() float64 {
+	durationUs := rpc.SamplingInterval
+	if durationUs == 0 {
+		durationUs = 1000
+	}
+	return float64(durationUs) / 1e6
+}
+
+// nextCmd returns the rocpctl command to run and its counter group, -1 when
+// the counters are not grouped
+func (rpc *ROCProfilerClient) nextCmd(now time.Time) (string, int) {
+	if len(rpc.groups) == 0 {
+		return rpc.cmd, -1
+	}
+	rpc.pCache.Lock()
+	defer rpc.pCache.Unlock()
+	group := rpc.pCache.rotation.next(len(rpc.groups), rpc.groupRotation, now)
+	return rpc.groups[group].cmd, group
+}
+
 // cacheMetrics returns the cached metrics if they are fresh, otherwise it fetches new metrics
 // and updates the cache. If the fetch fails, the cache is cleared and the error is returned.
 // this is required to avoid frequent calls to rocpctl for metrics to avoid stress on hardware
@@ -142,7 +207,77 @@ func (rpc *ROCProfilerClient) cacheMetrics(ctx context.Context) (*amdgpu.GpuProf
 }
 
 func (rpc *ROCProfilerClient) GetMetrics(ctx context.Context) (*amdgpu.GpuProfiler, error) {
-	return rpc.cacheMetrics(ctx)
+	var metrics *amdgpu.GpuProfiler
+	var err error
+	if rpc.IsScheduled() {
+		metrics, err = rpc.scheduledMetrics()
+	} else {
+		metrics, err = rpc.cacheMetrics(ctx)
+	}
+	if err != nil {
+		return metrics, err
+	}
+	return rpc.filterSuppressed(metrics), nil
+}
+
+// SetSuppressedDevices sets the GPUs not to profile, keyed by DRM render id
+// with the suppression reason
+func (rpc *ROCProfilerClient) SetSuppressedDevices(devices map[string]string) {
+	rpc.pCache.Lock()
+	defer rpc.pCache.Unlock()
+	for id, reason := range devices {
+		if _, ok := rpc.pCache.suppressed[id]; !ok {
+			logger.Log.Printf("%v profiling suppressed on render id %v, %v", rpc.Name, id, reason)
+		}
+	}
+	for id := range rpc.pCache.suppressed {
+		if _, ok := devices[id]; !ok {
+			logger.Log.Printf("%v profiling resumed on render id %v", rpc.Name, id)
+		}
+	}
+	rpc.pCache.suppressed = devices
+}
+
+// SuppressedReason returns the suppression reason of a GPU, false when the
+// GPU is profiled
+func (rpc *ROCProfilerClient) SuppressedReason(renderID string) (string, bool) {
+	rpc.pCache.RLock()
+	defer rpc.pCache.RUnlock()
+	reason, ok := rpc.pCache.suppressed[renderID]
+	return reason, ok
+}
+
+// excludeArgs returns the rocpctl option excluding the suppressed GPUs
+func (rpc *ROCProfilerClient) excludeArgs() string {
+	rpc.pCache.RLock()
+	defer rpc.pCache.RUnlock()
+	if len(rpc.pCache.suppressed) == 0 {
+		return ""
+	}
+	ids := make([]string, 0, len(rpc.pCache.suppressed))
+	for id := range rpc.pCache.suppressed {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return fmt.Sprintf(" -x %v", strings.Join(ids, ","))
+}
+
+// filterSuppressed drops the suppressed GPUs from a sample taken before they
+// were suppressed
+func (rpc *ROCProfilerClient) filterSuppressed(metrics *amdgpu.GpuProfiler) *amdgpu.GpuProfiler {
+	rpc.pCache.RLock()
+	defer rpc.pCache.RUnlock()
+	if metrics == nil || len(rpc.pCache.suppressed) == 0 {
+		return metrics
+	}
+	filtered := &amdgpu.GpuProfiler{}
+	for _, gpu := range metrics.GpuMetrics {
+		if _, ok := rpc.pCache.suppressed[gpu.DrmRenderId]; ok {
+			continue
+		}
+		filtered.GpuMetrics = append(filtered.GpuMetrics, gpu)
+	}
+	return filtered
 }
 
 func (rpc *ROCProfilerClient) IncFailureCount(ctx context.Context) {
@@ -212,7 +347,9 @@ func (rpc *ROCProfilerClient) getMetrics(ctx context.Context) (*amdgpu.GpuProfil
 	ctx, cancel := context.WithTimeout(ctx, rocprofilerTimeout*time.Second)
 	defer cancel()
 
-	cmd := exec.CommandContext(ctx, "/bin/bash", "-c", rpc.cmd)
+	rocpCmd, group := rpc.nextCmd(time.Now())
+	rocpCmd += rpc.excludeArgs()
+	cmd := exec.CommandContext(ctx, "/bin/bash", "-c", rocpCmd)
 
 	// Capture stderr separately for error logging
 	var stderr bytes.Buffer
@@ -233,7 +370,7 @@ func (rpc *ROCProfilerClient) getMetrics(ctx context.Context) (*amdgpu.GpuProfil
 	}
 
 	if ctx.Err() == context.DeadlineExceeded {
-		logger.Log.Printf("command timed out after %ds: %v", rocprofilerTimeout, rpc.cmd)
+		logger.Log.Printf("command timed out after %ds: %v", rocprofilerTimeout, rocpCmd)
 		if stderr.Len() > 0 {
 			logger.Log.Printf("stderr: %s", stderr.String())
 		}
@@ -243,7 +380,7 @@ func (rpc *ROCProfilerClient) getMetrics(ctx context.Context) (*amdgpu.GpuProfil
 		return nil, ctx.Err()
 	} else if err != nil {
 		logger.Log.Printf("error occurred: %v", err)
-		logger.Log.Printf("command: %v", rpc.cmd)
+		logger.Log.Printf("command: %v", rocpCmd)
 		if stderr.Len() > 0 {
 			logger.Log.Printf("stderr: %s", stderr.String())
 		}
@@ -265,5 +402,11 @@ func (rpc *ROCProfilerClient) getMetrics(ctx context.Context) (*amdgpu.GpuProfil
 		return nil, err
 	}
 	rpc.ResetFailureCount()
-	return &gpus, nil
+	addDerivedMetrics(&gpus, rpc.samplingDuration())
+	if group < 0 {
+		return &gpus, nil
+	}
+	rpc.pCache.Lock()
+	defer rpc.pCache.Unlock()
+	return rpc.pCache.rotation.merge(group, &gpus, time.Now()), nil
 }
@@ -22,6 +22,7 @@ import (
 
 	"gotest.tools/assert"
 
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
 )
 
@@ -82,3 +83,27 @@ func TestGetDisabledReason_FatalFailure(t *testing.T) {
 	rpc.SetFatalFailureState(ctx)
 	assert.Equal(t, rpc.GetDisabledReason(), disabledReasonCrash)
 }
+
+func TestSuppressedDevices(t *testing.T) {
+	rpc := NewRocProfilerClient("test")
+	sample := &amdgpu.GpuProfiler{
+		GpuMetrics: []*amdgpu.GpuMetric{{DrmRenderId: "128"}, {DrmRenderId: "136"}, {DrmRenderId: "144"}},
+	}
+
+	assert.Equal(t, rpc.excludeArgs(), "")
+	assert.Equal(t, len(rpc.filterSuppressed(sample).GpuMetrics), 3)
+
+	rpc.SetSuppressedDevices(map[string]string{"144": "pod_label", "128": "pod_annotation"})
+	assert.Equal(t, rpc.excludeArgs(), " -x 128,144")
+	filtered := rpc.filterSuppressed(sample)
+	assert.Equal(t, len(filtered.GpuMetrics), 1)
+	assert.Equal(t, filtered.GpuMetrics[0].DrmRenderId, "136")
+	reason, ok := rpc.SuppressedReason("128")
+	assert.Assert(t, ok)
+	assert.Equal(t, reason, "pod_annotation")
+	_, ok = rpc.SuppressedReason("136")
+	assert.Assert(t, !ok)
+
+	rpc.SetSuppressedDevices(nil)
+	assert.Equal(t, rpc.excludeArgs(), "")
+}
@@ -0,0 +1,203 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package rocprofiler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// reasons returned by a SkipFunc to skip a scheduled sample
+const (
+	SkipIdle   = "idle"
+	SkipOptOut = "opt-out"
+)
+
+// SkipFunc returns the reason to skip the next scheduled sample, empty to
+// take it
+type SkipFunc func() string
+
+// SchedulerConfig configures the background sampling of the profiler
+type SchedulerConfig struct {
+	// time between the start of two samples
+	Period time.Duration
+	// maximum percent of time rocpctl may be running
+	DutyCycle uint32
+	// upper bound of the backoff of skipped samples
+	MaxBackoff time.Duration
+}
+
+// schedulerState is the state of the background sampling, guarded by the
+// profiler cache lock
+type schedulerState struct {
+	config    *SchedulerConfig
+	sample    *amdgpu.GpuProfiler
+	sampledAt time.Time
+	backoff   time.Duration
+	skipped   string
+}
+
+// SetScheduler enables background sampling with the config, nil returns to
+// sampling on scrape requests
+func (rpc *ROCProfilerClient) SetScheduler(config *SchedulerConfig) {
+	rpc.pCache.Lock()
+	defer rpc.pCache.Unlock()
+	if config != nil {
+		logger.Log.Printf("profiler scheduler period %v duty cycle %v%% max backoff %v",
+			config.Period, config.DutyCycle, config.MaxBackoff)
+	} else if rpc.pCache.sched.config != nil {
+		logger.Log.Printf("profiler scheduler disabled")
+	}
+	rpc.pCache.sched = schedulerState{config: config}
+}
+
+// IsScheduled returns true when the profiler is sampled in the background
+func (rpc *ROCProfilerClient) IsScheduled() bool {
+	rpc.pCache.RLock()
+	defer rpc.pCache.RUnlock()
+	return rpc.pCache.sched.config != nil
+}
+
+// SampleAge returns the age of the latest profiler sample, false when there
+// is no sample
+func (rpc *ROCProfilerClient) SampleAge() (time.Duration, bool) {
+	rpc.pCache.RLock()
+	defer rpc.pCache.RUnlock()
+	if rpc.pCache.sched.config != nil {
+		if rpc.pCache.sched.sample == nil {
+			return 0, false
+		}
+		return time.Since(rpc.pCache.sched.sampledAt), true
+	}
+	if rpc.pCache.cachedMetrics == nil {
+		return 0, false
+	}
+	return time.Since(rpc.pCache.cacheLastRead), true
+}
+
+// scheduledMetrics returns the latest sample of the scheduler
+func (rpc *ROCProfilerClient) scheduledMetrics() (*amdgpu.GpuProfiler, error) {
+	rpc.pCache.RLock()
+	defer rpc.pCache.RUnlock()
+	if rpc.pCache.sched.sample == nil {
+		if rpc.pCache.sched.skipped != "" {
+			return nil, fmt.Errorf("%v sampling skipped, %v", rpc.Name, rpc.pCache.sched.skipped)
+		}
+		return nil, fmt.Errorf("%v has no sample yet", rpc.Name)
+	}
+	return rpc.pCache.sched.sample, nil
+}
+
+// StartScheduler samples the profiler in the background until ctx is done,
+// sampling only happens while a scheduler config is set
+func (rpc *ROCProfilerClient) StartScheduler(ctx context.Context, skip SkipFunc) {
+	go func() {
+		logger.Log.Printf("%v scheduler started", rpc.Name)
+		for {
+			wait := rpc.runScheduled(ctx, skip)
+			select {
+			case <-ctx.Done():
+				logger.Log.Printf("%v scheduler exiting: %v", rpc.Name, ctx.Err())
+				return
+			case <-time.After(wait):
+			}
+		}
+	}()
+}
+
+// runScheduled takes or skips one sample and returns the time to wait before
+// the next one
+func (rpc *ROCProfilerClient) runScheduled(ctx context.Context, skip SkipFunc) time.Duration {
+	rpc.pCache.RLock()
+	config := rpc.pCache.sched.config
+	rpc.pCache.RUnlock()
+	if config == nil || rpc.IsDisabledOnFailure() {
+		return cachedTimer
+	}
+
+	reason := ""
+	if skip != nil {
+		reason = skip()
+	}
+	if reason != "" {
+		rpc.pCache.Lock()
+		defer rpc.pCache.Unlock()
+		if rpc.pCache.sched.skipped != reason {
+			logger.Log.Printf("%v skipping samples, %v", rpc.Name, reason)
+		}
+		rpc.pCache.sched.skipped = reason
+		rpc.pCache.sched.backoff = nextBackoff(rpc.pCache.sched.backoff, config)
+		if reason == SkipOptOut {
+			// samples taken before the opt out are not exported
+			rpc.pCache.sched.sample = nil
+		}
+		return rpc.pCache.sched.backoff
+	}
+
+	start := time.Now()
+	metrics, err := rpc.getMetrics(ctx)
+	took := time.Since(start)
+
+	rpc.pCache.Lock()
+	defer rpc.pCache.Unlock()
+	if rpc.pCache.sched.skipped != "" {
+		logger.Log.Printf("%v resuming samples after %v", rpc.Name, rpc.pCache.sched.skipped)
+	}
+	rpc.pCache.sched.skipped = ""
+	rpc.pCache.sched.backoff = 0
+	if err != nil {
+		logger.Log.Printf("%v scheduled sample failed: %v", rpc.Name, err)
+	} else {
+		rpc.pCache.sched.sample = metrics
+		rpc.pCache.sched.sampledAt = start
+	}
+	return nextSampleWait(config, took)
+}
+
+// nextBackoff doubles the backoff of skipped samples from the period up to
+// the max backoff
+func nextBackoff(backoff time.Duration, config *SchedulerConfig) time.Duration {
+	if backoff < config.Period {
+		return config.Period
+	}
+	backoff *= 2
+	if backoff > config.MaxBackoff {
+		return config.MaxBackoff
+	}
+	return backoff
+}
+
+// nextSampleWait returns the wait after a sample that took the given time,
+// the next sample starts one period after this one unless the duty cycle
+// requires a longer pause
+func nextSampleWait(config *SchedulerConfig, took time.Duration) time.Duration {
+	wait := config.Period - took
+	if config.DutyCycle > 0 && config.DutyCycle < 100 {
+		pause := took * time.Duration(100-config.DutyCycle) / time.Duration(config.DutyCycle)
+		if pause > wait {
+			wait = pause
+		}
+	}
+	if wait < 0 {
+		return 0
+	}
+	return wait
+}
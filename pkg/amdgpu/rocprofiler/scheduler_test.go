@@ -0,0 +1,132 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package rocprofiler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"gotest.tools/assert"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+)
+
+func TestNextSampleWait(t *testing.T) {
+	tests := []struct {
+		name      string
+		dutyCycle uint32
+		took      time.Duration
+		want      time.Duration
+	}{
+		{"full duty cycle", 100, 2 * time.Second, 8 * time.Second},
+		{"duty cycle within period", 50, 2 * time.Second, 8 * time.Second},
+		{"duty cycle extends period", 10, 2 * time.Second, 18 * time.Second},
+		{"sample longer than period", 100, 12 * time.Second, 0},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			config := &SchedulerConfig{Period: 10 * time.Second, DutyCycle: tc.dutyCycle, MaxBackoff: time.Minute}
+			assert.Equal(t, nextSampleWait(config, tc.took), tc.want)
+		})
+	}
+}
+
+func TestNextBackoff(t *testing.T) {
+	config := &SchedulerConfig{Period: 10 * time.Second, DutyCycle: 100, MaxBackoff: 35 * time.Second}
+	backoff := time.Duration(0)
+	for _, want := range []time.Duration{10 * time.Second, 20 * time.Second, 35 * time.Second, 35 * time.Second} {
+		backoff = nextBackoff(backoff, config)
+		assert.Equal(t, backoff, want)
+	}
+}
+
+func TestRunScheduled(t *testing.T) {
+	ctx := context.Background()
+	rpc := NewRocProfilerClient("test")
+	config := &SchedulerConfig{Period: 10 * time.Second, DutyCycle: 100, MaxBackoff: time.Minute}
+
+	// not scheduled, scrapes read rocpctl
+	assert.Assert(t, !rpc.IsScheduled())
+	assert.Equal(t, rpc.runScheduled(ctx, nil), cachedTimer)
+
+	rpc.SetScheduler(config)
+	assert.Assert(t, rpc.IsScheduled())
+	_, err := rpc.GetMetrics(ctx)
+	assert.Assert(t, err != nil, "expected no sample before first run")
+	_, ok := rpc.SampleAge()
+	assert.Assert(t, !ok)
+
+	// no fields, the sample is empty without running rocpctl
+	wait := rpc.runScheduled(ctx, nil)
+	assert.Assert(t, wait > 0 && wait <= config.Period, "unexpected wait %v", wait)
+	sample, err := rpc.GetMetrics(ctx)
+	assert.Assert(t, err == nil && sample != nil, "expected sample, got %v", err)
+	_, ok = rpc.SampleAge()
+	assert.Assert(t, ok)
+
+	// idle GPUs keep the last sample and back off
+	skip := SkipIdle
+	skipFn := func() string { return skip }
+	assert.Equal(t, rpc.runScheduled(ctx, skipFn), 10*time.Second)
+	assert.Equal(t, rpc.runScheduled(ctx, skipFn), 20*time.Second)
+	sample, err = rpc.GetMetrics(ctx)
+	assert.Assert(t, err == nil && sample != nil, "expected sample, got %v", err)
+
+	// opted out jobs drop the sample
+	skip = SkipOptOut
+	assert.Equal(t, rpc.runScheduled(ctx, skipFn), 40*time.Second)
+	_, err = rpc.GetMetrics(ctx)
+	assert.ErrorContains(t, err, SkipOptOut)
+
+	// resuming resets the backoff
+	skip = ""
+	rpc.runScheduled(ctx, skipFn)
+	assert.Equal(t, rpc.pCache.sched.backoff, time.Duration(0))
+	sample, err = rpc.GetMetrics(ctx)
+	assert.Assert(t, err == nil && sample != nil, "expected sample, got %v", err)
+
+	rpc.SetScheduler(nil)
+	assert.Assert(t, !rpc.IsScheduled())
+}
+
+func TestStartScheduler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	rpc := NewRocProfilerClient("test")
+	rpc.SetScheduler(&SchedulerConfig{Period: time.Second, DutyCycle: 100, MaxBackoff: time.Second})
+
+	sampled := make(chan struct{}, 1)
+	rpc.StartScheduler(ctx, func() string {
+		select {
+		case sampled <- struct{}{}:
+		default:
+		}
+		return ""
+	})
+	select {
+	case <-sampled:
+	case <-time.After(2 * time.Second):
+		t.Fatal("scheduler did not run")
+	}
+	cancel()
+	var gpus *amdgpu.GpuProfiler
+	for i := 0; i < 20 && gpus == nil; i++ {
+		gpus, _ = rpc.scheduledMetrics()
+		time.Sleep(10 * time.Millisecond)
+	}
+	assert.Assert(t, gpus != nil, "expected a scheduled sample")
+}
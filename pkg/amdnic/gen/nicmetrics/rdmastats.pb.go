@@ -88,6 +88,7 @@ type RDMAStats struct {
 	RDMA_RESP_TX_RNR_RETRY_ERR     uint64 `protobuf:"varint,36,opt,name=RDMA_RESP_TX_RNR_RETRY_ERR,json=RDMARESPTXRNRRETRYERR,proto3" json:"resp_tx_rnr_retry_err,omitempty"`
 	RDMA_RESP_TX_LOC_SGL_INV_ERR   uint64 `protobuf:"varint,37,opt,name=RDMA_RESP_TX_LOC_SGL_INV_ERR,json=RDMARESPTXLOCSGLINVERR,proto3" json:"resp_tx_loc_sgl_inv_err,omitempty"`
 	RDMA_RESP_RX_S0_TABLE_ERR      uint64 `protobuf:"varint,38,opt,name=RDMA_RESP_RX_S0_TABLE_ERR,json=RDMARESPRXS0TABLEERR,proto3" json:"resp_rx_s0_table_err,omitempty"`
+	RDMA_LOCAL_ACK_TIMEOUT_ERR     uint64 `protobuf:"varint,39,opt,name=RDMA_LOCAL_ACK_TIMEOUT_ERR,json=RDMALOCALACKTIMEOUTERR,proto3" json:"local_ack_timeout_err,omitempty"`
 }
 
 func (x *RDMAStats) Reset() {
@@ -388,6 +389,13 @@ func (x *RDMAStats) GetRDMA_RESP_RX_S0_TABLE_ERR() uint64 {
 	return 0
 }
 
+func (x *RDMAStats) GetRDMA_LOCAL_ACK_TIMEOUT_ERR() uint64 {
+	if x != nil {
+		return x.RDMA_LOCAL_ACK_TIMEOUT_ERR
+	}
+	return 0
+}
+
 var File_rdmastats_proto protoreflect.FileDescriptor
 
 var file_rdmastats_proto_rawDesc = []byte{
@@ -395,7 +403,7 @@ var file_rdmastats_proto_rawDesc = []byte{
 	0x6f, 0x12, 0x0a, 0x6e, 0x69, 0x63, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x1a, 0x29, 0x67,
 	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x6c, 0x74, 0x61, 0x2f, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f, 0x70, 0x61, 0x74, 0x63, 0x68, 0x2f,
-	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xc0, 0x1c, 0x0a, 0x09, 0x52, 0x44, 0x4d,
+	0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xab, 0x1d, 0x0a, 0x09, 0x52, 0x44, 0x4d,
 	0x41, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x49, 0x46, 0x4e, 0x41, 0x4d, 0x45,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1e, 0xca, 0xb5, 0x03, 0x1a, 0xa2, 0x01, 0x17, 0x6a,
 	0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x69, 0x66, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
@@ -623,9 +631,15 @@ var file_rdmastats_proto_rawDesc = []byte{
 	0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x73, 0x70, 0x5f, 0x72, 0x78, 0x5f, 0x73, 0x30,
 	0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x65, 0x72, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
 	0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x14, 0x52, 0x44, 0x4d, 0x41, 0x52, 0x45, 0x53, 0x50, 0x52,
-	0x58, 0x53, 0x30, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x45, 0x52, 0x52, 0x42, 0x10, 0x5a, 0x0e, 0x67,
-	0x65, 0x6e, 0x2f, 0x6e, 0x69, 0x63, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x58, 0x53, 0x30, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x45, 0x52, 0x52, 0x12, 0x69, 0x0a, 0x1a, 0x52,
+	0x44, 0x4d, 0x41, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x54, 0x49,
+	0x4d, 0x45, 0x4f, 0x55, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x18, 0x27, 0x20, 0x01, 0x28, 0x04, 0x42,
+	0x2d, 0xca, 0xb5, 0x03, 0x29, 0xa2, 0x01, 0x26, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x61, 0x63, 0x6b, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
+	0x65, 0x72, 0x72, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x52, 0x16,
+	0x52, 0x44, 0x4d, 0x41, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x41, 0x43, 0x4b, 0x54, 0x49, 0x4d, 0x45,
+	0x4f, 0x55, 0x54, 0x45, 0x52, 0x52, 0x42, 0x10, 0x5a, 0x0e, 0x67, 0x65, 0x6e, 0x2f, 0x6e, 0x69,
+	0x63, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
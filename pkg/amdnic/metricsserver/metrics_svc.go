@@ -67,6 +67,7 @@ func (m *MetricsSvcImpl) List(ctx context.Context, e *emptypb.Empty) (*nicmetric
 					UUID:   nicState.UUID,
 					Device: nicState.Device,
 					Health: nicState.Health,
+					Reason: nicState.Reason,
 				})
 			}
 		}
@@ -84,3 +84,23 @@ func TestList(t *testing.T) {
 	assert.Assert(t, err != nil, "expected error from List due to mock error")
 	assert.Assert(t, resp == nil, "expected response to be nil due to error")
 }
+
+func TestListReason(t *testing.T) {
+	server := NewMetricsServer(false)
+	mockClient := &MockHealthInterface{
+		nicHealthStateMap: map[string]interface{}{
+			"nic1": &nicmetricssvc.NICState{
+				UUID:   "uuid1",
+				Device: "device1",
+				Health: "unhealthy",
+				Reason: "link down on eth1/1",
+			},
+		},
+	}
+	server.RegisterHealthClient(mockClient)
+	resp, err := server.List(context.Background(), &emptypb.Empty{})
+	assert.Assert(t, err == nil, "expected no error from List")
+	assert.Equal(t, 1, len(resp.NICState))
+	assert.Equal(t, "unhealthy", resp.NICState[0].Health)
+	assert.Equal(t, "link down on eth1/1", resp.NICState[0].Reason)
+}
@@ -18,6 +18,7 @@ package nicagent
 
 import (
 	"strings"
+	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 )
@@ -25,12 +26,18 @@ import (
 const (
 	// AMD Vendor ID
 	AMDVendorID = "0x1dd8"
+	// Mellanox (NVIDIA) Vendor ID
+	MellanoxVendorID = "0x15b3"
+	// Broadcom Vendor ID
+	BroadcomVendorID = "0x14e4"
 	// Port name label for port metrics
 	LabelPortName = "port_name"
 	// Port ID label for port metrics
 	LabelPortID = "port_id"
 	// RoCE Interface name label for RDMA  metrics
 	LabelRdmaDevName = "rdma_dev_name"
+	// Vendor counter name label for vendor specific RDMA metrics
+	LabelCounter = "counter"
 	// Netdevice name label for RDMA  metrics
 	LabelPcieBusId = "pcie_bus_id"
 	// Lif name label for Lif metrics
@@ -39,20 +46,45 @@ const (
 	LabelEthIntfAlias = "eth_intf_alias"
 	// Queue-Pair ID for QP metrics
 	LabelQPID = "qp_id"
+	// Transceiver lane label for per lane transceiver metrics
+	LabelLane = "lane"
+	// Transceiver sensor and threshold severity labels
+	LabelSensor   = "sensor"
+	LabelSeverity = "severity"
+	// Transceiver module identification labels
+	LabelTransceiverType   = "transceiver_type"
+	LabelTransceiverVendor = "transceiver_vendor"
+	LabelTransceiverPN     = "transceiver_part_number"
+	LabelTransceiverSN     = "transceiver_serial_number"
 	// set pod cache size > max concurrent workload pods per node to avoid cache thrashing
 	podCacheSize = 100
 
 	RDMAClientName            = "RDMA_Stats_Client"
 	NICCtlClientName          = "NICCTL_Client"
 	EthtoolClientName         = "Ethtool_Client"
+	TransceiverClientName     = "Transceiver_Client"
 	NICCtlBinary              = "nicctl"
 	RDMABinary                = "rdma"
 	EthtoolBinary             = "ethtool"
 	PodNetnsExecCmd           = "nsenter --net=/host/proc/%d/ns/net "
+	PodNetnsPath              = "/host/proc/%d/ns/net"
 	ShowRdmaDevicesCmd        = "rdma link"
 	ShowNetDeviceCmd          = "ip link show %s"
 	EthToolCmd                = "ethtool -S %s"
+	EthToolModuleCmd          = "ethtool -m %s"
 	GetPcieAddrFromRdmaDevCmd = "cat /sys/class/infiniband/%s/device/uevent  | grep PCI_SLOT"
+	// default sysfs root, the host /sys is mounted as is in the exporter
+	DefaultSysfsRoot = "/sys"
+	// rdma device class relative to the sysfs root
+	InfinibandClassPath = "class/infiniband"
+	// default window of the NIC health counter rules
+	DefaultNICHealthWindow = 5 * time.Minute
+	// default window of the derived rate metrics
+	DefaultDerivedMetricsWindow = time.Minute
+	// default refresh interval of the transceiver diagnostics
+	DefaultTransceiverRefreshInterval = 5 * time.Minute
+	// retry interval of the ethtool client after no interface could be queried
+	EthtoolRetryInterval = 5 * time.Minute
 )
 
 var (
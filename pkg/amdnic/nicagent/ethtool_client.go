@@ -20,8 +20,8 @@ import (
 	"bytes"
 	"context"
 	"fmt"
-	"os/exec"
 	"sync"
+	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
@@ -32,6 +32,10 @@ import (
 type EthtoolClient struct {
 	sync.Mutex
 	na *NICAgentClient
+	// interfaces read on the last update, and the last failure
+	queried    int
+	lastErr    error
+	lastUpdate time.Time
 }
 
 func newEthtoolClient(na *NICAgentClient) *EthtoolClient {
@@ -47,13 +51,17 @@ func (ec *EthtoolClient) Init() error {
 
 	return nil
 }
+
+// IsActive reports false once an update could not query any interface,
+// either with the ioctl or the ethtool binary, or found no interface. The
+// client is retried every EthtoolRetryInterval.
 func (ec *EthtoolClient) IsActive() bool {
 	ec.Lock()
 	defer ec.Unlock()
-	if _, err := exec.LookPath(EthtoolBinary); err == nil {
+	if ec.lastUpdate.IsZero() || ec.queried > 0 {
 		return true
 	}
-	return false
+	return time.Since(ec.lastUpdate) >= EthtoolRetryInterval
 }
 
 func (ec *EthtoolClient) GetClientName() string {
@@ -81,6 +89,9 @@ func (ec *EthtoolClient) UpdateNICStats(ctx context.Context, workloads map[strin
 	}
 	ec.Lock()
 	defer ec.Unlock()
+	ec.queried = 0
+	ec.lastErr = nil
+	ec.lastUpdate = time.Now()
 
 	// fetch Host Interface Stats
 	var nilPodInfo *scheduler.PodResourceInfo
@@ -96,6 +107,13 @@ func (ec *EthtoolClient) UpdateNICStats(ctx context.Context, workloads map[strin
 		}
 	}
 
+	if ec.queried == 0 {
+		if ec.lastErr == nil {
+			ec.lastErr = fmt.Errorf("no interfaces found")
+		}
+		logger.Log.Printf("ethtool stats unavailable, no interface could be queried: %v; retrying in %v",
+			ec.lastErr, EthtoolRetryInterval)
+	}
 	return nil
 }
 
@@ -104,6 +122,7 @@ func (ec *EthtoolClient) fetchEthStatsForDevicesInPod(podInfo *scheduler.PodReso
 	netDevList, err := ec.na.getNetDevicesList(podInfo)
 	if err != nil {
 		logger.Log.Printf("failed to get netDevices in podInfo %v: %v", podInfo, err)
+		ec.lastErr = err
 		return err
 	}
 
@@ -111,41 +130,64 @@ func (ec *EthtoolClient) fetchEthStatsForDevicesInPod(podInfo *scheduler.PodReso
 		labels := ec.na.populateLabelsForNetDevice(netDevList[i], podInfo)
 		if err := ec.populateEthStatsForNetDevice(podInfo, netDevList[i], labels); err != nil {
 			logger.Log.Printf("failure in fetch for ethstats of netDev %v : %v", netDevList[i], err)
+			ec.lastErr = err
+			continue
 		}
+		ec.queried++
 	}
 
 	return nil
 }
 
-func (ec *EthtoolClient) populateEthStatsForNetDevice(podInfo *scheduler.PodResourceInfo, netDev NetDevice, labels map[string]string) error {
+// getEthtoolStatsExec returns the interface statistics from `ethtool -S`,
+// run through nsenter for pod interfaces
+func (ec *EthtoolClient) getEthtoolStatsExec(pid int, netDev NetDevice) (*nicmetrics.EthtoolStats, error) {
 	var cmd string
-	if netDev.PodName == "" {
+	if pid == 0 {
 		cmd = fmt.Sprintf(EthToolCmd, netDev.IntfName)
 	} else {
-		netDevPid, ok := ec.na.podnameToPidCacheGet(podInfo)
-		if !ok {
-			err := fmt.Errorf("failed to get pid for netdev pod %s", netDev.PodName)
-			return err
-		}
-		cmd = fmt.Sprintf(PodNetnsExecCmd+EthToolCmd, netDevPid, netDev.IntfName)
+		cmd = fmt.Sprintf(PodNetnsExecCmd+EthToolCmd, pid, netDev.IntfName)
 	}
 
 	res, err := ExecWithContext(cmd, ec.na.cmdExec)
 	if err != nil {
-		logger.Log.Printf(
-			"failed to get intf stats for device %s, alias %s in pod %s: %v",
+		return nil, fmt.Errorf("failed to get intf stats for device %s, alias %s in pod %s: %v",
 			netDev.IntfName, netDev.IntfAlias, netDev.PodName, err)
-		return err
 	}
 
 	yamlifiedRes := yamlifyEthtoolOutput(res)
 
-	var ethtoolStats nicmetrics.EthtoolStats
-	err = yaml.Unmarshal(yamlifiedRes, &ethtoolStats)
+	ethtoolStats := &nicmetrics.EthtoolStats{}
+	err = yaml.Unmarshal(yamlifiedRes, ethtoolStats)
 	if err != nil {
-		logger.Log.Printf("failed to unmarshal ethtool stats for lif %s IntfAlias %s: %v",
-			labels[LabelEthIntfName], labels[LabelEthIntfAlias], err)
-		return err
+		return nil, fmt.Errorf("failed to unmarshal ethtool stats for device %s, alias %s: %v",
+			netDev.IntfName, netDev.IntfAlias, err)
+	}
+	return ethtoolStats, nil
+}
+
+func (ec *EthtoolClient) populateEthStatsForNetDevice(podInfo *scheduler.PodResourceInfo, netDev NetDevice, labels map[string]string) error {
+	pid := 0
+	if netDev.PodName != "" {
+		netDevPid, ok := ec.na.podnameToPidCacheGet(podInfo)
+		if !ok {
+			err := fmt.Errorf("failed to get pid for netdev pod %s", netDev.PodName)
+			return err
+		}
+		pid = netDevPid
+	}
+
+	// ethtool ioctl in the pod netns, exec ethtool when not permitted or
+	// not supported by the driver
+	ethtoolStats, err := getEthtoolStatsIoctl(pid, netDev.IntfName)
+	if err != nil {
+		logger.Debugf("ethtool ioctl failed for device %s in pod %s, using ethtool cmd: %v",
+			netDev.IntfName, netDev.PodName, err)
+		ethtoolStats, err = ec.getEthtoolStatsExec(pid, netDev)
+		if err != nil {
+			logger.Log.Printf("%v", err)
+			return err
+		}
 	}
 
 	ec.na.m.ethTxPackets.With(labels).Set(float64(ethtoolStats.TX_PACKETS))
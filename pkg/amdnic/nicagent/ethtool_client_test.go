@@ -0,0 +1,56 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestEthtoolClientIsActive(t *testing.T) {
+	saved := fetchEthtoolMetrics
+	fetchEthtoolMetrics = true
+	defer func() { fetchEthtoolMetrics = saved }()
+
+	// no command is mapped, listing the interfaces fails
+	ec := newEthtoolClient(&NICAgentClient{cmdExec: fakeCmdExec{}})
+	if !ec.IsActive() {
+		t.Fatalf("client should be active before the first update")
+	}
+	if err := ec.UpdateNICStats(context.Background(), nil); err != nil {
+		t.Fatalf("update failed: %v", err)
+	}
+	if ec.IsActive() {
+		t.Fatalf("client should be inactive when no interface could be queried")
+	}
+	if ec.lastErr == nil {
+		t.Errorf("expected the interface list error to be recorded")
+	}
+
+	// retried after the retry interval
+	ec.lastUpdate = time.Now().Add(-EthtoolRetryInterval)
+	if !ec.IsActive() {
+		t.Errorf("client should be retried after %v", EthtoolRetryInterval)
+	}
+
+	ec.lastUpdate = time.Now()
+	ec.queried = 2
+	if !ec.IsActive() {
+		t.Errorf("client should be active when interfaces were queried")
+	}
+}
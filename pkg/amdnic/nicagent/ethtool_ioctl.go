@@ -0,0 +1,199 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"runtime"
+	"unsafe"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetrics"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// ethtool string set of the nic statistics (ETH_SS_STATS)
+	ethSSStats = 1
+	// length of an ethtool string (ETH_GSTRING_LEN)
+	ethGStringLen = 32
+	// size of the ifreq union following the interface name
+	ifreqUnionSize = 24
+)
+
+// ethtoolIfreq is the ifreq passed to SIOCETHTOOL with ifr_data pointing to
+// the ethtool command buffer
+type ethtoolIfreq struct {
+	name [unix.IFNAMSIZ]byte
+	data unsafe.Pointer
+	_    [ifreqUnionSize - unsafe.Sizeof(uintptr(0))]byte
+}
+
+// ethtoolIoctl runs the ethtool command encoded in data on the interface
+func ethtoolIoctl(fd int, ifname string, data []byte) error {
+	if len(ifname) >= unix.IFNAMSIZ {
+		return unix.EINVAL
+	}
+	ifr := ethtoolIfreq{data: unsafe.Pointer(&data[0])}
+	copy(ifr.name[:], ifname)
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(fd), unix.SIOCETHTOOL, uintptr(unsafe.Pointer(&ifr)))
+	runtime.KeepAlive(data)
+	if errno != 0 {
+		return errno
+	}
+	return nil
+}
+
+// openNetnsSocket returns a socket in the network namespace of the process,
+// pid 0 is the exporter namespace. The socket keeps its namespace so the
+// ioctls can be issued from any thread.
+func openNetnsSocket(pid int) (int, error) {
+	if pid == 0 {
+		return unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	}
+	nsPath := fmt.Sprintf(PodNetnsPath, pid)
+	nsFd, err := unix.Open(nsPath, unix.O_RDONLY|unix.O_CLOEXEC, 0)
+	if err != nil {
+		return -1, fmt.Errorf("failed to open %s, %v", nsPath, err)
+	}
+	defer unix.Close(nsFd)
+
+	type result struct {
+		fd  int
+		err error
+	}
+	resCh := make(chan result, 1)
+	go func() {
+		// the thread is never unlocked so it exits with the goroutine instead
+		// of going back to the scheduler while in the pod namespace
+		runtime.LockOSThread()
+		if err := unix.Setns(nsFd, unix.CLONE_NEWNET); err != nil {
+			resCh <- result{-1, fmt.Errorf("failed to enter %s, %v", nsPath, err)}
+			return
+		}
+		fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+		resCh <- result{fd, err}
+	}()
+	res := <-resCh
+	return res.fd, res.err
+}
+
+// getEthtoolStatsCount returns the number of nic statistics of the interface
+func getEthtoolStatsCount(fd int, ifname string) (int, error) {
+	// struct ethtool_sset_info with room for a single set
+	buf := make([]byte, 20)
+	binary.NativeEndian.PutUint32(buf[0:], unix.ETHTOOL_GSSET_INFO)
+	binary.NativeEndian.PutUint64(buf[8:], 1<<ethSSStats)
+	if err := ethtoolIoctl(fd, ifname, buf); err != nil {
+		return 0, fmt.Errorf("ETHTOOL_GSSET_INFO failed for %s, %v", ifname, err)
+	}
+	if binary.NativeEndian.Uint64(buf[8:])&(1<<ethSSStats) == 0 {
+		return 0, nil
+	}
+	return int(binary.NativeEndian.Uint32(buf[16:])), nil
+}
+
+// parseEthtoolStrings parses the data of a struct ethtool_gstrings
+func parseEthtoolStrings(data []byte, count int) []string {
+	names := make([]string, 0, count)
+	for i := 0; i < count && (i+1)*ethGStringLen <= len(data); i++ {
+		name := data[i*ethGStringLen : (i+1)*ethGStringLen]
+		if idx := bytes.IndexByte(name, 0); idx >= 0 {
+			name = name[:idx]
+		}
+		names = append(names, string(name))
+	}
+	return names
+}
+
+// parseEthtoolStatsData parses the data of a struct ethtool_stats
+func parseEthtoolStatsData(data []byte, count int) []uint64 {
+	vals := make([]uint64, 0, count)
+	for i := 0; i < count && (i+1)*8 <= len(data); i++ {
+		vals = append(vals, binary.NativeEndian.Uint64(data[i*8:]))
+	}
+	return vals
+}
+
+// queryEthtoolStats returns the nic statistic names and values of the
+// interface through ETHTOOL_GSTRINGS and ETHTOOL_GSTATS
+func queryEthtoolStats(fd int, ifname string) ([]string, []uint64, error) {
+	count, err := getEthtoolStatsCount(fd, ifname)
+	if err != nil {
+		return nil, nil, err
+	}
+	if count == 0 {
+		return nil, nil, fmt.Errorf("no nic statistics for %s", ifname)
+	}
+
+	// struct ethtool_gstrings header is cmd, string_set and len
+	strBuf := make([]byte, 12+count*ethGStringLen)
+	binary.NativeEndian.PutUint32(strBuf[0:], unix.ETHTOOL_GSTRINGS)
+	binary.NativeEndian.PutUint32(strBuf[4:], ethSSStats)
+	binary.NativeEndian.PutUint32(strBuf[8:], uint32(count))
+	if err := ethtoolIoctl(fd, ifname, strBuf); err != nil {
+		return nil, nil, fmt.Errorf("ETHTOOL_GSTRINGS failed for %s, %v", ifname, err)
+	}
+
+	// struct ethtool_stats header is cmd and n_stats
+	statsBuf := make([]byte, 8+count*8)
+	binary.NativeEndian.PutUint32(statsBuf[0:], unix.ETHTOOL_GSTATS)
+	binary.NativeEndian.PutUint32(statsBuf[4:], uint32(count))
+	if err := ethtoolIoctl(fd, ifname, statsBuf); err != nil {
+		return nil, nil, fmt.Errorf("ETHTOOL_GSTATS failed for %s, %v", ifname, err)
+	}
+
+	// the driver may report less strings or stats than advertised
+	names := parseEthtoolStrings(strBuf[12:], int(binary.NativeEndian.Uint32(strBuf[8:])))
+	vals := parseEthtoolStatsData(statsBuf[8:], int(binary.NativeEndian.Uint32(statsBuf[4:])))
+	return names, vals, nil
+}
+
+// mapEthtoolStats maps the statistic names to the ethtool fields, the names
+// are the `ethtool -S` keys so the json tags of the fields are reused
+func mapEthtoolStats(names []string, vals []uint64) (*nicmetrics.EthtoolStats, error) {
+	counters := make(map[string]uint64, len(names))
+	for i := 0; i < len(names) && i < len(vals); i++ {
+		counters[names[i]] = vals[i]
+	}
+	data, err := json.Marshal(counters)
+	if err != nil {
+		return nil, err
+	}
+	stats := &nicmetrics.EthtoolStats{}
+	if err := json.Unmarshal(data, stats); err != nil {
+		return nil, fmt.Errorf("failed to map ethtool stats, %v", err)
+	}
+	return stats, nil
+}
+
+// getEthtoolStatsIoctl returns the ethtool statistics of the interface in the
+// network namespace of the process, pid 0 is the exporter namespace
+func getEthtoolStatsIoctl(pid int, ifname string) (*nicmetrics.EthtoolStats, error) {
+	fd, err := openNetnsSocket(pid)
+	if err != nil {
+		return nil, err
+	}
+	defer unix.Close(fd)
+	names, vals, err := queryEthtoolStats(fd, ifname)
+	if err != nil {
+		return nil, err
+	}
+	return mapEthtoolStats(names, vals)
+}
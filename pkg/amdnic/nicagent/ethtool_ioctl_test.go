@@ -0,0 +1,126 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"encoding/binary"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetrics"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v2"
+)
+
+// ethtool -S output recorded on an ionic interface
+const ethtoolFixture = "../../../docker/mockdata/nic/ethtool_S_enp68s0.txt"
+
+// encodeEthtoolBuffers encodes the statistics as returned by ETHTOOL_GSTRINGS
+// and ETHTOOL_GSTATS
+func encodeEthtoolBuffers(names []string, vals []uint64) ([]byte, []byte) {
+	strData := make([]byte, len(names)*ethGStringLen)
+	for i, name := range names {
+		copy(strData[i*ethGStringLen:(i+1)*ethGStringLen], name)
+	}
+	statsData := make([]byte, len(vals)*8)
+	for i, val := range vals {
+		binary.NativeEndian.PutUint64(statsData[i*8:], val)
+	}
+	return strData, statsData
+}
+
+func TestEthtoolIoctlMatchesExec(t *testing.T) {
+	res, err := os.ReadFile(ethtoolFixture)
+	if err != nil {
+		t.Fatalf("failed to read fixture: %v", err)
+	}
+
+	// exec path
+	execStats := &nicmetrics.EthtoolStats{}
+	if err := yaml.Unmarshal(yamlifyEthtoolOutput(res), execStats); err != nil {
+		t.Fatalf("failed to unmarshal ethtool output: %v", err)
+	}
+	if execStats.RX_PACKETS == 0 {
+		t.Fatalf("fixture parsed without rx_packets")
+	}
+
+	// ioctl path on the same statistics
+	names := []string{}
+	vals := []uint64{}
+	for _, line := range strings.Split(string(yamlifyEthtoolOutput(res)), "\n") {
+		kv := strings.SplitN(line, ":", 2)
+		if len(kv) != 2 {
+			continue
+		}
+		val, err := strconv.ParseUint(strings.TrimSpace(kv[1]), 10, 64)
+		if err != nil {
+			t.Fatalf("invalid fixture line %q", line)
+		}
+		names = append(names, kv[0])
+		vals = append(vals, val)
+	}
+	strData, statsData := encodeEthtoolBuffers(names, vals)
+	ioctlStats, err := mapEthtoolStats(parseEthtoolStrings(strData, len(names)), parseEthtoolStatsData(statsData, len(vals)))
+	if err != nil {
+		t.Fatalf("mapEthtoolStats failed: %v", err)
+	}
+	if !proto.Equal(execStats, ioctlStats) {
+		t.Errorf("ioctl stats %v differ from exec stats %v", ioctlStats, execStats)
+	}
+}
+
+func TestParseEthtoolStrings(t *testing.T) {
+	longName := strings.Repeat("x", ethGStringLen)
+	strData, _ := encodeEthtoolBuffers([]string{"tx_packets", longName, "rx_bytes"}, nil)
+	tests := []struct {
+		name     string
+		data     []byte
+		count    int
+		expected []string
+	}{
+		{"all strings", strData, 3, []string{"tx_packets", longName, "rx_bytes"}},
+		{"count below buffer", strData, 1, []string{"tx_packets"}},
+		{"truncated buffer", strData[:ethGStringLen*2+4], 3, []string{"tx_packets", longName}},
+		{"empty", nil, 2, []string{}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseEthtoolStrings(tt.data, tt.count); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("parseEthtoolStrings() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestMapEthtoolStats(t *testing.T) {
+	_, statsData := encodeEthtoolBuffers(nil, []uint64{10, 20, 30, 40})
+	vals := parseEthtoolStatsData(statsData[:len(statsData)-3], 4)
+	if !reflect.DeepEqual(vals, []uint64{10, 20, 30}) {
+		t.Fatalf("parseEthtoolStatsData() = %v, want [10 20 30]", vals)
+	}
+	// unknown names are ignored, names without a value are dropped
+	stats, err := mapEthtoolStats([]string{"tx_packets", "xdp_drop", "rx_0_dropped", "rx_bytes"}, vals)
+	if err != nil {
+		t.Fatalf("mapEthtoolStats failed: %v", err)
+	}
+	if stats.TX_PACKETS != 10 || stats.RX_0_DROPPED != 30 || stats.RX_BYTES != 0 {
+		t.Errorf("unexpected mapped stats %v", stats)
+	}
+}
@@ -34,7 +34,8 @@ type NICInterface interface {
 }
 
 // NIC represents the card data
-// NICToPort: currently it's a 1:1 mapping but this has to be changed if the ports are getting breaked down further
+// A card has one or more ports, breakout splits a physical port further into
+// multiple ports each with a PF of its own
 type NIC struct {
 	Index                 string
 	UUID                  string           `json:"id"`
@@ -49,10 +50,13 @@ type NIC struct {
 
 // Port represents the network port data
 type Port struct {
-	Index      string
-	UUID       string `json:"id"`
-	Name       string `json:"name"`
-	MACAddress string
+	Index        string
+	UUID         string `json:"id"`
+	Name         string `json:"name"`
+	MACAddress   string
+	PhysicalPort string // physical port number, shared by breakout ports
+	MACChannel   string // channel within the physical port
+	PCIeAddress  string // pcie bus id of the port PF
 }
 
 // LIf represents the logical interface data
@@ -60,7 +64,10 @@ type Lif struct {
 	Index       string
 	UUID        string
 	Name        string
+	MACAddress  string
 	PCIeAddress string
+	PFID        string // PF the lif belongs to, the PF itself for a PF lif
+	PortID      string // port the lif is attached to
 	IsPF        bool
 }
 
@@ -72,30 +79,63 @@ type NetDevice struct {
 	PodName     string // PID of namespace which contains the Intf
 }
 
-// GetPortName returns the name of the first port associated with the NIC.
-// This is a simplified method assuming a 1:1 mapping between NIC and Port.
-func (n *NIC) GetPortName() string {
-	for _, port := range n.Ports {
+// GetPort returns the port with the given UUID, the only port of a single
+// port NIC is returned when the UUID is not known
+func (n *NIC) GetPort(uuid string) *Port {
+	if port, ok := n.Ports[uuid]; ok {
+		return port
+	}
+	if len(n.Ports) == 1 {
+		for _, port := range n.Ports {
+			return port
+		}
+	}
+	return nil
+}
+
+// GetPortName returns the name of the port with the given UUID.
+func (n *NIC) GetPortName(uuid string) string {
+	if port := n.GetPort(uuid); port != nil {
 		return port.Name
 	}
 	return ""
 }
 
-// GetPortIndex returns the index of the first port associated with the NIC.
-func (n *NIC) GetPortIndex() string {
-	for _, port := range n.Ports {
+// GetPortIndex returns the index of the port with the given UUID.
+func (n *NIC) GetPortIndex(uuid string) string {
+	if port := n.GetPort(uuid); port != nil {
 		return port.Index
 	}
 	return ""
 }
 
-// GetPortPcieAddr returns the pcie bus id of the Port associated with the given NIC.
-// When multi-port NIC support is added later , all 3 port fns in this file needs
-// to be modified to take port UUID as input param.
-func (n *NIC) GetPortPcieAddr() string {
+// GetPortPcieAddr returns the pcie bus id of the PF of the port with the
+// given UUID, the card eth BDF when the PF is not known.
+func (n *NIC) GetPortPcieAddr(uuid string) string {
+	if port := n.GetPort(uuid); port != nil && port.PCIeAddress != "" {
+		return port.PCIeAddress
+	}
 	return n.EthBDF
 }
 
+// GetPortNetDev returns the network device name of the PF of the port.
+func (n *NIC) GetPortNetDev(uuid string) string {
+	for _, lif := range n.Lifs {
+		if lif.IsPF && lif.PortID == uuid {
+			return lif.Name
+		}
+	}
+	return ""
+}
+
+// GetLifPortName returns the name of the port the lif is attached to.
+func (n *NIC) GetLifPortName(uuid string) string {
+	if lif, ok := n.Lifs[uuid]; ok {
+		return n.GetPortName(lif.PortID)
+	}
+	return ""
+}
+
 // GetLifName returns the name of the lif associated with the given UUID.
 func (n *NIC) GetLifName(uuid string) string {
 	if lif, ok := n.Lifs[uuid]; ok {
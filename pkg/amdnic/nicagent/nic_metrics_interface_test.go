@@ -0,0 +1,183 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init(false)
+	os.Exit(m.Run())
+}
+
+// fakeCmdExec returns the content of the fixture mapped to the command
+type fakeCmdExec map[string]string
+
+func (f fakeCmdExec) Run(cmd string) ([]byte, error) {
+	file, ok := f[cmd]
+	if !ok {
+		return nil, fmt.Errorf("unexpected command %q", cmd)
+	}
+	return os.ReadFile(filepath.Join("testdata", "multiport", file))
+}
+
+func (f fakeCmdExec) RunWithContext(_ context.Context, cmd string) ([]byte, error) {
+	return f.Run(cmd)
+}
+
+// fakeNetDevSysfs links the network devices to their pci devices
+func fakeNetDevSysfs(t *testing.T, netDevs map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for netDev, addr := range netDevs {
+		dir := filepath.Join(root, "class/net", netDev)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink("../../../devices/pci0000:40/0000:40:01.1/"+addr, filepath.Join(dir, "device")); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+type expectedPort struct {
+	name  string
+	index string
+	pcie  string
+}
+
+type expectedLif struct {
+	port string
+	pcie string
+	isPF bool
+}
+
+func TestGetNICsMultiPort(t *testing.T) {
+	tests := []struct {
+		name    string
+		fixture string
+		card    string
+		netDevs map[string]string
+		ports   map[string]expectedPort
+		lifs    map[string]expectedLif
+	}{
+		{
+			name:    "2 port",
+			fixture: "2port",
+			card:    "42424650-4c32-3530-3330-303432000000",
+			// the PF of the first port is not visible, falls back to eth_bdf
+			netDevs: map[string]string{
+				"enp68s0f1":   "0000:44:00.1",
+				"enp68s0f1v0": "0000:44:00.3",
+			},
+			ports: map[string]expectedPort{
+				"0490812c-4f90-4242-4242-000011010000": {"eth1/1", "0", "0000:44:00.0"},
+				"0490812c-4f90-4242-4242-000012010000": {"eth2/1", "1", "0000:44:00.1"},
+			},
+			lifs: map[string]expectedLif{
+				"43000070-0100-0000-4242-0490812c4f90": {"eth1/1", "0000:44:00.0", true},
+				"43000070-0100-0000-4242-0490812c4fa0": {"eth2/1", "0000:44:00.1", true},
+				"44000070-0100-0000-4242-0490812c4fa1": {"eth2/1", "0000:44:00.3", false},
+			},
+		},
+		{
+			name:    "4 port breakout",
+			fixture: "4port",
+			card:    "42424650-4c32-3434-3530-304434000000",
+			netDevs: map[string]string{
+				"enp132s0f0": "0000:84:00.0",
+				"enp132s0f1": "0000:84:00.1",
+				"enp132s0f2": "0000:84:00.2",
+				"enp132s0f3": "0000:84:00.3",
+			},
+			ports: map[string]expectedPort{
+				"0490812a-2c28-4242-4242-000011010001": {"eth1/1/1", "0", "0000:84:00.0"},
+				"0490812a-2c28-4242-4242-000011010002": {"eth1/1/2", "1", "0000:84:00.1"},
+				"0490812a-2c28-4242-4242-000011010003": {"eth1/1/3", "2", "0000:84:00.2"},
+				"0490812a-2c28-4242-4242-000011010004": {"eth1/1/4", "3", "0000:84:00.3"},
+			},
+			lifs: map[string]expectedLif{
+				"43000070-0100-0000-4242-0490812a2c28": {"eth1/1/1", "0000:84:00.0", true},
+				"43000070-0100-0000-4242-0490812a2c29": {"eth1/1/2", "0000:84:00.1", true},
+				"43000070-0100-0000-4242-0490812a2c2a": {"eth1/1/3", "0000:84:00.2", true},
+				"43000070-0100-0000-4242-0490812a2c2b": {"eth1/1/4", "0000:84:00.3", true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			na := &NICAgentClient{
+				cmdExec: fakeCmdExec{
+					"nicctl show card -j": "nicctl_show_card_" + tt.fixture + "_j.json",
+					fmt.Sprintf("nicctl show port --card %s -j", tt.card): "nicctl_show_port_" + tt.fixture + "_j.json",
+					fmt.Sprintf("nicctl show lif --card %s -j", tt.card):  "nicctl_show_lif_" + tt.fixture + "_j.json",
+				},
+				sysfsRoot: fakeNetDevSysfs(t, tt.netDevs),
+			}
+			nics, err := na.getNICs()
+			if err != nil {
+				t.Fatalf("getNICs failed: %v", err)
+			}
+			nic, ok := nics[tt.card]
+			if !ok {
+				t.Fatalf("card %s not found in %v", tt.card, nics)
+			}
+			if len(nic.Ports) != len(tt.ports) || len(nic.Lifs) != len(tt.lifs) {
+				t.Fatalf("got %d ports, %d lifs; want %d, %d", len(nic.Ports), len(nic.Lifs), len(tt.ports), len(tt.lifs))
+			}
+			for uuid, want := range tt.ports {
+				got := expectedPort{nic.GetPortName(uuid), nic.GetPortIndex(uuid), nic.GetPortPcieAddr(uuid)}
+				if got != want {
+					t.Errorf("port %s got %+v, want %+v", uuid, got, want)
+				}
+			}
+			for uuid, want := range tt.lifs {
+				got := expectedLif{nic.GetLifPortName(uuid), nic.GetLifPcieAddr(uuid), nic.Lifs[uuid].IsPF}
+				if got != want {
+					t.Errorf("lif %s got %+v, want %+v", uuid, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetPortSinglePort(t *testing.T) {
+	nic := &NIC{
+		EthBDF: "0000:44:00.0",
+		Ports:  indexPorts([]*Port{{UUID: "p1", Name: "eth1/1"}}),
+	}
+	// single port cards resolve unknown port ids to the only port
+	if got := nic.GetPortName(""); got != "eth1/1" {
+		t.Errorf("GetPortName() = %q, want eth1/1", got)
+	}
+	if got := nic.GetPortPcieAddr("p1"); got != "0000:44:00.0" {
+		t.Errorf("GetPortPcieAddr() = %q, want the card eth bdf", got)
+	}
+	nic.Ports = indexPorts([]*Port{{UUID: "p1", Name: "eth1/1", PhysicalPort: "1"}, {UUID: "p2", Name: "eth2/1", PhysicalPort: "2"}})
+	if got := nic.GetPortName("unknown"); got != "" {
+		t.Errorf("GetPortName() = %q, want empty for an unknown port of a multi port card", got)
+	}
+}
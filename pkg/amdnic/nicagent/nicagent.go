@@ -59,6 +59,9 @@ type NICAgentClient struct {
 	podnameToPidCache       *lru.Cache[string, int]
 	podnameToNetDeviceCache *lru.Cache[string, []NetDevice]
 	cmdExec                 cmdexec.CommandExecuter
+	sysfsRoot               string
+	health                  *nicHealthTracker
+	rates                   *nicRateTracker
 }
 
 // NICAgentClientOptions defines the options for the NICAgentClient
@@ -126,7 +129,10 @@ func NewAgent(mh *metricsutil.MetricsHandler, opts ...NICAgentClientOptions) *NI
 		nodeHealthLabellerCfg: &utils.NodeHealthLabellerConfig{
 			LabelPrefix: globals.NICHealthLabelPrefix,
 		},
-		cmdExec: cmdexec.NewExecuter(),
+		cmdExec:   cmdexec.NewExecuter(),
+		sysfsRoot: DefaultSysfsRoot,
+		health:    newNICHealthTracker(),
+		rates:     newNICRateTracker(),
 	}
 
 	for _, o := range opts {
@@ -169,6 +175,9 @@ func (na *NICAgentClient) Init() error {
 	ethtoolClient := newEthtoolClient(na)
 	na.nicClients = append(na.nicClients, ethtoolClient)
 
+	transceiverClient := newTransceiverClient(na)
+	na.nicClients = append(na.nicClients, transceiverClient)
+
 	err = na.initClients()
 	if err != nil {
 		logger.Log.Printf("NIC clients init failure err :%v", err)
@@ -190,6 +199,7 @@ func (na *NICAgentClient) Init() error {
 	}
 	na.nics = nics
 	na.printNICs()
+	na.updateTopology()
 
 	return nil
 }
@@ -198,6 +208,10 @@ func (na *NICAgentClient) addRdmaDevPcieAddrIfAbsent(rdmaDev string) error {
 	na.Lock()
 	defer na.Unlock()
 	if _, ok := na.rdmaDevToPcieAddr[rdmaDev]; !ok {
+		if addr, err := readRdmaSysfsDevPcieAddr(na.sysfsRoot, rdmaDev); err == nil {
+			na.rdmaDevToPcieAddr[rdmaDev] = addr
+			return nil
+		}
 		cmd := fmt.Sprintf(GetPcieAddrFromRdmaDevCmd, rdmaDev)
 		out, err := ExecWithContext(cmd, na.cmdExec)
 		if err != nil {
@@ -212,6 +226,15 @@ func (na *NICAgentClient) addRdmaDevPcieAddrIfAbsent(rdmaDev string) error {
 	return nil
 }
 
+// getRdmaDevVendor returns the vendor id of the rdma device from sysfs and
+// falls back to the command executer
+func (na *NICAgentClient) getRdmaDevVendor(rdmaDev string) (string, error) {
+	if vendorID, err := readRdmaSysfsDevVendor(na.sysfsRoot, rdmaDev); err == nil {
+		return vendorID, nil
+	}
+	return getVendor(rdmaDev, na.cmdExec)
+}
+
 func (na *NICAgentClient) addPodPidIfAbsent(podName string, podNamespace string) error {
 	na.Lock()
 	defer na.Unlock()
@@ -352,7 +375,7 @@ func (na *NICAgentClient) getNetDevicesList(podInfo *scheduler.PodResourceInfo)
 		for i, p := range parts {
 			if p == "link" && i+1 < partsLen {
 				roceDevName = strings.Split(parts[i+1], "/")[0]
-				vendorID, err := getVendor(roceDevName, na.cmdExec)
+				vendorID, err := na.getRdmaDevVendor(roceDevName)
 				if err != nil {
 					logger.Log.Printf("failed to get vendor ID for %s: %v", roceDevName, err)
 					roceDevName = ""
@@ -431,6 +454,7 @@ func (na *NICAgentClient) initLocalCacheIfRequired() {
 					logger.Log.Printf("failed get NICs, Ports and Lifs, err: %v", err)
 				} else {
 					na.nics = nics
+					na.updateTopology()
 				}
 			}
 		}
@@ -460,6 +484,7 @@ func (na *NICAgentClient) getMetricsAll(ctx context.Context) error {
 
 	nonNICLabels := na.populateNonNICLabels()
 	na.m.nicNodesTotal.With(nonNICLabels).Set(float64(len(na.nics)))
+	na.updateNICInfoMetrics()
 
 	for _, client := range na.nicClients {
 		wg.Add(1)
@@ -499,6 +524,10 @@ func (na *NICAgentClient) sendNodeLabelUpdate(healthState map[string]interface{}
 			continue
 		}
 
+		if hs.Reason != "" {
+			logger.Log.Printf("NIC %s is %s, reason: %s", nicPCIeAddr, hs.Health, hs.Reason)
+		}
+
 		nicPCIeAddr = strings.ReplaceAll(nicPCIeAddr, ":", "_") // replace ':' with '_' for label compatibility
 		nicPCIeAddr = strings.ReplaceAll(nicPCIeAddr, ".", "_")
 		nicHealthStates[nicPCIeAddr] = hs.Health
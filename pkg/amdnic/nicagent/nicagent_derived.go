@@ -0,0 +1,208 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+// counters the derived metrics are computed from
+const (
+	derivedRdmaRxCnp   = "rx_cnp"
+	derivedRdmaTxCnp   = "tx_cnp"
+	derivedRdmaRxEcn   = "rx_ecn"
+	derivedRdmaTxPkts  = "tx_pkts"
+	derivedRdmaRetrans = "retransmissions"
+	derivedRdmaRnrNak  = "rnr_nak"
+	derivedPortRxBytes = "rx_bytes"
+	derivedPortTxBytes = "tx_bytes"
+)
+
+var (
+	// window of the derived rate metrics
+	derivedMetricsWindow = DefaultDerivedMetricsWindow
+
+	derivedRdmaFields = []string{
+		exportermetrics.NICMetricField_RDMA_RX_CNP_RATE.String(),
+		exportermetrics.NICMetricField_RDMA_TX_CNP_RATE.String(),
+		exportermetrics.NICMetricField_RDMA_RX_ECN_RATE.String(),
+		exportermetrics.NICMetricField_RDMA_RETRANSMISSION_RATIO.String(),
+		exportermetrics.NICMetricField_RDMA_RNR_NAK_RATE.String(),
+	}
+	derivedPortFields = []string{
+		exportermetrics.NICMetricField_NIC_PORT_STATS_RX_BYTES_RATE.String(),
+		exportermetrics.NICMetricField_NIC_PORT_STATS_TX_BYTES_RATE.String(),
+	}
+)
+
+// counterDelta is the increase of a counter within the window and the time
+// it covers
+type counterDelta struct {
+	increase uint64
+	elapsed  time.Duration
+}
+
+type counterDeltas map[string]counterDelta
+
+// rate returns the per second increase of the counter, false until the
+// window holds two samples
+func (d counterDeltas) rate(name string) (float64, bool) {
+	delta, ok := d[name]
+	if !ok || delta.elapsed <= 0 {
+		return 0, false
+	}
+	return float64(delta.increase) / delta.elapsed.Seconds(), true
+}
+
+// ratio returns the increase of the numerator counter over the increase of
+// the denominator counter, false when the denominator did not increase
+func (d counterDeltas) ratio(num, den string) (float64, bool) {
+	n, nok := d[num]
+	dd, dok := d[den]
+	if !nok || !dok || dd.elapsed <= 0 || dd.increase == 0 {
+		return 0, false
+	}
+	return float64(n.increase) / float64(dd.increase), true
+}
+
+// nicRateTracker keeps the counter samples of the derived metrics across
+// scrapes
+type nicRateTracker struct {
+	sync.Mutex
+	windows map[string]*counterWindow
+	now     func() time.Time
+}
+
+func newNICRateTracker() *nicRateTracker {
+	return &nicRateTracker{
+		windows: make(map[string]*counterWindow),
+		now:     time.Now,
+	}
+}
+
+// sample records the counters of the device and returns their increase
+// within the window
+func (t *nicRateTracker) sample(device string, counters map[string]uint64, window time.Duration) counterDeltas {
+	t.Lock()
+	defer t.Unlock()
+	now := t.now()
+	deltas := make(counterDeltas, len(counters))
+	for name, val := range counters {
+		key := device + "/" + name
+		w, ok := t.windows[key]
+		if !ok {
+			w = &counterWindow{}
+			t.windows[key] = w
+		}
+		inc := w.increase(now, val, window)
+		deltas[name] = counterDelta{increase: inc, elapsed: w.span()}
+	}
+	return deltas
+}
+
+// prune drops the samples of the devices not seen for twice the window
+func (t *nicRateTracker) prune(window time.Duration) {
+	t.Lock()
+	defer t.Unlock()
+	expiry := t.now().Add(-2 * window)
+	for key, w := range t.windows {
+		if len(w.samples) == 0 || w.samples[len(w.samples)-1].ts.Before(expiry) {
+			delete(t.windows, key)
+		}
+	}
+}
+
+func (na *NICAgentClient) initDerivedMetricsConfig(config *exportermetrics.NICMetricConfig) {
+	derivedMetricsWindow = DefaultDerivedMetricsWindow
+	if window := config.GetDerivedMetricsWindow(); window != "" {
+		d, err := time.ParseDuration(window)
+		if err != nil || d <= 0 {
+			logger.Log.Printf("invalid derived metrics window %q, using %v", window, DefaultDerivedMetricsWindow)
+		} else {
+			derivedMetricsWindow = d
+		}
+	}
+	logger.Log.Printf("derived metrics window set to %v", derivedMetricsWindow)
+}
+
+// isAnyFieldEnabled returns true if one of the fields is exported
+func (na *NICAgentClient) isAnyFieldEnabled(fields []string) bool {
+	for _, field := range fields {
+		if na.isFieldEnabled(field) {
+			return true
+		}
+	}
+	return false
+}
+
+// rdmaDerivedCounters returns the rdma counters the derived metrics are
+// computed from, retransmissions are triggered by sequence error NAKs and
+// local ack timeouts
+func rdmaDerivedCounters(stats *nicmetrics.RDMAStats) map[string]uint64 {
+	return map[string]uint64{
+		derivedRdmaRxCnp:  stats.RDMA_RX_CNP_PKTS,
+		derivedRdmaTxCnp:  stats.RDMA_TX_CNP_PKTS,
+		derivedRdmaRxEcn:  stats.RDMA_RX_ECN_PKTS,
+		derivedRdmaTxPkts: stats.RDMA_TX_UCAST_PKTS,
+		derivedRdmaRetrans: stats.RDMA_REQ_RX_PKT_SEQ_ERR + stats.RDMA_REQ_RX_IMPL_NAK_SEQ_ERR +
+			stats.RDMA_LOCAL_ACK_TIMEOUT_ERR,
+		derivedRdmaRnrNak: stats.RDMA_REQ_RX_RNR_RETRY_ERR + stats.RDMA_RESP_TX_RNR_RETRY_ERR,
+	}
+}
+
+// updateDerivedRdmaMetrics exports the rates of the rdma device port
+func (na *NICAgentClient) updateDerivedRdmaMetrics(stats *nicmetrics.RDMAStats, labels map[string]string) {
+	device := fmt.Sprintf("rdma/%s/%d", stats.IFNAME, stats.PORT)
+	deltas := na.rates.sample(device, rdmaDerivedCounters(stats), derivedMetricsWindow)
+	if rate, ok := deltas.rate(derivedRdmaRxCnp); ok {
+		na.m.rdmaRxCnpRate.With(labels).Set(rate)
+	}
+	if rate, ok := deltas.rate(derivedRdmaTxCnp); ok {
+		na.m.rdmaTxCnpRate.With(labels).Set(rate)
+	}
+	if rate, ok := deltas.rate(derivedRdmaRxEcn); ok {
+		na.m.rdmaRxEcnRate.With(labels).Set(rate)
+	}
+	if ratio, ok := deltas.ratio(derivedRdmaRetrans, derivedRdmaTxPkts); ok {
+		na.m.rdmaRetransmissionRatio.With(labels).Set(ratio)
+	}
+	if rate, ok := deltas.rate(derivedRdmaRnrNak); ok {
+		na.m.rdmaRnrNakRate.With(labels).Set(rate)
+	}
+}
+
+// updateDerivedPortMetrics exports the byte rates of the port
+func (na *NICAgentClient) updateDerivedPortMetrics(portUUID string, stats *nicmetrics.PortStats, labels map[string]string) {
+	counters := map[string]uint64{
+		derivedPortRxBytes: utils.StringToUint64(stats.OCTETS_RX_OK),
+		derivedPortTxBytes: utils.StringToUint64(stats.OCTETS_TX_OK),
+	}
+	deltas := na.rates.sample("port/"+portUUID, counters, derivedMetricsWindow)
+	if rate, ok := deltas.rate(derivedPortRxBytes); ok {
+		na.m.nicPortStatsRxBytesRate.With(labels).Set(rate)
+	}
+	if rate, ok := deltas.rate(derivedPortTxBytes); ok {
+		na.m.nicPortStatsTxBytesRate.With(labels).Set(rate)
+	}
+}
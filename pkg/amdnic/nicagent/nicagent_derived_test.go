@@ -0,0 +1,113 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetrics"
+)
+
+func TestNICRateTracker(t *testing.T) {
+	type want struct {
+		rxCnpRate float64
+		rxCnpOk   bool
+		ratio     float64
+		ratioOk   bool
+	}
+	type poll struct {
+		offset time.Duration
+		stats  *nicmetrics.RDMAStats
+		want   want
+	}
+	tests := []struct {
+		name   string
+		window time.Duration
+		polls  []poll
+	}{
+		{
+			name:   "rates between scrapes",
+			window: time.Minute,
+			polls: []poll{
+				{0, &nicmetrics.RDMAStats{RDMA_RX_CNP_PKTS: 100, RDMA_TX_UCAST_PKTS: 1000}, want{}},
+				{10 * time.Second, &nicmetrics.RDMAStats{RDMA_RX_CNP_PKTS: 200, RDMA_TX_UCAST_PKTS: 2000, RDMA_REQ_RX_PKT_SEQ_ERR: 5},
+					want{10, true, 0.005, true}},
+				{20 * time.Second, &nicmetrics.RDMAStats{RDMA_RX_CNP_PKTS: 400, RDMA_TX_UCAST_PKTS: 3000, RDMA_REQ_RX_PKT_SEQ_ERR: 5, RDMA_LOCAL_ACK_TIMEOUT_ERR: 5},
+					want{15, true, 0.005, true}},
+			},
+		},
+		{
+			name:   "window slides",
+			window: 30 * time.Second,
+			polls: []poll{
+				{0, &nicmetrics.RDMAStats{RDMA_RX_CNP_PKTS: 0, RDMA_TX_UCAST_PKTS: 0}, want{}},
+				{30 * time.Second, &nicmetrics.RDMAStats{RDMA_RX_CNP_PKTS: 300, RDMA_TX_UCAST_PKTS: 100}, want{10, true, 0, true}},
+				{60 * time.Second, &nicmetrics.RDMAStats{RDMA_RX_CNP_PKTS: 300, RDMA_TX_UCAST_PKTS: 100}, want{0, true, 0, false}},
+			},
+		},
+		{
+			name:   "counter cleared",
+			window: time.Minute,
+			polls: []poll{
+				{0, &nicmetrics.RDMAStats{RDMA_RX_CNP_PKTS: 1000}, want{}},
+				{10 * time.Second, &nicmetrics.RDMAStats{RDMA_RX_CNP_PKTS: 10}, want{}},
+				{20 * time.Second, &nicmetrics.RDMAStats{RDMA_RX_CNP_PKTS: 60}, want{5, true, 0, false}},
+			},
+		},
+	}
+	start := time.Unix(1700000000, 0)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := start
+			tracker := newNICRateTracker()
+			tracker.now = func() time.Time { return now }
+			for i, p := range tt.polls {
+				now = start.Add(p.offset)
+				deltas := tracker.sample("rdma/rocep68s0/1", rdmaDerivedCounters(p.stats), tt.window)
+				rate, ok := deltas.rate(derivedRdmaRxCnp)
+				if ok != p.want.rxCnpOk || math.Abs(rate-p.want.rxCnpRate) > 1e-9 {
+					t.Errorf("poll %d: rx cnp rate = %v, %v, want %v, %v", i, rate, ok, p.want.rxCnpRate, p.want.rxCnpOk)
+				}
+				ratio, ok := deltas.ratio(derivedRdmaRetrans, derivedRdmaTxPkts)
+				if ok != p.want.ratioOk || math.Abs(ratio-p.want.ratio) > 1e-9 {
+					t.Errorf("poll %d: retransmission ratio = %v, %v, want %v, %v", i, ratio, ok, p.want.ratio, p.want.ratioOk)
+				}
+			}
+		})
+	}
+}
+
+func TestNICRateTrackerPrune(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	now := start
+	tracker := newNICRateTracker()
+	tracker.now = func() time.Time { return now }
+	tracker.sample("port/p1", map[string]uint64{derivedPortRxBytes: 1}, time.Minute)
+	now = start.Add(90 * time.Second)
+	tracker.sample("port/p2", map[string]uint64{derivedPortRxBytes: 1}, time.Minute)
+	tracker.prune(time.Minute)
+	if len(tracker.windows) != 2 {
+		t.Fatalf("expected both devices to be kept, got %v", len(tracker.windows))
+	}
+	now = start.Add(150 * time.Second)
+	tracker.prune(time.Minute)
+	if _, ok := tracker.windows["port/p1/"+derivedPortRxBytes]; ok || len(tracker.windows) != 1 {
+		t.Fatalf("expected the stale device to be dropped, got %v", tracker.windows)
+	}
+}
@@ -20,18 +20,200 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetricssvc"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
 )
 
+// counters evaluated by the NIC health rules, port counters are keyed by the
+// port id and rdma counters by the pcie address of the rdma device
+const (
+	healthCounterLinkDown        = "link_down_events"
+	healthCounterRSFEC           = "rsfec_uncorrectable_word"
+	healthCounterPauseFrames     = "pause_frames"
+	healthCounterRDMARetryExcd   = "req_tx_retry_excd_err"
+	healthCounterRDMAAckTimeouts = "local_ack_timeout_err"
+)
+
+// nicHealthRule reports a lif as unhealthy when the counter of its port or
+// rdma device increases by at least the threshold within the window
+type nicHealthRule struct {
+	counter   string
+	perPort   bool
+	threshold uint64
+	window    time.Duration
+	reason    string // reason format of the increase, device and window
+}
+
+// nicHealthData is the state of the NIC ports and rdma devices at one poll
+type nicHealthData struct {
+	operStatus map[string]string            // port operational status by port id
+	counters   map[string]map[string]uint64 // counter values by counter name and device
+}
+
+type counterSample struct {
+	ts  time.Time
+	val uint64
+}
+
+// counterWindow keeps the samples of a counter covering the rule window
+type counterWindow struct {
+	samples []counterSample
+}
+
+// increase adds the sample and returns the counter increase within the
+// window, the newest sample at or before the window start is the baseline
+func (w *counterWindow) increase(now time.Time, val uint64, window time.Duration) uint64 {
+	if n := len(w.samples); n > 0 && val < w.samples[n-1].val {
+		// counter was cleared, restart from the new value
+		w.samples = w.samples[:0]
+	}
+	w.samples = append(w.samples, counterSample{ts: now, val: val})
+	start := now.Add(-window)
+	for len(w.samples) > 1 && !w.samples[1].ts.After(start) {
+		w.samples = w.samples[1:]
+	}
+	return val - w.samples[0].val
+}
+
+// span returns the time covered by the samples of the window
+func (w *counterWindow) span() time.Duration {
+	if len(w.samples) == 0 {
+		return 0
+	}
+	return w.samples[len(w.samples)-1].ts.Sub(w.samples[0].ts)
+}
+
+// nicHealthTracker keeps the counter history of the health rules across polls
+type nicHealthTracker struct {
+	sync.Mutex
+	windows map[string]*counterWindow
+	now     func() time.Time
+}
+
+func newNICHealthTracker() *nicHealthTracker {
+	return &nicHealthTracker{
+		windows: make(map[string]*counterWindow),
+		now:     time.Now,
+	}
+}
+
+// update records the polled counters of the rules and returns the increase
+// within the rule window by counter name and device
+func (t *nicHealthTracker) update(rules []nicHealthRule, data *nicHealthData) map[string]map[string]uint64 {
+	t.Lock()
+	defer t.Unlock()
+	now := t.now()
+	increases := make(map[string]map[string]uint64, len(rules))
+	seen := map[string]bool{}
+	for _, rule := range rules {
+		increases[rule.counter] = make(map[string]uint64)
+		for device, val := range data.counters[rule.counter] {
+			key := rule.counter + "/" + device
+			w, ok := t.windows[key]
+			if !ok {
+				w = &counterWindow{}
+				t.windows[key] = w
+			}
+			increases[rule.counter][device] = w.increase(now, val, rule.window)
+			seen[key] = true
+		}
+	}
+	// drop the history of disabled rules and removed devices
+	for key := range t.windows {
+		if !seen[key] {
+			delete(t.windows, key)
+		}
+	}
+	return increases
+}
+
+// parseHealthWindow returns the window of the counter rule, the default
+// window is used for an empty or invalid window
+func parseHealthWindow(window string) time.Duration {
+	if window == "" {
+		return DefaultNICHealthWindow
+	}
+	d, err := time.ParseDuration(window)
+	if err != nil || d <= 0 {
+		logger.Log.Printf("invalid NIC health rule window %q, using %v", window, DefaultNICHealthWindow)
+		return DefaultNICHealthWindow
+	}
+	return d
+}
+
+// getNICHealthRules returns the enabled counter rules of the health config
+func getNICHealthRules(cfg *exportermetrics.NICHealthCheckConfig) []nicHealthRule {
+	ruleCfgs := []struct {
+		cfg     *exportermetrics.NICHealthCounterRule
+		counter string
+		perPort bool
+		reason  string
+	}{
+		{cfg.GetLinkFlap(), healthCounterLinkDown, true, "link flap: %d link down events on %s within %v"},
+		{cfg.GetRSFECUncorrectable(), healthCounterRSFEC, true, "rsfec uncorrectable: %d uncorrectable words on %s within %v"},
+		{cfg.GetPFCPauseStorm(), healthCounterPauseFrames, true, "pfc pause storm: %d pause frames on %s within %v"},
+		{cfg.GetRDMARetryExceeded(), healthCounterRDMARetryExcd, false, "rdma retry exceeded: %d errors on %s within %v"},
+		{cfg.GetRDMALocalAckTimeout(), healthCounterRDMAAckTimeouts, false, "rdma local ack timeout: %d timeouts on %s within %v"},
+	}
+	rules := []nicHealthRule{}
+	for _, rc := range ruleCfgs {
+		if rc.cfg.GetThreshold() == 0 {
+			continue
+		}
+		rules = append(rules, nicHealthRule{
+			counter:   rc.counter,
+			perPort:   rc.perPort,
+			threshold: rc.cfg.GetThreshold(),
+			window:    parseHealthWindow(rc.cfg.GetWindow()),
+			reason:    rc.reason,
+		})
+	}
+	return rules
+}
+
+// lifHealthReasons returns the reasons of the lif to be unhealthy from the
+// port and rdma device state, empty when no rule fails
+func lifHealthReasons(nic *NIC, lif *Lif, linkDownCheck bool, rules []nicHealthRule,
+	data *nicHealthData, increases map[string]map[string]uint64) []string {
+	reasons := []string{}
+	port, hasPort := nic.Ports[lif.PortID]
+	if linkDownCheck && hasPort {
+		if status, ok := data.operStatus[port.UUID]; ok && !strings.EqualFold(status, nicmetricssvc.AdminState_UP.String()) {
+			reasons = append(reasons, fmt.Sprintf("link down on %s", port.Name))
+		}
+	}
+	for _, rule := range rules {
+		device, name := lif.PCIeAddress, lif.PCIeAddress
+		if rule.perPort {
+			if !hasPort {
+				continue
+			}
+			device, name = port.UUID, port.Name
+		}
+		if inc, ok := increases[rule.counter][device]; ok && inc >= rule.threshold {
+			reasons = append(reasons, fmt.Sprintf(rule.reason, inc, name, rule.window))
+		}
+	}
+	return reasons
+}
+
 // GetNICHealthStates retrieves the health states of all NICs managed by the NIC agent.
 // It returns a map where the keys are the PCIe IDs of the NICs and the values are their health states.
+// A lif is unhealthy when any of the configured health rules fails, the failed rules are reported
+// as the reason of the state.
 func (na *NICAgentClient) GetNICHealthStates() (map[string]interface{}, error) {
-	if healthSettings := na.mh.GetNICHealthCheckConfig(); healthSettings != nil &&
-		!healthSettings.InterfaceAdminDownAsUnhealthy {
-		// If the health check settings specify that admin down interfaces should not be treated as unhealthy,
-		// we skip the health check for admin down interfaces.
+	healthSettings := na.mh.GetNICHealthCheckConfig()
+	adminDownCheck := healthSettings == nil || healthSettings.InterfaceAdminDownAsUnhealthy
+	linkDownCheck := healthSettings.GetLinkDownAsUnhealthy()
+	rules := getNICHealthRules(healthSettings)
+	if !adminDownCheck && !linkDownCheck && len(rules) == 0 {
+		// no health rule is enabled, skip the health check
 		return map[string]interface{}{}, nil
 	}
 
@@ -50,6 +232,13 @@ func (na *NICAgentClient) GetNICHealthStates() (map[string]interface{}, error) {
 		}
 	}
 
+	data, err := na.getNICHealthData(linkDownCheck, rules)
+	if err != nil {
+		logger.Log.Printf("failed to get NIC health data, err: %+v", err)
+		return nil, err
+	}
+	increases := na.health.update(rules, data)
+
 	nicHealthMap := make(map[string]interface{})
 	for _, nic := range na.nics {
 		for _, lif := range nic.Lifs {
@@ -58,19 +247,30 @@ func (na *NICAgentClient) GetNICHealthStates() (map[string]interface{}, error) {
 				continue
 			}
 
-			nicState := &nicmetricssvc.NICState{}
-			adminState, err := na.getAdminStatus(lif.UUID)
-			if err != nil {
-				logger.Log.Printf("failed to get admin state for LIF %s, err: %+v", lif.UUID, err)
-				return nil, err
+			nicState := &nicmetricssvc.NICState{
+				Device: lif.PCIeAddress,
+				UUID:   lif.UUID,
+				Health: strings.ToLower(nicmetricssvc.Health_HEALTHY.String()),
 			}
-			nicState.Device = lif.PCIeAddress
-			nicState.UUID = lif.UUID
-			switch adminState {
-			case strings.ToLower(nicmetricssvc.AdminState_UP.String()):
-				nicState.Health = strings.ToLower(nicmetricssvc.Health_HEALTHY.String())
-			case strings.ToLower(nicmetricssvc.AdminState_DOWN.String()):
+			reasons := []string{}
+			if adminDownCheck {
+				adminState, err := na.getAdminStatus(lif.UUID)
+				if err != nil {
+					logger.Log.Printf("failed to get admin state for LIF %s, err: %+v", lif.UUID, err)
+					return nil, err
+				}
+				switch adminState {
+				case strings.ToLower(nicmetricssvc.AdminState_UP.String()):
+				case strings.ToLower(nicmetricssvc.AdminState_DOWN.String()):
+					reasons = append(reasons, "interface admin down")
+				default:
+					nicState.Health = strings.ToLower(nicmetricssvc.Health_UNKNOWN.String())
+				}
+			}
+			reasons = append(reasons, lifHealthReasons(nic, lif, linkDownCheck, rules, data, increases)...)
+			if len(reasons) != 0 {
 				nicState.Health = strings.ToLower(nicmetricssvc.Health_UNHEALTHY.String())
+				nicState.Reason = strings.Join(reasons, "; ")
 			}
 			nicHealthMap[lif.PCIeAddress] = nicState
 		}
@@ -112,3 +312,121 @@ func (na *NICAgentClient) getAdminStatus(lifUUID string) (string, error) {
 
 	return resp.NIC[0].Lif[0].Spec.AdminState, nil
 }
+
+// getNICHealthData polls the port status, port statistics and rdma
+// statistics required by the enabled rules
+func (na *NICAgentClient) getNICHealthData(linkDownCheck bool, rules []nicHealthRule) (*nicHealthData, error) {
+	data := &nicHealthData{
+		operStatus: map[string]string{},
+		counters:   map[string]map[string]uint64{},
+	}
+	needed := map[string]bool{}
+	for _, rule := range rules {
+		needed[rule.counter] = true
+		data.counters[rule.counter] = map[string]uint64{}
+	}
+
+	if linkDownCheck || needed[healthCounterLinkDown] {
+		if err := na.getPortHealthStatus(data); err != nil {
+			return nil, err
+		}
+	}
+
+	if needed[healthCounterRSFEC] || needed[healthCounterPauseFrames] {
+		portStatsOut, err := ExecWithContext("nicctl show port statistics -j", na.cmdExec)
+		if err != nil {
+			logger.Log.Printf("failed to get port statistics, err: %+v", err)
+			return nil, err
+		}
+		var portStats nicmetrics.PortStatsList
+		if err := json.Unmarshal(portStatsOut, &portStats); err != nil {
+			logger.Log.Printf("error unmarshaling port statistics data: %v", err)
+			return nil, err
+		}
+		for _, nic := range portStats.NIC {
+			for _, port := range nic.Port {
+				if port.Spec == nil || port.Statistics == nil {
+					continue
+				}
+				stats := port.Statistics
+				if needed[healthCounterRSFEC] {
+					data.counters[healthCounterRSFEC][port.Spec.ID] = utils.StringToUint64(stats.RSFEC_UNCORRECTABLE_WORD)
+				}
+				if needed[healthCounterPauseFrames] {
+					data.counters[healthCounterPauseFrames][port.Spec.ID] = utils.StringToUint64(stats.FRAMES_RX_PAUSE) +
+						utils.StringToUint64(stats.FRAMES_RX_PRIPAUSE) +
+						utils.StringToUint64(stats.FRAMES_TX_PAUSE) +
+						utils.StringToUint64(stats.FRAMES_TX_PRIPAUSE)
+				}
+			}
+		}
+	}
+
+	if needed[healthCounterRDMARetryExcd] || needed[healthCounterRDMAAckTimeouts] {
+		// rdma devices may not be present, the rdma rules are skipped then
+		rdmaStats, err := na.getRdmaStats()
+		if err != nil {
+			logger.Log.Printf("failed to get rdma stats for NIC health, err: %v", err)
+			return data, nil
+		}
+		for _, stats := range rdmaStats {
+			if err := na.addRdmaDevPcieAddrIfAbsent(stats.IFNAME); err != nil {
+				logger.Log.Printf("failed to get pcie addr of %s, err: %v", stats.IFNAME, err)
+				continue
+			}
+			na.Lock()
+			pcieAddr := na.rdmaDevToPcieAddr[stats.IFNAME]
+			na.Unlock()
+			// counters of all the ports of the rdma device
+			if needed[healthCounterRDMARetryExcd] {
+				data.counters[healthCounterRDMARetryExcd][pcieAddr] += stats.RDMA_REQ_TX_RETRY_EXCD_ERR
+			}
+			if needed[healthCounterRDMAAckTimeouts] {
+				data.counters[healthCounterRDMAAckTimeouts][pcieAddr] += stats.RDMA_LOCAL_ACK_TIMEOUT_ERR
+			}
+		}
+	}
+	return data, nil
+}
+
+// getPortHealthStatus fills the operational status and link down events of
+// the ports of all NICs
+func (na *NICAgentClient) getPortHealthStatus(data *nicHealthData) error {
+	type Response struct {
+		NIC []struct {
+			ID   string `json:"id"`
+			Port []struct {
+				Spec struct {
+					ID string `json:"id"`
+				} `json:"spec"`
+				Status struct {
+					OperationalStatus string `json:"operational_status"`
+					LinkDownEvents    string `json:"number_of_link_down_events"`
+				} `json:"status"`
+			} `json:"port"`
+		} `json:"nic"`
+	}
+
+	for _, nic := range na.nics {
+		cmd := fmt.Sprintf("nicctl show port --card %s -j", nic.UUID)
+		portOut, err := ExecWithContext(cmd, na.cmdExec)
+		if err != nil {
+			logger.Log.Printf("NIC: %s, failed to get port data, err: %+v", nic.UUID, err)
+			return err
+		}
+		var resp Response
+		if err := json.Unmarshal(portOut, &resp); err != nil {
+			logger.Log.Printf("NIC: %s, error unmarshalling port data: %v", nic.UUID, err)
+			return err
+		}
+		for _, n := range resp.NIC {
+			for _, port := range n.Port {
+				data.operStatus[port.Spec.ID] = port.Status.OperationalStatus
+				if counters, ok := data.counters[healthCounterLinkDown]; ok {
+					counters[port.Spec.ID] = utils.StringToUint64(port.Status.LinkDownEvents)
+				}
+			}
+		}
+	}
+	return nil
+}
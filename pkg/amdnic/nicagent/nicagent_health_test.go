@@ -0,0 +1,200 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+)
+
+func TestCounterWindowIncrease(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+	type sample struct {
+		offset time.Duration
+		val    uint64
+		want   uint64
+	}
+	tests := []struct {
+		name    string
+		window  time.Duration
+		samples []sample
+	}{
+		{
+			name:   "increase within window",
+			window: time.Minute,
+			samples: []sample{
+				{0, 10, 0},
+				{20 * time.Second, 12, 2},
+				{40 * time.Second, 15, 5},
+			},
+		},
+		{
+			name:   "old samples leave the window",
+			window: time.Minute,
+			samples: []sample{
+				{0, 10, 0},
+				{30 * time.Second, 20, 10},
+				{90 * time.Second, 25, 5},
+				{200 * time.Second, 25, 0},
+			},
+		},
+		{
+			name:   "counter cleared",
+			window: time.Minute,
+			samples: []sample{
+				{0, 100, 0},
+				{10 * time.Second, 3, 0},
+				{20 * time.Second, 7, 4},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &counterWindow{}
+			for i, s := range tt.samples {
+				if got := w.increase(start.Add(s.offset), s.val, tt.window); got != s.want {
+					t.Errorf("sample %d: increase = %d, want %d", i, got, s.want)
+				}
+			}
+		})
+	}
+}
+
+func TestGetNICHealthRules(t *testing.T) {
+	cfg := &exportermetrics.NICHealthCheckConfig{
+		LinkFlap:           &exportermetrics.NICHealthCounterRule{Threshold: 3, Window: "10m"},
+		RSFECUncorrectable: &exportermetrics.NICHealthCounterRule{Threshold: 0, Window: "1m"},
+		RDMARetryExceeded:  &exportermetrics.NICHealthCounterRule{Threshold: 5, Window: "bogus"},
+	}
+	rules := getNICHealthRules(cfg)
+	got := map[string]time.Duration{}
+	for _, rule := range rules {
+		got[rule.counter] = rule.window
+	}
+	want := map[string]time.Duration{
+		healthCounterLinkDown:      10 * time.Minute,
+		healthCounterRDMARetryExcd: DefaultNICHealthWindow,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("rules = %v, want %v", got, want)
+	}
+	if rules := getNICHealthRules(nil); len(rules) != 0 {
+		t.Errorf("expected no rules for nil config, got %v", rules)
+	}
+}
+
+func TestLifHealthReasons(t *testing.T) {
+	nic := &NIC{
+		Ports: map[string]*Port{
+			"p1": {UUID: "p1", Name: "eth1/1"},
+			"p2": {UUID: "p2", Name: "eth2/1"},
+		},
+	}
+	lif1 := &Lif{UUID: "l1", PortID: "p1", PCIeAddress: "0000:44:00.0"}
+	lif2 := &Lif{UUID: "l2", PortID: "p2", PCIeAddress: "0000:44:00.1"}
+	cfg := &exportermetrics.NICHealthCheckConfig{
+		LinkFlap:            &exportermetrics.NICHealthCounterRule{Threshold: 3, Window: "5m"},
+		PFCPauseStorm:       &exportermetrics.NICHealthCounterRule{Threshold: 1000, Window: "1m"},
+		RDMALocalAckTimeout: &exportermetrics.NICHealthCounterRule{Threshold: 10, Window: "1m"},
+	}
+	rules := getNICHealthRules(cfg)
+
+	type poll struct {
+		offset     time.Duration
+		operStatus map[string]string
+		counters   map[string]map[string]uint64
+		want       map[string][]string // reasons by lif id
+	}
+	polls := []poll{
+		{
+			offset:     0,
+			operStatus: map[string]string{"p1": "UP", "p2": "DOWN"},
+			counters: map[string]map[string]uint64{
+				healthCounterLinkDown:        {"p1": 11, "p2": 4},
+				healthCounterPauseFrames:     {"p1": 500, "p2": 0},
+				healthCounterRDMAAckTimeouts: {"0000:44:00.0": 1, "0000:44:00.1": 0},
+			},
+			want: map[string][]string{
+				"l1": {},
+				"l2": {"link down on eth2/1"},
+			},
+		},
+		{
+			offset:     30 * time.Second,
+			operStatus: map[string]string{"p1": "UP", "p2": "UP"},
+			counters: map[string]map[string]uint64{
+				healthCounterLinkDown:        {"p1": 11, "p2": 7},
+				healthCounterPauseFrames:     {"p1": 5000, "p2": 0},
+				healthCounterRDMAAckTimeouts: {"0000:44:00.0": 20, "0000:44:00.1": 0},
+			},
+			want: map[string][]string{
+				"l1": {
+					"pfc pause storm: 4500 pause frames on eth1/1 within 1m0s",
+					"rdma local ack timeout: 19 timeouts on 0000:44:00.0 within 1m0s",
+				},
+				"l2": {"link flap: 3 link down events on eth2/1 within 5m0s"},
+			},
+		},
+		{
+			// pause and ack timeout increases are out of their window, the
+			// link flaps are still within theirs
+			offset:     3 * time.Minute,
+			operStatus: map[string]string{"p1": "UP", "p2": "UP"},
+			counters: map[string]map[string]uint64{
+				healthCounterLinkDown:        {"p1": 11, "p2": 7},
+				healthCounterPauseFrames:     {"p1": 5100, "p2": 0},
+				healthCounterRDMAAckTimeouts: {"0000:44:00.0": 20, "0000:44:00.1": 0},
+			},
+			want: map[string][]string{
+				"l1": {},
+				"l2": {"link flap: 3 link down events on eth2/1 within 5m0s"},
+			},
+		},
+		{
+			offset:     6 * time.Minute,
+			operStatus: map[string]string{"p1": "UP", "p2": "UP"},
+			counters: map[string]map[string]uint64{
+				healthCounterLinkDown:        {"p1": 11, "p2": 7},
+				healthCounterPauseFrames:     {"p1": 5100, "p2": 0},
+				healthCounterRDMAAckTimeouts: {"0000:44:00.0": 20, "0000:44:00.1": 0},
+			},
+			want: map[string][]string{
+				"l1": {},
+				"l2": {},
+			},
+		},
+	}
+
+	start := time.Unix(1700000000, 0)
+	now := start
+	tracker := newNICHealthTracker()
+	tracker.now = func() time.Time { return now }
+	for i, p := range polls {
+		now = start.Add(p.offset)
+		data := &nicHealthData{operStatus: p.operStatus, counters: p.counters}
+		increases := tracker.update(rules, data)
+		for _, lif := range []*Lif{lif1, lif2} {
+			got := lifHealthReasons(nic, lif, true, rules, data, increases)
+			if !reflect.DeepEqual(got, p.want[lif.UUID]) {
+				t.Errorf("poll %d lif %s: reasons = %q, want %q", i, lif.UUID, got, p.want[lif.UUID])
+			}
+		}
+	}
+}
@@ -0,0 +1,85 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+var (
+	// identity labels only exported on nic_info
+	infoMetrics bool
+	// labels identifying the nic series, kept on every series in info metric
+	// mode to join with nic_info
+	nicInfoJoinLabels = []string{
+		exportermetrics.MetricLabel_HOSTNAME.String(),
+		exportermetrics.NICMetricLabel_NIC_ID.String(),
+	}
+	// static identity labels only exported on nic_info in info metric mode
+	nicInfoLabels = []string{
+		exportermetrics.NICMetricLabel_NIC_UUID.String(),
+		exportermetrics.MetricLabel_SERIAL_NUMBER.String(),
+		exportermetrics.NICMetricLabel_FIRMWARE_VERSION.String(),
+		exportermetrics.NICMetricLabel_AFFINITY_GPU.String(),
+	}
+)
+
+func (na *NICAgentClient) initInfoMetrics(config *exportermetrics.NICMetricConfig) {
+	infoMetrics = config.GetInfoMetrics()
+	logger.Log.Printf("info metric mode set to %v", infoMetrics)
+}
+
+// isInfoLabel returns true if the label is only exported on nic_info
+func isInfoLabel(name string) bool {
+	return infoMetrics && slices.Contains(nicInfoLabels, strings.ToUpper(name))
+}
+
+// GetInfoLabels returns the labels of the nic_info series, the join labels
+// followed by the enabled identity labels and the custom labels
+func (na *NICAgentClient) GetInfoLabels() []string {
+	labelList := []string{}
+	for _, name := range append(slices.Clone(nicInfoJoinLabels), nicInfoLabels...) {
+		if exportLabels[name] {
+			labelList = append(labelList, strings.ToLower(name))
+		}
+	}
+	for key := range customLabelMap {
+		labelList = append(labelList, key)
+	}
+	return labelList
+}
+
+// updateNICInfoMetrics exports the static identity labels of the nics on the
+// nic_info series in info metric mode
+func (na *NICAgentClient) updateNICInfoMetrics() {
+	if !infoMetrics {
+		return
+	}
+	infoLabels := na.GetInfoLabels()
+	for uuid := range na.nics {
+		allLabels := na.populateAllLabelsFromNIC(uuid)
+		labels := make(map[string]string, len(infoLabels))
+		for _, key := range infoLabels {
+			labels[key] = allLabels[key]
+		}
+		na.m.nicInfo.With(labels).Set(1)
+	}
+}
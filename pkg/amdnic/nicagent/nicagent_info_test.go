@@ -0,0 +1,87 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	dto "github.com/prometheus/client_model/go"
+)
+
+func TestNICInfoMetrics(t *testing.T) {
+	customLabelMap = map[string]string{}
+	extraPodLabelsMap = map[string]string{}
+	t.Cleanup(func() { infoMetrics = false })
+
+	na := &NICAgentClient{
+		staticHostLabels: map[string]string{
+			exportermetrics.MetricLabel_HOSTNAME.String(): "node1",
+		},
+		nics: map[string]*NIC{
+			"nic-uuid-0": {Index: "0", UUID: "nic-uuid-0", SerialNumber: "SN0", FirmwareVersion: "1.117.1"},
+		},
+	}
+	na.initLabelConfigs(&exportermetrics.NICMetricConfig{
+		Labels: []string{"NIC_UUID", "FIRMWARE_VERSION"},
+	})
+
+	na.initInfoMetrics(&exportermetrics.NICMetricConfig{})
+	if labels := na.populateLabelsFromNIC("nic-uuid-0"); labels["serial_number"] != "SN0" || labels["nic_uuid"] != "nic-uuid-0" {
+		t.Fatalf("identity labels expected on the series by default, got %v", labels)
+	}
+
+	na.initInfoMetrics(&exportermetrics.NICMetricConfig{InfoMetrics: true})
+	na.initPrometheusMetrics()
+	exportLabels := na.GetExportLabels()
+	infoLabels := na.GetInfoLabels()
+	for _, label := range []string{"hostname", "nic_id"} {
+		if !slices.Contains(exportLabels, label) || !slices.Contains(infoLabels, label) {
+			t.Errorf("join label %v missing, series %v, nic_info %v", label, exportLabels, infoLabels)
+		}
+	}
+	for _, label := range []string{"nic_uuid", "serial_number", "firmware_version"} {
+		if slices.Contains(exportLabels, label) || !slices.Contains(infoLabels, label) {
+			t.Errorf("identity label %v not moved to nic_info, series %v, nic_info %v", label, exportLabels, infoLabels)
+		}
+	}
+
+	labels := na.populateLabelsFromNIC("nic-uuid-0")
+	if len(labels) != len(exportLabels) || labels["nic_id"] != "0" {
+		t.Errorf("unexpected series labels %v, want %v", labels, exportLabels)
+	}
+
+	na.updateNICInfoMetrics()
+	gauge, err := na.m.nicInfo.GetMetricWith(map[string]string{
+		"hostname":         "node1",
+		"nic_id":           "0",
+		"nic_uuid":         "nic-uuid-0",
+		"serial_number":    "SN0",
+		"firmware_version": "1.117.1",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m dto.Metric
+	if err := gauge.Write(&m); err != nil {
+		t.Fatal(err)
+	}
+	if m.GetGauge().GetValue() != 1 {
+		t.Errorf("nic_info got %v, want 1", m.GetGauge().GetValue())
+	}
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
@@ -27,6 +29,7 @@ import (
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+	"github.com/ROCm/device-metrics-exporter/pkg/topology"
 	"github.com/ROCm/device-metrics-exporter/pkg/types"
 	"github.com/prometheus/client_golang/prometheus"
 )
@@ -52,6 +55,8 @@ var (
 	fetchLifMetrics      bool
 	fetchQPMetrics       bool
 	fetchLIFAggQPMetrics bool
+	// transceiver diagnostics are read on their own refresh interval
+	fetchTransceiverMetrics bool
 )
 
 type FieldMeta struct {
@@ -61,6 +66,7 @@ type FieldMeta struct {
 
 type metrics struct {
 	nicNodesTotal prometheus.GaugeVec
+	nicInfo       prometheus.GaugeVec
 	nicMaxSpeed   prometheus.GaugeVec
 
 	// Port stats
@@ -118,6 +124,8 @@ type metrics struct {
 	nicPortStatsTxBps                  prometheus.GaugeVec
 	nicPortStatsRxPps                  prometheus.GaugeVec
 	nicPortStatsRxBps                  prometheus.GaugeVec
+	nicPortStatsRxBytesRate            prometheus.GaugeVec
+	nicPortStatsTxBytesRate            prometheus.GaugeVec
 
 	//RDMA Stats
 	rdmaTxUcastPkts prometheus.GaugeVec
@@ -153,6 +161,17 @@ type metrics struct {
 	rdmaRespRxLocOperErr     prometheus.GaugeVec
 	rdmaRespRxOutofAtomic    prometheus.GaugeVec
 	rdmaRespRxS0TableErr     prometheus.GaugeVec
+
+	// derived RDMA rates
+	rdmaRxCnpRate           prometheus.GaugeVec
+	rdmaTxCnpRate           prometheus.GaugeVec
+	rdmaRxEcnRate           prometheus.GaugeVec
+	rdmaRetransmissionRatio prometheus.GaugeVec
+	rdmaRnrNakRate          prometheus.GaugeVec
+
+	// vendor specific RDMA counters
+	rdmaVendorMlx5Counter prometheus.GaugeVec
+	rdmaVendorBnxtCounter prometheus.GaugeVec
 	//RDMA Resp Tx Stats
 	rdmaRespTxPktSeqErr      prometheus.GaugeVec
 	rdmaRespTxRmtInvalReqErr prometheus.GaugeVec
@@ -337,6 +356,15 @@ type metrics struct {
 	ethFramesTx1519b2047b prometheus.GaugeVec
 	ethFramesTx2048b4095b prometheus.GaugeVec
 	ethFramesTx4096b8191b prometheus.GaugeVec
+
+	// Transceiver diagnostics
+	nicTransceiverTemperature       prometheus.GaugeVec
+	nicTransceiverVoltage           prometheus.GaugeVec
+	nicTransceiverTxPower           prometheus.GaugeVec
+	nicTransceiverRxPower           prometheus.GaugeVec
+	nicTransceiverBiasCurrent       prometheus.GaugeVec
+	nicTransceiverInfo              prometheus.GaugeVec
+	nicTransceiverThresholdExceeded prometheus.GaugeVec
 }
 
 func (na *NICAgentClient) ResetMetrics() error {
@@ -344,6 +372,7 @@ func (na *NICAgentClient) ResetMetrics() error {
 	for _, prommetric := range fieldMetricsMap {
 		prommetric.Metric.Reset()
 	}
+	na.m.nicInfo.Reset()
 	return nil
 }
 
@@ -454,7 +483,7 @@ func (na *NICAgentClient) populateLabelsForNetDevice(netDev NetDevice, podInfo *
 
 	// Add extra pod labels only if config has mapped any
 	if len(extraPodLabelsMap) > 0 {
-		podLabels := utils.GetPodLabels(podInfo, k8PodInfoMap)
+		podLabels := utils.GetPodMetadata(podInfo, k8PodInfoMap)
 		// populate labels from extraPodLabelsMap; regarless of whether there is a workload or not
 		for prometheusPodlabel, k8Podlabel := range extraPodLabelsMap {
 			label := strings.ToLower(prometheusPodlabel)
@@ -472,7 +501,7 @@ func (na *NICAgentClient) populateLabelsForNetDevice(netDev NetDevice, podInfo *
 func (na *NICAgentClient) GetExportLabels() []string { //TODO .. move to exporter/utils
 	labelList := []string{}
 	for key, enabled := range exportLabels {
-		if !enabled {
+		if !enabled || isInfoLabel(key) {
 			continue
 		}
 		labelList = append(labelList, strings.ToLower(key))
@@ -612,6 +641,7 @@ func (na *NICAgentClient) initFieldConfig(config *exportermetrics.NICMetricConfi
 	fetchLifMetrics = false
 	fetchQPMetrics = false
 	fetchLIFAggQPMetrics = false
+	fetchTransceiverMetrics = false
 
 	if config == nil || len(config.GetFields()) == 0 {
 		fetchRdmaMetrics = true
@@ -621,8 +651,9 @@ func (na *NICAgentClient) initFieldConfig(config *exportermetrics.NICMetricConfi
 		fetchLifMetrics = true
 		fetchLIFAggQPMetrics = true
 		fetchQPMetrics = false
-		logger.Log.Printf("fetch enable status defaulted to: {Rdma: %v, Ethtool: %v, Port: %v, PortRate: %v, Lif: %v, QP: %v, LIF_Agg_QP: %v}",
-			fetchRdmaMetrics, fetchEthtoolMetrics, fetchPortMetrics, fetchPortRateMetrics, fetchLifMetrics, fetchQPMetrics, fetchLIFAggQPMetrics)
+		fetchTransceiverMetrics = true
+		logger.Log.Printf("fetch enable status defaulted to: {Rdma: %v, Ethtool: %v, Port: %v, PortRate: %v, Lif: %v, QP: %v, LIF_Agg_QP: %v, Transceiver: %v}",
+			fetchRdmaMetrics, fetchEthtoolMetrics, fetchPortMetrics, fetchPortRateMetrics, fetchLifMetrics, fetchQPMetrics, fetchLIFAggQPMetrics, fetchTransceiverMetrics)
 		return
 	}
 
@@ -643,6 +674,8 @@ func (na *NICAgentClient) initFieldConfig(config *exportermetrics.NICMetricConfi
 			strings.HasPrefix(fieldName, "NIC_PORT_STATS_RX_BPS"):
 			fetchPortRateMetrics = true
 			fetchPortMetrics = true // Rate metrics also need Port to be enabled
+		case strings.HasPrefix(fieldName, "NIC_TRANSCEIVER_"):
+			fetchTransceiverMetrics = true
 		case strings.HasPrefix(fieldName, "NIC_PORT_"):
 			fetchPortMetrics = true
 		case strings.HasPrefix(fieldName, "NIC_LIF_"):
@@ -662,8 +695,8 @@ func (na *NICAgentClient) initFieldConfig(config *exportermetrics.NICMetricConfi
 			logger.Log.Printf("%v field is disabled", k)
 		}
 	}
-	logger.Log.Printf("fetch enable status: {Rdma: %v, Ethtool: %v, Port: %v, PortRate: %v, Lif: %v, QP: %v, LIF_Agg_QP: %v}",
-		fetchRdmaMetrics, fetchEthtoolMetrics, fetchPortMetrics, fetchPortRateMetrics, fetchLifMetrics, fetchQPMetrics, fetchLIFAggQPMetrics)
+	logger.Log.Printf("fetch enable status: {Rdma: %v, Ethtool: %v, Port: %v, PortRate: %v, Lif: %v, QP: %v, LIF_Agg_QP: %v, Transceiver: %v}",
+		fetchRdmaMetrics, fetchEthtoolMetrics, fetchPortMetrics, fetchPortRateMetrics, fetchLifMetrics, fetchQPMetrics, fetchLIFAggQPMetrics, fetchTransceiverMetrics)
 }
 
 func (na *NICAgentClient) initFieldMetricsMap() {
@@ -725,6 +758,8 @@ func (na *NICAgentClient) initFieldMetricsMap() {
 		exportermetrics.NICMetricField_NIC_PORT_STATS_TX_BPS.String():                   {Metric: na.m.nicPortStatsTxBps},
 		exportermetrics.NICMetricField_NIC_PORT_STATS_RX_PPS.String():                   {Metric: na.m.nicPortStatsRxPps},
 		exportermetrics.NICMetricField_NIC_PORT_STATS_RX_BPS.String():                   {Metric: na.m.nicPortStatsRxBps},
+		exportermetrics.NICMetricField_NIC_PORT_STATS_RX_BYTES_RATE.String():            {Metric: na.m.nicPortStatsRxBytesRate},
+		exportermetrics.NICMetricField_NIC_PORT_STATS_TX_BYTES_RATE.String():            {Metric: na.m.nicPortStatsTxBytesRate},
 		exportermetrics.NICMetricField_RDMA_TX_UCAST_PKTS.String():                      {Metric: na.m.rdmaTxUcastPkts},
 		exportermetrics.NICMetricField_RDMA_TX_CNP_PKTS.String():                        {Metric: na.m.rdmaTxCnpPkts},
 		exportermetrics.NICMetricField_RDMA_RX_UCAST_PKTS.String():                      {Metric: na.m.rdmaRxUcastPkts},
@@ -761,6 +796,13 @@ func (na *NICAgentClient) initFieldMetricsMap() {
 		exportermetrics.NICMetricField_RDMA_RESP_TX_RNR_RETRY_ERR.String():              {Metric: na.m.rdmaRespTxRnrRetryErr},
 		exportermetrics.NICMetricField_RDMA_RESP_TX_LOC_SGL_INV_ERR.String():            {Metric: na.m.rdmaRespTxLocSglInvErr},
 		exportermetrics.NICMetricField_RDMA_RESP_RX_S0_TABLE_ERR.String():               {Metric: na.m.rdmaRespRxS0TableErr},
+		exportermetrics.NICMetricField_RDMA_RX_CNP_RATE.String():                        {Metric: na.m.rdmaRxCnpRate},
+		exportermetrics.NICMetricField_RDMA_TX_CNP_RATE.String():                        {Metric: na.m.rdmaTxCnpRate},
+		exportermetrics.NICMetricField_RDMA_RX_ECN_RATE.String():                        {Metric: na.m.rdmaRxEcnRate},
+		exportermetrics.NICMetricField_RDMA_RETRANSMISSION_RATIO.String():               {Metric: na.m.rdmaRetransmissionRatio},
+		exportermetrics.NICMetricField_RDMA_RNR_NAK_RATE.String():                       {Metric: na.m.rdmaRnrNakRate},
+		exportermetrics.NICMetricField_RDMA_VENDOR_MLX5_COUNTER.String():                {Metric: na.m.rdmaVendorMlx5Counter},
+		exportermetrics.NICMetricField_RDMA_VENDOR_BNXT_COUNTER.String():                {Metric: na.m.rdmaVendorBnxtCounter},
 		exportermetrics.NICMetricField_NIC_LIF_STATS_RX_UNICAST_PACKETS.String():        {Metric: na.m.nicLifStatsRxUnicastPackets},
 		exportermetrics.NICMetricField_NIC_LIF_STATS_RX_UNICAST_DROP_PACKETS.String():   {Metric: na.m.nicLifStatsRxUnicastDropPackets},
 		exportermetrics.NICMetricField_NIC_LIF_STATS_RX_MULTICAST_DROP_PACKETS.String(): {Metric: na.m.nicLifStatsRxMulticastDropPackets},
@@ -884,6 +926,13 @@ func (na *NICAgentClient) initFieldMetricsMap() {
 		exportermetrics.NICMetricField_ETH_FRAMES_TX_1519B_2047B.String():               {Metric: na.m.ethFramesTx1519b2047b},
 		exportermetrics.NICMetricField_ETH_FRAMES_TX_2048B_4095B.String():               {Metric: na.m.ethFramesTx2048b4095b},
 		exportermetrics.NICMetricField_ETH_FRAMES_TX_4096B_8191B.String():               {Metric: na.m.ethFramesTx4096b8191b},
+		exportermetrics.NICMetricField_NIC_TRANSCEIVER_TEMPERATURE.String():             {Metric: na.m.nicTransceiverTemperature},
+		exportermetrics.NICMetricField_NIC_TRANSCEIVER_VOLTAGE.String():                 {Metric: na.m.nicTransceiverVoltage},
+		exportermetrics.NICMetricField_NIC_TRANSCEIVER_TX_POWER.String():                {Metric: na.m.nicTransceiverTxPower},
+		exportermetrics.NICMetricField_NIC_TRANSCEIVER_RX_POWER.String():                {Metric: na.m.nicTransceiverRxPower},
+		exportermetrics.NICMetricField_NIC_TRANSCEIVER_BIAS_CURRENT.String():            {Metric: na.m.nicTransceiverBiasCurrent},
+		exportermetrics.NICMetricField_NIC_TRANSCEIVER_INFO.String():                    {Metric: na.m.nicTransceiverInfo},
+		exportermetrics.NICMetricField_NIC_TRANSCEIVER_THRESHOLD_EXCEEDED.String():      {Metric: na.m.nicTransceiverThresholdExceeded},
 		// Backward compatibility aliases (deprecated, use PRI_N format)
 		"ETH_FRAMES_RX_PRI0": {Metric: na.m.ethFramesRxPri0},
 		"ETH_FRAMES_RX_PRI1": {Metric: na.m.ethFramesRxPri1},
@@ -955,6 +1004,11 @@ func (na *NICAgentClient) initPrometheusMetrics() {
 			Help: "Number of NICs in the node",
 		}, nonNICLabels),
 
+		nicInfo: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: "nic_info",
+			Help: "Static identity labels of the NIC, always 1",
+		}, na.GetInfoLabels()),
+
 		nicMaxSpeed: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: strings.ToLower(exportermetrics.NICMetricField_NIC_MAX_SPEED.String()),
 			Help: "Maximum NIC speed in Gbps",
@@ -1231,6 +1285,16 @@ func (na *NICAgentClient) initPrometheusMetrics() {
 			Help: "Port receive rate in bits per second",
 		}, append([]string{LabelPortName, LabelPortID, LabelPcieBusId}, labels...)),
 
+		nicPortStatsRxBytesRate: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_NIC_PORT_STATS_RX_BYTES_RATE.String()),
+			Help: "Port received bytes per second over the derived metrics window",
+		}, append([]string{LabelPortName, LabelPortID, LabelPcieBusId}, labels...)),
+
+		nicPortStatsTxBytesRate: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_NIC_PORT_STATS_TX_BYTES_RATE.String()),
+			Help: "Port transmitted bytes per second over the derived metrics window",
+		}, append([]string{LabelPortName, LabelPortID, LabelPcieBusId}, labels...)),
+
 		/* RDMA stats */
 		rdmaTxUcastPkts: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: strings.ToLower(exportermetrics.NICMetricField_RDMA_TX_UCAST_PKTS.String()),
@@ -1412,6 +1476,42 @@ func (na *NICAgentClient) initPrometheusMetrics() {
 			Help: "Response rx S0 Table error count",
 		}, deviceLabels),
 
+		/* derived RDMA rates */
+		rdmaRxCnpRate: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_RDMA_RX_CNP_RATE.String()),
+			Help: "Rx RDMA Congestion Notification Packets per second over the derived metrics window",
+		}, deviceLabels),
+
+		rdmaTxCnpRate: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_RDMA_TX_CNP_RATE.String()),
+			Help: "Tx RDMA Congestion Notification Packets per second over the derived metrics window",
+		}, deviceLabels),
+
+		rdmaRxEcnRate: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_RDMA_RX_ECN_RATE.String()),
+			Help: "Rx RDMA ECN marked packets per second over the derived metrics window",
+		}, deviceLabels),
+
+		rdmaRetransmissionRatio: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_RDMA_RETRANSMISSION_RATIO.String()),
+			Help: "Retransmission triggers (sequence error NAKs and local ack timeouts) per Tx RDMA unicast packet over the derived metrics window",
+		}, deviceLabels),
+
+		rdmaRnrNakRate: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_RDMA_RNR_NAK_RATE.String()),
+			Help: "Receiver not ready NAK retry errors per second over the derived metrics window",
+		}, deviceLabels),
+
+		rdmaVendorMlx5Counter: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_RDMA_VENDOR_MLX5_COUNTER.String()),
+			Help: "mlx5 RDMA counters without an RDMA field equivalent, by counter name",
+		}, append([]string{LabelCounter}, deviceLabels...)),
+
+		rdmaVendorBnxtCounter: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_RDMA_VENDOR_BNXT_COUNTER.String()),
+			Help: "bnxt_re RDMA counters without an RDMA field equivalent, by counter name",
+		}, append([]string{LabelCounter}, deviceLabels...)),
+
 		/* Lif stats */
 		nicLifStatsRxUnicastPackets: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
 			Name: strings.ToLower(exportermetrics.NICMetricField_NIC_LIF_STATS_RX_UNICAST_PACKETS.String()),
@@ -2142,15 +2242,53 @@ func (na *NICAgentClient) initPrometheusMetrics() {
 			Name: strings.ToLower(exportermetrics.NICMetricField_ETH_FRAMES_TX_4096B_8191B.String()),
 			Help: "Count of frames transmitted with size 4096-8191 bytes",
 		}, deviceLabels),
+
+		/* Transceiver diagnostics */
+		nicTransceiverTemperature: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_NIC_TRANSCEIVER_TEMPERATURE.String()),
+			Help: "Transceiver module temperature in degrees Celsius",
+		}, append([]string{LabelPortName, LabelPortID, LabelPcieBusId}, labels...)),
+
+		nicTransceiverVoltage: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_NIC_TRANSCEIVER_VOLTAGE.String()),
+			Help: "Transceiver module supply voltage in volts",
+		}, append([]string{LabelPortName, LabelPortID, LabelPcieBusId}, labels...)),
+
+		nicTransceiverTxPower: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_NIC_TRANSCEIVER_TX_POWER.String()),
+			Help: "Transceiver lane transmit optical power in mW",
+		}, append([]string{LabelPortName, LabelPortID, LabelPcieBusId, LabelLane}, labels...)),
+
+		nicTransceiverRxPower: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_NIC_TRANSCEIVER_RX_POWER.String()),
+			Help: "Transceiver lane receive optical power in mW",
+		}, append([]string{LabelPortName, LabelPortID, LabelPcieBusId, LabelLane}, labels...)),
+
+		nicTransceiverBiasCurrent: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_NIC_TRANSCEIVER_BIAS_CURRENT.String()),
+			Help: "Transceiver lane laser bias current in mA",
+		}, append([]string{LabelPortName, LabelPortID, LabelPcieBusId, LabelLane}, labels...)),
+
+		nicTransceiverInfo: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_NIC_TRANSCEIVER_INFO.String()),
+			Help: "Transceiver module type, vendor, part and serial number, always 1",
+		}, append([]string{LabelPortName, LabelPortID, LabelPcieBusId, LabelTransceiverType,
+			LabelTransceiverVendor, LabelTransceiverPN, LabelTransceiverSN}, labels...)),
+
+		nicTransceiverThresholdExceeded: *prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Name: strings.ToLower(exportermetrics.NICMetricField_NIC_TRANSCEIVER_THRESHOLD_EXCEEDED.String()),
+			Help: "Transceiver diagnostic beyond its warning or alarm threshold, always 1",
+		}, append([]string{LabelPortName, LabelPortID, LabelPcieBusId, LabelSensor, LabelLane, LabelSeverity}, labels...)),
 	}
 	na.initFieldMetricsMap()
 }
 
 func (na *NICAgentClient) initFieldRegistration() error {
 	for field, enabled := range exportFieldMap {
-		// Always register QP_ metrics so that ?debug=qp works at runtime
+		// Always register the QP_, LIF_QP_ and NIC_LIF_ metrics so that
+		// ?debug=qp|lif|all works at runtime
 		// Data collection is still gated by isFieldEnabled/debugMode
-		if !enabled && !strings.HasPrefix(field, "QP_") {
+		if !enabled && !isDebugField(field) {
 			continue
 		}
 		prommetric, ok := fieldMetricsMap[field]
@@ -2162,10 +2300,21 @@ func (na *NICAgentClient) initFieldRegistration() error {
 			logger.Log.Printf("Field %v registration failed with err : %v", field, err)
 		}
 	}
+	if infoMetrics {
+		if err := na.mh.RegisterMetric(na.m.nicInfo); err != nil {
+			logger.Log.Printf("nic_info registration failed with err : %v", err)
+		}
+	}
 
 	return nil
 }
 
+// isDebugField returns true for the fields exported by the debug modes
+func isDebugField(field string) bool {
+	return strings.HasPrefix(field, "QP_") || strings.HasPrefix(field, "LIF_QP_") ||
+		strings.HasPrefix(field, "NIC_LIF_")
+}
+
 func (na *NICAgentClient) isFieldEnabled(fieldName string) bool {
 	enabled, exists := exportFieldMap[fieldName]
 	return exists && enabled
@@ -2175,7 +2324,8 @@ func (na *NICAgentClient) initPodExtraLabels(config *exportermetrics.NICMetricCo
 	// initialize pod labels maps
 	k8PodInfoMap = make(map[string]types.K8sPodInfo)
 	if config != nil {
-		extraPodLabelsMap = utils.NormalizeExtraPodLabels(config.GetExtraPodLabels())
+		extraPodLabelsMap = utils.NormalizePodMetadataLabels(config.GetExtraPodLabels(),
+			config.GetExtraPodAnnotations(), config.GetExtraNamespaceLabels(), config.GetOwnerKindLabel())
 		if len(extraPodLabelsMap) > 0 {
 			podInfoEnabled = true
 		}
@@ -2190,6 +2340,9 @@ func (na *NICAgentClient) InitConfigs() error {
 	na.initCustomLabels(filedConfigs)
 	na.initLabelConfigs(filedConfigs)
 	na.initFieldConfig(filedConfigs)
+	na.initTransceiverConfig(filedConfigs)
+	na.initDerivedMetricsConfig(filedConfigs)
+	na.initInfoMetrics(filedConfigs)
 	na.initPrometheusMetrics()
 	return na.initFieldRegistration()
 }
@@ -2206,6 +2359,7 @@ func (na *NICAgentClient) UpdateStaticMetrics(_ context.Context) error {
 	}
 	nonNICLabels := na.populateNonNICLabels()
 	na.m.nicNodesTotal.With(nonNICLabels).Set(float64(len(na.nics)))
+	na.updateNICInfoMetrics()
 	return nil
 }
 
@@ -2259,7 +2413,20 @@ func (na *NICAgentClient) populateNonNICLabels() map[string]string {
 	return labels
 }
 
+// populateLabelsFromNIC returns the labels of the nic series, the identity
+// labels are left to nic_info in info metric mode
 func (na *NICAgentClient) populateLabelsFromNIC(UUID string) map[string]string {
+	labels := na.populateAllLabelsFromNIC(UUID)
+	if infoMetrics {
+		for _, name := range nicInfoLabels {
+			delete(labels, strings.ToLower(name))
+		}
+	}
+	return labels
+}
+
+// populateAllLabelsFromNIC returns all the enabled labels of the nic
+func (na *NICAgentClient) populateAllLabelsFromNIC(UUID string) map[string]string {
 	labels := make(map[string]string)
 
 	nic, found := na.nics[UUID]
@@ -2305,6 +2472,12 @@ func (na *NICAgentClient) populateLabelsFromNIC(UUID string) map[string]string {
 			}
 		case exportermetrics.MetricLabel_HOSTNAME.String():
 			labels[key] = na.staticHostLabels[exportermetrics.MetricLabel_HOSTNAME.String()]
+		case exportermetrics.NICMetricLabel_AFFINITY_GPU.String():
+			if nic != nil {
+				labels[key] = getAffinityGPU(nic)
+			} else {
+				labels[key] = ""
+			}
 		default:
 			// for any other label which is not extra pod label or custom label,
 			// populate with empty value if enabled in config to avoid missing label dimension in metrics
@@ -2341,7 +2514,7 @@ func (na *NICAgentClient) getAssociatedWorkloadLabelsForPcieAddr(pcieAddr string
 
 		// Add extra pod labels only if config has mapped any
 		if len(extraPodLabelsMap) > 0 {
-			podLabels := utils.GetPodLabels(&podInfo, k8PodInfoMap)
+			podLabels := utils.GetPodMetadata(&podInfo, k8PodInfoMap)
 			// populate labels from extraPodLabelsMap; regarless of whether there is a workload or not
 			for prometheusPodlabel, k8Podlabel := range extraPodLabelsMap {
 				label := strings.ToLower(prometheusPodlabel)
@@ -2391,13 +2564,16 @@ func (na *NICAgentClient) getNICs() (map[string]*NIC, error) {
 					Name string `json:"name"`
 				} `json:"spec"`
 				Status struct {
-					MACAddress string `json:"mac_address"`
+					MACAddress   string `json:"mac_address"`
+					PhysicalPort string `json:"physical_port"`
+					MACChannel   string `json:"mac_channel"`
 				} `json:"status"`
 			} `json:"port"`
 			Lif []struct {
 				Spec struct {
 					ID         string `json:"id"`
 					MACAddress string `json:"mac_address"`
+					PFID       string `json:"pf_id"`
 				} `json:"spec"`
 				Status struct {
 					Name string `json:"name"`
@@ -2429,6 +2605,8 @@ func (na *NICAgentClient) getNICs() (map[string]*NIC, error) {
 			SerialNumber:    nic.SerialNumber,
 			EthBDF:          nic.EthBDF,
 			FirmwareVersion: nic.FirmwareVersion,
+			Ports:           map[string]*Port{},
+			Lifs:            map[string]*Lif{},
 		}
 
 		cmd := fmt.Sprintf("nicctl show port --card %s -j", nic.ID)
@@ -2445,15 +2623,20 @@ func (na *NICAgentClient) getNICs() (map[string]*NIC, error) {
 		}
 
 		for _, nic := range resp.NIC {
-			nics[nic.ID].Ports = map[string]*Port{}
-			for index, port := range nic.Port {
-				nics[nic.ID].Ports[port.Spec.ID] = &Port{
-					Index:      fmt.Sprintf("%v", index),
-					UUID:       port.Spec.ID,
-					Name:       port.Spec.Name,
-					MACAddress: port.Status.MACAddress,
-				}
+			if _, ok := nics[nic.ID]; !ok {
+				continue
+			}
+			ports := []*Port{}
+			for _, port := range nic.Port {
+				ports = append(ports, &Port{
+					UUID:         port.Spec.ID,
+					Name:         port.Spec.Name,
+					MACAddress:   port.Status.MACAddress,
+					PhysicalPort: port.Status.PhysicalPort,
+					MACChannel:   port.Status.MACChannel,
+				})
 			}
+			nics[nic.ID].Ports = indexPorts(ports)
 		}
 	}
 
@@ -2473,38 +2656,144 @@ func (na *NICAgentClient) getNICs() (map[string]*NIC, error) {
 		}
 
 		for _, nic := range resp.NIC {
-			lifIndex := 0
-			nics[nic.ID].Lifs = map[string]*Lif{}
+			card, ok := nics[nic.ID]
+			if !ok {
+				continue
+			}
+			lifs := []*Lif{}
 			for index, lif := range nic.Lif {
-				nics[nic.ID].Lifs[lif.Spec.ID] = &Lif{
-					Index: fmt.Sprintf("%v", lifIndex),
-					UUID:  lif.Spec.ID,
-					Name:  lif.Status.Name,
-				}
-				lifIndex++
-
-				// assume first LIF is the PF and
-				// card's ethBDF is the PCIe address for the PF
-				if index == 0 {
-					nics[nic.ID].Lifs[lif.Spec.ID].IsPF = true
-					nics[nic.ID].Lifs[lif.Spec.ID].PCIeAddress = nics[nic.ID].EthBDF
-				} else {
-					pcieAddr, err := na.getPCIeAddress(nics[nic.ID].EthBDF)
-					if err != nil || pcieAddr == "" {
-						logger.Log.Printf("NIC: %s, failed to get PCIe address for LIF: %s, err: %v. Health monitoring will be skipped for this LIF",
-							nic.ID, lif.Status.Name, err)
-						continue
-					}
-					// VF, congiured on both NIC and host
-					nics[nic.ID].sriovConfiguredOnHost = true
-					nics[nic.ID].Lifs[lif.Spec.ID].PCIeAddress = pcieAddr
-				}
+				lifs = append(lifs, &Lif{
+					Index:      fmt.Sprintf("%v", index),
+					UUID:       lif.Spec.ID,
+					Name:       lif.Status.Name,
+					MACAddress: lif.Spec.MACAddress,
+					PFID:       lif.Spec.PFID,
+				})
+				card.Lifs[lif.Spec.ID] = lifs[index]
 			}
+			attachLifsToPorts(card, lifs)
+			na.populateLifPcieAddrs(card, lifs)
 		}
 	}
 	return nics, nil
 }
 
+// indexPorts assigns stable indices to the ports of a NIC ordered by the
+// physical port and the breakout channel, independent of the nicctl order
+func indexPorts(ports []*Port) map[string]*Port {
+	less := func(a, b string) (bool, bool) {
+		if a == b {
+			return false, false
+		}
+		ai, aErr := strconv.Atoi(a)
+		bi, bErr := strconv.Atoi(b)
+		if aErr == nil && bErr == nil {
+			return ai < bi, true
+		}
+		return a < b, true
+	}
+	sort.SliceStable(ports, func(i, j int) bool {
+		if l, ok := less(ports[i].PhysicalPort, ports[j].PhysicalPort); ok {
+			return l
+		}
+		if l, ok := less(ports[i].MACChannel, ports[j].MACChannel); ok {
+			return l
+		}
+		return ports[i].Name < ports[j].Name
+	})
+	portMap := make(map[string]*Port, len(ports))
+	for index, port := range ports {
+		port.Index = fmt.Sprintf("%v", index)
+		portMap[port.UUID] = port
+	}
+	return portMap
+}
+
+// attachLifsToPorts marks the PF lifs, whose MAC address is the MAC address
+// of a port, and attaches every lif to the port of its PF. When no lif
+// matches a port the first lif is the PF of the first port.
+func attachLifsToPorts(nic *NIC, lifs []*Lif) {
+	portByMAC := map[string]*Port{}
+	for _, port := range nic.Ports {
+		if port.MACAddress != "" {
+			portByMAC[strings.ToLower(port.MACAddress)] = port
+		}
+	}
+	pfPorts := map[string]string{}
+	for _, lif := range lifs {
+		if port, ok := portByMAC[strings.ToLower(lif.MACAddress)]; ok && lif.MACAddress != "" {
+			lif.IsPF = true
+			lif.PortID = port.UUID
+			pfPorts[lif.PFID] = port.UUID
+		}
+	}
+	if len(pfPorts) == 0 && len(lifs) != 0 {
+		lifs[0].IsPF = true
+		for _, port := range nic.Ports {
+			if port.Index == "0" {
+				lifs[0].PortID = port.UUID
+			}
+		}
+		pfPorts[lifs[0].PFID] = lifs[0].PortID
+	}
+	for _, lif := range lifs {
+		if !lif.IsPF {
+			lif.PortID = pfPorts[lif.PFID]
+		}
+	}
+}
+
+// populateLifPcieAddrs resolves the PCIe addresses of the lifs and their
+// ports. The netdev of the lif is looked up in sysfs first; the PF of the
+// first port falls back to the card eth BDF and the VFs to lspci.
+func (na *NICAgentClient) populateLifPcieAddrs(nic *NIC, lifs []*Lif) {
+	pfAddrs := map[string]string{}
+	// PFs first, the VF lookup needs the address of the parent PF
+	for _, lif := range lifs {
+		if !lif.IsPF {
+			continue
+		}
+		lif.PCIeAddress = readNetDevPcieAddr(na.sysfsRoot, lif.Name)
+		if lif.PCIeAddress == "" {
+			if port := nic.GetPort(lif.PortID); port == nil || port.Index == "0" {
+				// card's ethBDF is the PCIe address for the PF of the first port
+				lif.PCIeAddress = nic.EthBDF
+			} else {
+				logger.Log.Printf("NIC: %s, failed to get PCIe address for PF LIF: %s of port %s. Health monitoring will be skipped for this LIF",
+					nic.UUID, lif.Name, port.Name)
+				continue
+			}
+		}
+		pfAddrs[lif.PFID] = lif.PCIeAddress
+		if port, ok := nic.Ports[lif.PortID]; ok {
+			port.PCIeAddress = lif.PCIeAddress
+		}
+	}
+
+	for _, lif := range lifs {
+		if lif.IsPF {
+			continue
+		}
+		pcieAddr := readNetDevPcieAddr(na.sysfsRoot, lif.Name)
+		if pcieAddr == "" {
+			pfAddr, ok := pfAddrs[lif.PFID]
+			if !ok {
+				pfAddr = nic.EthBDF
+			}
+			var err error
+			pcieAddr, err = na.getPCIeAddress(pfAddr)
+			if err != nil || pcieAddr == "" {
+				logger.Log.Printf("NIC: %s, failed to get PCIe address for LIF: %s, err: %v. Health monitoring will be skipped for this LIF",
+					nic.UUID, lif.Name, err)
+				continue
+			}
+		}
+		// VF, congiured on both NIC and host
+		nic.sriovConfiguredOnHost = true
+		lif.PCIeAddress = pcieAddr
+	}
+}
+
 // populateStaticHostLabels populates static host labels for NIC metrics
 func (na *NICAgentClient) populateStaticHostLabels() error {
 	na.staticHostLabels = map[string]string{}
@@ -2554,14 +2843,47 @@ func (na *NICAgentClient) getPCIeAddress(ethBDF string) (string, error) {
 	return result, nil
 }
 
+// updateTopology registers the NIC port PFs in the PCIe/NUMA topology
+func (na *NICAgentClient) updateTopology() {
+	devs := map[string]string{}
+	for _, nic := range na.nics {
+		for portUUID := range nic.Ports {
+			if addr := nic.GetPortPcieAddr(portUUID); addr != "" {
+				name := nic.GetPortNetDev(portUUID)
+				if name == "" {
+					name = nic.GetPortName(portUUID)
+				}
+				devs[addr] = name
+			}
+		}
+	}
+	topology.SetDevices(topology.NIC, devs)
+}
+
+// getAffinityGPU returns the id of the GPU closest to the NIC
+func getAffinityGPU(nic *NIC) string {
+	addr := nic.EthBDF
+	if addr == "" {
+		for portUUID := range nic.Ports {
+			if addr = nic.GetPortPcieAddr(portUUID); addr != "" {
+				break
+			}
+		}
+	}
+	if gpu := topology.Closest(topology.NIC, addr); gpu != nil {
+		return gpu.Name
+	}
+	return ""
+}
+
 func (na *NICAgentClient) printNICs() {
 	for nicID, nic := range na.nics {
 		logger.Log.Printf("NIC ID: %s, Product Name: %s, Serial Number: %s, BDF: %s", nicID, nic.ProductName, nic.SerialNumber, nic.EthBDF)
 		for portID, port := range nic.Ports {
-			logger.Log.Printf("Port ID: %s, Name: %s, MAC Address: %s", portID, port.Name, port.MACAddress)
+			logger.Log.Printf("Port ID: %s, Index: %s, Name: %s, MAC Address: %s, PCIe Address: %s", portID, port.Index, port.Name, port.MACAddress, port.PCIeAddress)
 		}
 		for lifID, lif := range nic.Lifs {
-			logger.Log.Printf("LIF ID: %s, Name: %s, PCIe Address: %s, IsPF: %v", lifID, lif.Name, lif.PCIeAddress, lif.IsPF)
+			logger.Log.Printf("LIF ID: %s, Name: %s, PCIe Address: %s, IsPF: %v, Port: %s", lifID, lif.Name, lif.PCIeAddress, lif.IsPF, nic.GetPortName(lif.PortID))
 		}
 	}
 }
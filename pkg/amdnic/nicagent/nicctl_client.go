@@ -143,15 +143,27 @@ func (nc *NICCtlClient) UpdatePortStats(ctx context.Context, workloads map[strin
 		}
 	}
 
+	derivedEnabled := nc.na.isAnyFieldEnabled(derivedPortFields)
+	if derivedEnabled {
+		nc.na.rates.prune(derivedMetricsWindow)
+	}
+
 	// for each reported port stats, find out the port name and report metrics to prometheus
 	for _, nic := range portStats.NIC {
+		card, ok := nc.na.nics[nic.ID]
+		if !ok {
+			logger.Log.Printf("NIC %s not found in the local cache", nic.ID)
+			continue
+		}
 		labels := nc.na.populateLabelsFromNIC(nic.ID)
 		for _, port := range nic.Port {
-			portName := nc.na.nics[nic.ID].GetPortName()
-			portID := nc.na.nics[nic.ID].GetPortIndex()
-			labels[LabelPortName] = portName
-			labels[LabelPortID] = portID
-			labels[LabelPcieBusId] = nc.na.nics[nic.ID].GetPortPcieAddr()
+			portUUID := ""
+			if port.Spec != nil {
+				portUUID = port.Spec.ID
+			}
+			labels[LabelPortName] = card.GetPortName(portUUID)
+			labels[LabelPortID] = card.GetPortIndex(portUUID)
+			labels[LabelPcieBusId] = card.GetPortPcieAddr(portUUID)
 
 			// rx counters
 			nc.na.m.nicPortStatsFramesRxOk.With(labels).Set(float64(utils.StringToUint64(port.Statistics.FRAMES_RX_OK)))
@@ -207,6 +219,10 @@ func (nc *NICCtlClient) UpdatePortStats(ctx context.Context, workloads map[strin
 			nc.na.m.nicPortStatsRsfecUncorrectableWord.With(labels).Set(float64(utils.StringToUint64(port.Statistics.RSFEC_UNCORRECTABLE_WORD)))
 			nc.na.m.nicPortStatsRsfecChSymbolErrCnt.With(labels).Set(float64(utils.StringToUint64(port.Statistics.RSFEC_CH_SYMBOL_ERR_CNT)))
 
+			if derivedEnabled && port.Spec != nil {
+				nc.na.updateDerivedPortMetrics(port.Spec.ID, port.Statistics, labels)
+			}
+
 			// Add rate statistics if available
 			if rateStatsAvailable && port.Spec != nil {
 				if ratePort, ok := rateStatsMap[nic.ID][port.Spec.ID]; ok && ratePort.Statistics != nil {
@@ -266,7 +282,8 @@ func parseRateValue(rateStr string) float64 {
 }
 
 func (nc *NICCtlClient) UpdateLifStats(ctx context.Context, workloads map[string]scheduler.Workload) error {
-	if !fetchLifMetrics {
+	debugLIF := globals.GetDebugMode(ctx).IncludesLIF()
+	if !fetchLifMetrics && !debugLIF {
 		return nil
 	}
 
@@ -293,44 +310,55 @@ func (nc *NICCtlClient) UpdateLifStats(ctx context.Context, workloads map[string
 			}
 			// Add additional labels for NIC metrics
 			labels[LabelEthIntfName] = nc.na.nics[nic.ID].GetLifName(lif.Spec.ID)
-			labels[LabelPortName] = nc.na.nics[nic.ID].GetPortName()
+			labels[LabelPortName] = nc.na.nics[nic.ID].GetLifPortName(lif.Spec.ID)
 			labels[LabelPcieBusId] = nc.na.nics[nic.ID].GetLifPcieAddr(lif.Spec.ID)
 
-			// rx counters
-			nc.na.m.nicLifStatsRxUnicastPackets.With(labels).Set(float64(utils.StringToUint64(lif.Statistics.RX_UNICAST_PACKETS)))
-			nc.na.m.nicLifStatsRxUnicastDropPackets.With(labels).Set(float64(utils.StringToUint64(lif.Statistics.RX_UNICAST_DROP_PACKETS)))
-			nc.na.m.nicLifStatsRxMulticastDropPackets.With(labels).Set(float64(utils.StringToUint64(lif.Statistics.RX_MULTICAST_DROP_PACKETS)))
-			nc.na.m.nicLifStatsRxBroadcastDropPackets.With(labels).Set(float64(utils.StringToUint64(lif.Statistics.RX_BROADCAST_DROP_PACKETS)))
-			nc.na.m.nicLifStatsRxDMAErrors.With(labels).Set(float64(utils.StringToUint64(lif.Statistics.RX_DMA_ERRORS)))
-
-			// tx counters
-			nc.na.m.nicLifStatsTxUnicastPackets.With(labels).Set(float64(utils.StringToUint64(lif.Statistics.TX_UNICAST_PACKETS)))
-			nc.na.m.nicLifStatsTxUnicastDropPackets.With(labels).Set(float64(utils.StringToUint64(lif.Statistics.TX_UNICAST_DROP_PACKETS)))
-			nc.na.m.nicLifStatsTxMulticastDropPackets.With(labels).Set(float64(utils.StringToUint64(lif.Statistics.TX_MULTICAST_DROP_PACKETS)))
-			nc.na.m.nicLifStatsTxBroadcastDropPackets.With(labels).Set(float64(utils.StringToUint64(lif.Statistics.TX_BROADCAST_DROP_PACKETS)))
-			nc.na.m.nicLifStatsTxDMAErrors.With(labels).Set(float64(utils.StringToUint64(lif.Statistics.TX_DMA_ERRORS)))
+			lifValues := map[string]string{
+				// rx counters
+				exportermetrics.NICMetricField_NIC_LIF_STATS_RX_UNICAST_PACKETS.String():        lif.Statistics.RX_UNICAST_PACKETS,
+				exportermetrics.NICMetricField_NIC_LIF_STATS_RX_UNICAST_DROP_PACKETS.String():   lif.Statistics.RX_UNICAST_DROP_PACKETS,
+				exportermetrics.NICMetricField_NIC_LIF_STATS_RX_MULTICAST_DROP_PACKETS.String(): lif.Statistics.RX_MULTICAST_DROP_PACKETS,
+				exportermetrics.NICMetricField_NIC_LIF_STATS_RX_BROADCAST_DROP_PACKETS.String(): lif.Statistics.RX_BROADCAST_DROP_PACKETS,
+				exportermetrics.NICMetricField_NIC_LIF_STATS_RX_DMA_ERRORS.String():             lif.Statistics.RX_DMA_ERRORS,
+
+				// tx counters
+				exportermetrics.NICMetricField_NIC_LIF_STATS_TX_UNICAST_PACKETS.String():        lif.Statistics.TX_UNICAST_PACKETS,
+				exportermetrics.NICMetricField_NIC_LIF_STATS_TX_UNICAST_DROP_PACKETS.String():   lif.Statistics.TX_UNICAST_DROP_PACKETS,
+				exportermetrics.NICMetricField_NIC_LIF_STATS_TX_MULTICAST_DROP_PACKETS.String(): lif.Statistics.TX_MULTICAST_DROP_PACKETS,
+				exportermetrics.NICMetricField_NIC_LIF_STATS_TX_BROADCAST_DROP_PACKETS.String(): lif.Statistics.TX_BROADCAST_DROP_PACKETS,
+				exportermetrics.NICMetricField_NIC_LIF_STATS_TX_DMA_ERRORS.String():             lif.Statistics.TX_DMA_ERRORS,
+			}
+			// all LIF metrics are exported in the LIF debug mode
+			for field, value := range lifValues {
+				nc.na.setField(field, labels, float64(utils.StringToUint64(value)), debugLIF)
+			}
 		}
 	}
 	return nil
 }
 
 func (nc *NICCtlClient) UpdateQPStats(ctx context.Context, workloads map[string]scheduler.Workload) error {
-	debugMode := globals.GetDebugMode(ctx)
+	debugOpts := globals.GetDebugOptions(ctx)
+	debugQP := debugOpts.Mode.IncludesQP()
+	debugLIF := debugOpts.Mode.IncludesLIF()
+	exportQP := debugQP || fetchQPMetrics
+	exportLIFAgg := debugLIF || fetchLIFAggQPMetrics
 	var wg sync.WaitGroup
 
 	start := time.Now()
 	defer func() {
-		if debugMode == globals.DebugModeQP {
+		if debugOpts.Mode != globals.DebugModeNone {
 			elapsed := time.Since(start).Milliseconds()
 			logger.Log.Printf("UpdateQPStats execution time: %d ms", elapsed)
 		}
 	}()
 
-	if debugMode != globals.DebugModeQP && !fetchQPMetrics && !fetchLIFAggQPMetrics {
+	if !exportQP && !exportLIFAgg {
 		// QP metrics NOT enabled, skip fetching QP stats to save resources
 		return nil
 	}
 
+	selector := newQPSelector(debugOpts)
 	for _, nic := range nc.na.nics {
 		wg.Add(1)
 
@@ -354,43 +382,6 @@ func (nc *NICCtlClient) UpdateQPStats(ctx context.Context, workloads map[string]
 				return
 			}
 
-			type lifAggregates struct {
-				sqReqTxNumPackets          float64
-				sqReqTxNumSendMsgsRke      float64
-				sqReqTxNumLocalAckTimeouts float64
-				sqReqTxRnrTimeout          float64
-				sqReqTxTimesSQdrained      float64
-				sqReqTxNumCNPsent          float64
-				sqReqRxNumPackets          float64
-				sqReqRxNumPacketsEcnMarked float64
-				sqQcnCurrByteCounter       float64
-				sqQcnNumByteCounterExpired float64
-				sqQcnNumTimerExpired       float64
-				sqQcnNumAlphaTimerExpired  float64
-				sqQcnNumCNPrcvd            float64
-				sqQcnNumCNPprocessed       float64
-				rqRspTxNumPackets          float64
-				rqRspTxRnrError            float64
-				rqRspTxNumSequenceError    float64
-				rqRspTxRPByteThresholdHits float64
-				rqRspTxRPMaxRateHits       float64
-				rqRspRxNumPackets          float64
-				rqRspRxNumSendMsgsRke      float64
-				rqRspRxNumPacketsEcnMarked float64
-				rqRspRxNumCNPsReceived     float64
-				rqRspRxMaxRecircDrop       float64
-				rqRspRxNumMemWindowInvalid float64
-				rqRspRxNumDuplWriteSendOpc float64
-				rqRspRxNumDupReadBacktrack float64
-				rqRspRxNumDupReadDrop      float64
-				rqQcnCurrByteCounter       float64
-				rqQcnNumByteCounterExpired float64
-				rqQcnNumTimerExpired       float64
-				rqQcnNumAlphaTimerExpired  float64
-				rqQcnNumCNPrcvd            float64
-				rqQcnNumCNPprocessed       float64
-			}
-
 			for _, statsNIC := range rdmaQPStats.NicList {
 				nicLabels := nc.na.populateLabelsFromNIC(statsNIC.ID)
 				for _, qplif := range statsNIC.LifList {
@@ -405,316 +396,36 @@ func (nc *NICCtlClient) UpdateQPStats(ctx context.Context, workloads map[string]
 					lifQPLabels[LabelEthIntfName] = nc.na.nics[statsNIC.ID].GetLifName(qplif.Spec.ID)
 					lifQPLabels[LabelPcieBusId] = nc.na.nics[statsNIC.ID].GetLifPcieAddr(qplif.Spec.ID)
 
-					// Create a copy of lifQPLabels for per-QP metrics (will add qp_id below)
-					var labels map[string]string
-					var setIfEnabled func(string, func())
-
-					if debugMode == globals.DebugModeQP || fetchQPMetrics {
-						labels = maps.Clone(lifQPLabels)
-						// Always set metric in debugModeQP, else check if Field is enabled in config
-						setIfEnabled = func(fieldName string, setFunc func()) {
-							if debugMode == globals.DebugModeQP || nc.na.isFieldEnabled(fieldName) {
-								setFunc()
-							}
-						}
-					}
+					// per-QP metrics are only selected from the LIFs of the filtered pod
+					selectQPs := exportQP && selector.matches(workloadLabels)
 
 					// LIF-level aggregation accumulators (sum of all QPs for this LIF)
-					var lifAgg lifAggregates
+					lifAgg := make(map[string]float64, len(qpFields))
 
 					for _, qp := range qplif.QPStatsList {
 						// Parse each field once and reuse for both per-QP export and LIF aggregation
-						// SQ Requester Tx
-						sqReqTxNumPacket := float64(utils.StringToUint64(qp.Stats.Sq.ReqTx.NUM_PACKET))
-						sqReqTxNumSendMsgsRke := float64(utils.StringToUint64(qp.Stats.Sq.ReqTx.NUM_SEND_MSGS_WITH_RKE))
-						sqReqTxNumLocalAckTimeouts := float64(utils.StringToUint64(qp.Stats.Sq.ReqTx.NUM_LOCAL_ACK_TIMEOUTS))
-						sqReqTxRnrTimeout := float64(utils.StringToUint64(qp.Stats.Sq.ReqTx.RNR_TIMEOUT))
-						sqReqTxTimesSQdrained := float64(utils.StringToUint64(qp.Stats.Sq.ReqTx.TIMES_SQ_DRAINED))
-						sqReqTxNumCNPsent := float64(utils.StringToUint64(qp.Stats.Sq.ReqTx.NUM_CNP_SENT))
-
-						// SQ Requester Rx
-						sqReqRxNumPacket := float64(utils.StringToUint64(qp.Stats.Sq.ReqRx.NUM_PACKET))
-						sqReqRxNumPacketsEcnMarked := float64(utils.StringToUint64(qp.Stats.Sq.ReqRx.NUM_PKTS_WITH_ECN_MARKING))
-
-						// RQ Responder Tx
-						rqRspTxNumPacket := float64(utils.StringToUint64(qp.Stats.Rq.RespTx.NUM_PACKET))
-						rqRspTxRnrError := float64(utils.StringToUint64(qp.Stats.Rq.RespTx.RNR_ERROR))
-						rqRspTxNumSequenceError := float64(utils.StringToUint64(qp.Stats.Rq.RespTx.NUM_SEQUENCE_ERROR))
-						rqRspTxRPByteThresholdHits := float64(utils.StringToUint64(qp.Stats.Rq.RespTx.NUM_RP_BYTE_THRES_HIT))
-						rqRspTxRPMaxRateHits := float64(utils.StringToUint64(qp.Stats.Rq.RespTx.NUM_RP_MAX_RATE_HIT))
-
-						// RQ Responder Rx
-						rqRspRxNumPacket := float64(utils.StringToUint64(qp.Stats.Rq.RespRx.NUM_PACKET))
-						rqRspRxNumSendMsgsRke := float64(utils.StringToUint64(qp.Stats.Rq.RespRx.NUM_SEND_MSGS_WITH_RKE))
-						rqRspRxNumPacketsEcnMarked := float64(utils.StringToUint64(qp.Stats.Rq.RespRx.NUM_PKTS_WITH_ECN_MARKING))
-						rqRspRxNumCNPsReceived := float64(utils.StringToUint64(qp.Stats.Rq.RespRx.NUM_CNPS_RECEIVED))
-						rqRspRxMaxRecircDrop := float64(utils.StringToUint64(qp.Stats.Rq.RespRx.MAX_RECIRC_EXCEEDED_DROP))
-						rqRspRxNumMemWindowInvalid := float64(utils.StringToUint64(qp.Stats.Rq.RespRx.NUM_MEM_WINDOW_INVALID))
-						rqRspRxNumDuplWriteSendOpc := float64(utils.StringToUint64(qp.Stats.Rq.RespRx.NUM_DUPL_WITH_WR_SEND_OPC))
-						rqRspRxNumDupReadBacktrack := float64(utils.StringToUint64(qp.Stats.Rq.RespRx.NUM_DUPL_READ_BACKTRACK))
-						rqRspRxNumDupReadDrop := float64(utils.StringToUint64(qp.Stats.Rq.RespRx.NUM_DUPL_READ_ATOMIC_DROP))
-
-						var sqQcnCurrByteCounter, sqQcnNumByteCounterExpired, sqQcnNumTimerExpired, sqQcnNumAlphaTimerExpired, sqQcnNumCNPrcvd, sqQcnNumCNPprocessed float64
-						var rqQcnCurrByteCounter, rqQcnNumByteCounterExpired, rqQcnNumTimerExpired, rqQcnNumAlphaTimerExpired, rqQcnNumCNPrcvd, rqQcnNumCNPprocessed float64
-
-						if qp.Stats.Sq.DcQcn != nil {
-							sqQcnCurrByteCounter = float64(utils.StringToUint64(qp.Stats.Sq.DcQcn.CURR_BYTE_COUNTER))
-							sqQcnNumByteCounterExpired = float64(utils.StringToUint64(qp.Stats.Sq.DcQcn.NUM_BYTE_COUNTER_EXPIRED))
-							sqQcnNumTimerExpired = float64(utils.StringToUint64(qp.Stats.Sq.DcQcn.NUM_TIMER_EXPIRED))
-							sqQcnNumAlphaTimerExpired = float64(utils.StringToUint64(qp.Stats.Sq.DcQcn.NUM_ALPHA_TIMER_EXPIRED))
-							sqQcnNumCNPrcvd = float64(utils.StringToUint64(qp.Stats.Sq.DcQcn.NUM_CNP_RCVD))
-							sqQcnNumCNPprocessed = float64(utils.StringToUint64(qp.Stats.Sq.DcQcn.NUM_CNP_PROCESSED))
-						}
-						if qp.Stats.Rq.DcQcn != nil {
-							rqQcnCurrByteCounter = float64(utils.StringToUint64(qp.Stats.Rq.DcQcn.CURR_BYTE_COUNTER))
-							rqQcnNumByteCounterExpired = float64(utils.StringToUint64(qp.Stats.Rq.DcQcn.NUM_BYTE_COUNTER_EXPIRED))
-							rqQcnNumTimerExpired = float64(utils.StringToUint64(qp.Stats.Rq.DcQcn.NUM_TIMER_EXPIRED))
-							rqQcnNumAlphaTimerExpired = float64(utils.StringToUint64(qp.Stats.Rq.DcQcn.NUM_ALPHA_TIMER_EXPIRED))
-							rqQcnNumCNPrcvd = float64(utils.StringToUint64(qp.Stats.Rq.DcQcn.NUM_CNP_RCVD))
-							rqQcnNumCNPprocessed = float64(utils.StringToUint64(qp.Stats.Rq.DcQcn.NUM_CNP_PROCESSED))
-						}
+						values := qpStatValues(qp)
 
-						// Export per-QP metrics
-						if debugMode == globals.DebugModeQP || fetchQPMetrics {
+						if selectQPs {
 							// Add QueuePair ID label
+							labels := maps.Clone(lifQPLabels)
 							labels[LabelQPID] = qp.Spec.ID
-
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_PACKET.String(),
-								func() { nc.na.m.qpSqReqTxNumPackets.With(labels).Set(sqReqTxNumPacket) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_SEND_MSGS_WITH_RKE.String(),
-								func() { nc.na.m.qpSqReqTxNumSendMsgsRke.With(labels).Set(sqReqTxNumSendMsgsRke) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_LOCAL_ACK_TIMEOUTS.String(),
-								func() { nc.na.m.qpSqReqTxNumLocalAckTimeouts.With(labels).Set(sqReqTxNumLocalAckTimeouts) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_SQ_REQ_TX_RNR_TIMEOUT.String(),
-								func() { nc.na.m.qpSqReqTxRnrTimeout.With(labels).Set(sqReqTxRnrTimeout) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_SQ_REQ_TX_TIMES_SQ_DRAINED.String(),
-								func() { nc.na.m.qpSqReqTxTimesSQdrained.With(labels).Set(sqReqTxTimesSQdrained) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_CNP_SENT.String(),
-								func() { nc.na.m.qpSqReqTxNumCNPsent.With(labels).Set(sqReqTxNumCNPsent) },
-							)
-
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_SQ_REQ_RX_NUM_PACKET.String(),
-								func() { nc.na.m.qpSqReqRxNumPackets.With(labels).Set(sqReqRxNumPacket) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_SQ_REQ_RX_NUM_PKTS_WITH_ECN_MARKING.String(),
-								func() { nc.na.m.qpSqReqRxNumPacketsEcnMarked.With(labels).Set(sqReqRxNumPacketsEcnMarked) },
-							)
-
-							// Only export SQ DCQCN metrics when DcQcn stats are present
-							if qp.Stats.Sq.DcQcn != nil {
-								setIfEnabled(
-									exportermetrics.NICMetricField_QP_SQ_QCN_CURR_BYTE_COUNTER.String(),
-									func() { nc.na.m.qpSqQcnCurrByteCounter.With(labels).Set(sqQcnCurrByteCounter) },
-								)
-								setIfEnabled(
-									exportermetrics.NICMetricField_QP_SQ_QCN_NUM_BYTE_COUNTER_EXPIRED.String(),
-									func() { nc.na.m.qpSqQcnNumByteCounterExpired.With(labels).Set(sqQcnNumByteCounterExpired) },
-								)
-								setIfEnabled(
-									exportermetrics.NICMetricField_QP_SQ_QCN_NUM_TIMER_EXPIRED.String(),
-									func() { nc.na.m.qpSqQcnNumTimerExpired.With(labels).Set(sqQcnNumTimerExpired) },
-								)
-								setIfEnabled(
-									exportermetrics.NICMetricField_QP_SQ_QCN_NUM_ALPHA_TIMER_EXPIRED.String(),
-									func() { nc.na.m.qpSqQcnNumAlphaTimerExpired.With(labels).Set(sqQcnNumAlphaTimerExpired) },
-								)
-								setIfEnabled(
-									exportermetrics.NICMetricField_QP_SQ_QCN_NUM_CNP_RCVD.String(),
-									func() { nc.na.m.qpSqQcnNumCNPrcvd.With(labels).Set(sqQcnNumCNPrcvd) },
-								)
-								setIfEnabled(
-									exportermetrics.NICMetricField_QP_SQ_QCN_NUM_CNP_PROCESSED.String(),
-									func() { nc.na.m.qpSqQcnNumCNPprocessed.With(labels).Set(sqQcnNumCNPprocessed) },
-								)
-							}
-
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_TX_NUM_PACKET.String(),
-								func() { nc.na.m.qpRqRspTxNumPackets.With(labels).Set(rqRspTxNumPacket) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_TX_RNR_ERROR.String(),
-								func() { nc.na.m.qpRqRspTxRnrError.With(labels).Set(rqRspTxRnrError) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_TX_NUM_SEQUENCE_ERROR.String(),
-								func() { nc.na.m.qpRqRspTxNumSequenceError.With(labels).Set(rqRspTxNumSequenceError) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_TX_NUM_RP_BYTE_THRES_HIT.String(),
-								func() { nc.na.m.qpRqRspTxRPByteThresholdHits.With(labels).Set(rqRspTxRPByteThresholdHits) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_TX_NUM_RP_MAX_RATE_HIT.String(),
-								func() { nc.na.m.qpRqRspTxRPMaxRateHits.With(labels).Set(rqRspTxRPMaxRateHits) },
-							)
-
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_PACKET.String(),
-								func() { nc.na.m.qpRqRspRxNumPackets.With(labels).Set(rqRspRxNumPacket) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_SEND_MSGS_WITH_RKE.String(),
-								func() { nc.na.m.qpRqRspRxNumSendMsgsRke.With(labels).Set(rqRspRxNumSendMsgsRke) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_PKTS_WITH_ECN_MARKING.String(),
-								func() { nc.na.m.qpRqRspRxNumPacketsEcnMarked.With(labels).Set(rqRspRxNumPacketsEcnMarked) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_CNPS_RECEIVED.String(),
-								func() { nc.na.m.qpRqRspRxNumCNPsReceived.With(labels).Set(rqRspRxNumCNPsReceived) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_RX_MAX_RECIRC_EXCEEDED_DROP.String(),
-								func() { nc.na.m.qpRqRspRxMaxRecircDrop.With(labels).Set(rqRspRxMaxRecircDrop) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_MEM_WINDOW_INVALID.String(),
-								func() { nc.na.m.qpRqRspRxNumMemWindowInvalid.With(labels).Set(rqRspRxNumMemWindowInvalid) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_DUPL_WITH_WR_SEND_OPC.String(),
-								func() { nc.na.m.qpRqRspRxNumDuplWriteSendOpc.With(labels).Set(rqRspRxNumDuplWriteSendOpc) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_DUPL_READ_BACKTRACK.String(),
-								func() { nc.na.m.qpRqRspRxNumDupReadBacktrack.With(labels).Set(rqRspRxNumDupReadBacktrack) },
-							)
-							setIfEnabled(
-								exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_DUPL_READ_ATOMIC_DROP.String(),
-								func() { nc.na.m.qpRqRspRxNumDupReadDrop.With(labels).Set(rqRspRxNumDupReadDrop) },
-							)
-
-							// Only export RQ DCQCN metrics when DcQcn stats are present
-							if qp.Stats.Rq.DcQcn != nil {
-								setIfEnabled(
-									exportermetrics.NICMetricField_QP_RQ_QCN_CURR_BYTE_COUNTER.String(),
-									func() { nc.na.m.qpRqQcnCurrByteCounter.With(labels).Set(rqQcnCurrByteCounter) },
-								)
-								setIfEnabled(
-									exportermetrics.NICMetricField_QP_RQ_QCN_NUM_BYTE_COUNTER_EXPIRED.String(),
-									func() { nc.na.m.qpRqQcnNumByteCounterExpired.With(labels).Set(rqQcnNumByteCounterExpired) },
-								)
-								setIfEnabled(
-									exportermetrics.NICMetricField_QP_RQ_QCN_NUM_TIMER_EXPIRED.String(),
-									func() { nc.na.m.qpRqQcnNumTimerExpired.With(labels).Set(rqQcnNumTimerExpired) },
-								)
-								setIfEnabled(
-									exportermetrics.NICMetricField_QP_RQ_QCN_NUM_ALPHA_TIMER_EXPIRED.String(),
-									func() { nc.na.m.qpRqQcnNumAlphaTimerExpired.With(labels).Set(rqQcnNumAlphaTimerExpired) },
-								)
-								setIfEnabled(
-									exportermetrics.NICMetricField_QP_RQ_QCN_NUM_CNP_RCVD.String(),
-									func() { nc.na.m.qpRqQcnNumCNPrcvd.With(labels).Set(rqQcnNumCNPrcvd) },
-								)
-								setIfEnabled(
-									exportermetrics.NICMetricField_QP_RQ_QCN_NUM_CNP_PROCESSED.String(),
-									func() { nc.na.m.qpRqQcnNumCNPprocessed.With(labels).Set(rqQcnNumCNPprocessed) },
-								)
-							}
-
+							selector.add(qpSample{labels: labels, values: values})
 						}
 
 						// Accumulate values for LIF-aggregated metrics
-						if fetchLIFAggQPMetrics {
-							lifAgg.sqReqTxNumPackets += sqReqTxNumPacket
-							lifAgg.sqReqTxNumSendMsgsRke += sqReqTxNumSendMsgsRke
-							lifAgg.sqReqTxNumLocalAckTimeouts += sqReqTxNumLocalAckTimeouts
-							lifAgg.sqReqTxRnrTimeout += sqReqTxRnrTimeout
-							lifAgg.sqReqTxTimesSQdrained += sqReqTxTimesSQdrained
-							lifAgg.sqReqTxNumCNPsent += sqReqTxNumCNPsent
-
-							lifAgg.sqReqRxNumPackets += sqReqRxNumPacket
-							lifAgg.sqReqRxNumPacketsEcnMarked += sqReqRxNumPacketsEcnMarked
-
-							lifAgg.sqQcnCurrByteCounter += sqQcnCurrByteCounter
-							lifAgg.sqQcnNumByteCounterExpired += sqQcnNumByteCounterExpired
-							lifAgg.sqQcnNumTimerExpired += sqQcnNumTimerExpired
-							lifAgg.sqQcnNumAlphaTimerExpired += sqQcnNumAlphaTimerExpired
-							lifAgg.sqQcnNumCNPrcvd += sqQcnNumCNPrcvd
-							lifAgg.sqQcnNumCNPprocessed += sqQcnNumCNPprocessed
-
-							lifAgg.rqRspTxNumPackets += rqRspTxNumPacket
-							lifAgg.rqRspTxRnrError += rqRspTxRnrError
-							lifAgg.rqRspTxNumSequenceError += rqRspTxNumSequenceError
-							lifAgg.rqRspTxRPByteThresholdHits += rqRspTxRPByteThresholdHits
-							lifAgg.rqRspTxRPMaxRateHits += rqRspTxRPMaxRateHits
-
-							lifAgg.rqRspRxNumPackets += rqRspRxNumPacket
-							lifAgg.rqRspRxNumSendMsgsRke += rqRspRxNumSendMsgsRke
-							lifAgg.rqRspRxNumPacketsEcnMarked += rqRspRxNumPacketsEcnMarked
-							lifAgg.rqRspRxNumCNPsReceived += rqRspRxNumCNPsReceived
-							lifAgg.rqRspRxMaxRecircDrop += rqRspRxMaxRecircDrop
-							lifAgg.rqRspRxNumMemWindowInvalid += rqRspRxNumMemWindowInvalid
-							lifAgg.rqRspRxNumDuplWriteSendOpc += rqRspRxNumDuplWriteSendOpc
-							lifAgg.rqRspRxNumDupReadBacktrack += rqRspRxNumDupReadBacktrack
-							lifAgg.rqRspRxNumDupReadDrop += rqRspRxNumDupReadDrop
-
-							lifAgg.rqQcnCurrByteCounter += rqQcnCurrByteCounter
-							lifAgg.rqQcnNumByteCounterExpired += rqQcnNumByteCounterExpired
-							lifAgg.rqQcnNumTimerExpired += rqQcnNumTimerExpired
-							lifAgg.rqQcnNumAlphaTimerExpired += rqQcnNumAlphaTimerExpired
-							lifAgg.rqQcnNumCNPrcvd += rqQcnNumCNPrcvd
-							lifAgg.rqQcnNumCNPprocessed += rqQcnNumCNPprocessed
-
+						if exportLIFAgg {
+							for field, value := range values {
+								lifAgg[field] += value
+							}
 						}
 					}
 
-					// Export LIF-aggregated metrics
-					if fetchLIFAggQPMetrics {
-						nc.na.m.lifQpSqReqTxNumPacketTotal.With(lifQPLabels).Set(lifAgg.sqReqTxNumPackets)
-						nc.na.m.lifQpSqReqTxNumSendMsgsWithRkeTotal.With(lifQPLabels).Set(lifAgg.sqReqTxNumSendMsgsRke)
-						nc.na.m.lifQpSqReqTxNumLocalAckTimeoutsTotal.With(lifQPLabels).Set(lifAgg.sqReqTxNumLocalAckTimeouts)
-						nc.na.m.lifQpSqReqTxRnrTimeoutTotal.With(lifQPLabels).Set(lifAgg.sqReqTxRnrTimeout)
-						nc.na.m.lifQpSqReqTxTimesSQdrainedTotal.With(lifQPLabels).Set(lifAgg.sqReqTxTimesSQdrained)
-						nc.na.m.lifQpSqReqTxNumCNPsentTotal.With(lifQPLabels).Set(lifAgg.sqReqTxNumCNPsent)
-
-						nc.na.m.lifQpSqReqRxNumPacketTotal.With(lifQPLabels).Set(lifAgg.sqReqRxNumPackets)
-						nc.na.m.lifQpSqReqRxNumPktsWithEcnMarkingTotal.With(lifQPLabels).Set(lifAgg.sqReqRxNumPacketsEcnMarked)
-
-						nc.na.m.lifQpSqQcnCurrByteCounterTotal.With(lifQPLabels).Set(lifAgg.sqQcnCurrByteCounter)
-						nc.na.m.lifQpSqQcnNumByteCounterExpiredTotal.With(lifQPLabels).Set(lifAgg.sqQcnNumByteCounterExpired)
-						nc.na.m.lifQpSqQcnNumTimerExpiredTotal.With(lifQPLabels).Set(lifAgg.sqQcnNumTimerExpired)
-						nc.na.m.lifQpSqQcnNumAlphaTimerExpiredTotal.With(lifQPLabels).Set(lifAgg.sqQcnNumAlphaTimerExpired)
-						nc.na.m.lifQpSqQcnNumCNPrcvdTotal.With(lifQPLabels).Set(lifAgg.sqQcnNumCNPrcvd)
-						nc.na.m.lifQpSqQcnNumCNPprocessedTotal.With(lifQPLabels).Set(lifAgg.sqQcnNumCNPprocessed)
-
-						nc.na.m.lifQpRqRspTxNumPacketTotal.With(lifQPLabels).Set(lifAgg.rqRspTxNumPackets)
-						nc.na.m.lifQpRqRspTxRnrErrorTotal.With(lifQPLabels).Set(lifAgg.rqRspTxRnrError)
-						nc.na.m.lifQpRqRspTxNumSequenceErrorTotal.With(lifQPLabels).Set(lifAgg.rqRspTxNumSequenceError)
-						nc.na.m.lifQpRqRspTxNumRpByteThresHitTotal.With(lifQPLabels).Set(lifAgg.rqRspTxRPByteThresholdHits)
-						nc.na.m.lifQpRqRspTxNumRpMaxRateHitTotal.With(lifQPLabels).Set(lifAgg.rqRspTxRPMaxRateHits)
-
-						nc.na.m.lifQpRqRspRxNumPacketTotal.With(lifQPLabels).Set(lifAgg.rqRspRxNumPackets)
-						nc.na.m.lifQpRqRspRxNumSendMsgsWithRkeTotal.With(lifQPLabels).Set(lifAgg.rqRspRxNumSendMsgsRke)
-						nc.na.m.lifQpRqRspRxNumPktsWithEcnMarkingTotal.With(lifQPLabels).Set(lifAgg.rqRspRxNumPacketsEcnMarked)
-						nc.na.m.lifQpRqRspRxNumCNPsReceivedTotal.With(lifQPLabels).Set(lifAgg.rqRspRxNumCNPsReceived)
-						nc.na.m.lifQpRqRspRxMaxRecircExceededDropTotal.With(lifQPLabels).Set(lifAgg.rqRspRxMaxRecircDrop)
-						nc.na.m.lifQpRqRspRxNumMemWindowInvalidTotal.With(lifQPLabels).Set(lifAgg.rqRspRxNumMemWindowInvalid)
-						nc.na.m.lifQpRqRspRxNumDuplWithWrSendOpcTotal.With(lifQPLabels).Set(lifAgg.rqRspRxNumDuplWriteSendOpc)
-						nc.na.m.lifQpRqRspRxNumDuplReadBacktrackTotal.With(lifQPLabels).Set(lifAgg.rqRspRxNumDupReadBacktrack)
-						nc.na.m.lifQpRqRspRxNumDuplReadAtomicDropTotal.With(lifQPLabels).Set(lifAgg.rqRspRxNumDupReadDrop)
-
-						nc.na.m.lifQpRqQcnCurrByteCounterTotal.With(lifQPLabels).Set(lifAgg.rqQcnCurrByteCounter)
-						nc.na.m.lifQpRqQcnNumByteCounterExpiredTotal.With(lifQPLabels).Set(lifAgg.rqQcnNumByteCounterExpired)
-						nc.na.m.lifQpRqQcnNumTimerExpiredTotal.With(lifQPLabels).Set(lifAgg.rqQcnNumTimerExpired)
-						nc.na.m.lifQpRqQcnNumAlphaTimerExpiredTotal.With(lifQPLabels).Set(lifAgg.rqQcnNumAlphaTimerExpired)
-						nc.na.m.lifQpRqQcnNumCNPrcvdTotal.With(lifQPLabels).Set(lifAgg.rqQcnNumCNPrcvd)
-						nc.na.m.lifQpRqQcnNumCNPprocessedTotal.With(lifQPLabels).Set(lifAgg.rqQcnNumCNPprocessed)
+					// Export LIF-aggregated metrics, all of them in the LIF debug mode
+					if exportLIFAgg {
+						for _, field := range qpFields {
+							nc.na.setField(lifQPAggField(field), lifQPLabels, lifAgg[field], debugLIF)
+						}
 					}
 				}
 			}
@@ -722,5 +433,13 @@ func (nc *NICCtlClient) UpdateQPStats(ctx context.Context, workloads map[string]
 
 	}
 	wg.Wait()
+
+	// Export per-QP metrics, always set in the QP debug mode, else check if
+	// the field is enabled in config
+	for _, sample := range selector.selected() {
+		for field, value := range sample.values {
+			nc.na.setField(field, sample.labels, value, debugQP)
+		}
+	}
 	return nil
 }
@@ -0,0 +1,226 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+var (
+	// per-QP fields, the LIF aggregated fields are LIF_<field>_TOTAL
+	qpFields = []string{
+		exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_PACKET.String(),
+		exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_SEND_MSGS_WITH_RKE.String(),
+		exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_LOCAL_ACK_TIMEOUTS.String(),
+		exportermetrics.NICMetricField_QP_SQ_REQ_TX_RNR_TIMEOUT.String(),
+		exportermetrics.NICMetricField_QP_SQ_REQ_TX_TIMES_SQ_DRAINED.String(),
+		exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_CNP_SENT.String(),
+		exportermetrics.NICMetricField_QP_SQ_REQ_RX_NUM_PACKET.String(),
+		exportermetrics.NICMetricField_QP_SQ_REQ_RX_NUM_PKTS_WITH_ECN_MARKING.String(),
+		exportermetrics.NICMetricField_QP_SQ_QCN_CURR_BYTE_COUNTER.String(),
+		exportermetrics.NICMetricField_QP_SQ_QCN_NUM_BYTE_COUNTER_EXPIRED.String(),
+		exportermetrics.NICMetricField_QP_SQ_QCN_NUM_TIMER_EXPIRED.String(),
+		exportermetrics.NICMetricField_QP_SQ_QCN_NUM_ALPHA_TIMER_EXPIRED.String(),
+		exportermetrics.NICMetricField_QP_SQ_QCN_NUM_CNP_RCVD.String(),
+		exportermetrics.NICMetricField_QP_SQ_QCN_NUM_CNP_PROCESSED.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_TX_NUM_PACKET.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_TX_RNR_ERROR.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_TX_NUM_SEQUENCE_ERROR.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_TX_NUM_RP_BYTE_THRES_HIT.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_TX_NUM_RP_MAX_RATE_HIT.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_PACKET.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_SEND_MSGS_WITH_RKE.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_PKTS_WITH_ECN_MARKING.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_CNPS_RECEIVED.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_MAX_RECIRC_EXCEEDED_DROP.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_MEM_WINDOW_INVALID.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_DUPL_WITH_WR_SEND_OPC.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_DUPL_READ_BACKTRACK.String(),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_DUPL_READ_ATOMIC_DROP.String(),
+		exportermetrics.NICMetricField_QP_RQ_QCN_CURR_BYTE_COUNTER.String(),
+		exportermetrics.NICMetricField_QP_RQ_QCN_NUM_BYTE_COUNTER_EXPIRED.String(),
+		exportermetrics.NICMetricField_QP_RQ_QCN_NUM_TIMER_EXPIRED.String(),
+		exportermetrics.NICMetricField_QP_RQ_QCN_NUM_ALPHA_TIMER_EXPIRED.String(),
+		exportermetrics.NICMetricField_QP_RQ_QCN_NUM_CNP_RCVD.String(),
+		exportermetrics.NICMetricField_QP_RQ_QCN_NUM_CNP_PROCESSED.String(),
+	}
+
+	// queue pairs are ranked by the transmitted packets unless sort_by is set
+	defaultQPSortField = exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_PACKET.String()
+)
+
+// lifQPAggField returns the LIF aggregated field of a per-QP field
+func lifQPAggField(qpField string) string {
+	return "LIF_" + qpField + "_TOTAL"
+}
+
+// qpStatValues returns the statistics of a queue pair by QP field, the
+// DCQCN fields are only present when the queue pair reports them
+func qpStatValues(qp *nicmetrics.QPStatsInfo) map[string]float64 {
+	val := func(s string) float64 {
+		return float64(utils.StringToUint64(s))
+	}
+	sq, rq := qp.Stats.Sq, qp.Stats.Rq
+	values := map[string]float64{
+		// SQ Requester Tx
+		exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_PACKET.String():             val(sq.ReqTx.NUM_PACKET),
+		exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_SEND_MSGS_WITH_RKE.String(): val(sq.ReqTx.NUM_SEND_MSGS_WITH_RKE),
+		exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_LOCAL_ACK_TIMEOUTS.String(): val(sq.ReqTx.NUM_LOCAL_ACK_TIMEOUTS),
+		exportermetrics.NICMetricField_QP_SQ_REQ_TX_RNR_TIMEOUT.String():            val(sq.ReqTx.RNR_TIMEOUT),
+		exportermetrics.NICMetricField_QP_SQ_REQ_TX_TIMES_SQ_DRAINED.String():       val(sq.ReqTx.TIMES_SQ_DRAINED),
+		exportermetrics.NICMetricField_QP_SQ_REQ_TX_NUM_CNP_SENT.String():           val(sq.ReqTx.NUM_CNP_SENT),
+
+		// SQ Requester Rx
+		exportermetrics.NICMetricField_QP_SQ_REQ_RX_NUM_PACKET.String():                val(sq.ReqRx.NUM_PACKET),
+		exportermetrics.NICMetricField_QP_SQ_REQ_RX_NUM_PKTS_WITH_ECN_MARKING.String(): val(sq.ReqRx.NUM_PKTS_WITH_ECN_MARKING),
+
+		// RQ Responder Tx
+		exportermetrics.NICMetricField_QP_RQ_RSP_TX_NUM_PACKET.String():            val(rq.RespTx.NUM_PACKET),
+		exportermetrics.NICMetricField_QP_RQ_RSP_TX_RNR_ERROR.String():             val(rq.RespTx.RNR_ERROR),
+		exportermetrics.NICMetricField_QP_RQ_RSP_TX_NUM_SEQUENCE_ERROR.String():    val(rq.RespTx.NUM_SEQUENCE_ERROR),
+		exportermetrics.NICMetricField_QP_RQ_RSP_TX_NUM_RP_BYTE_THRES_HIT.String(): val(rq.RespTx.NUM_RP_BYTE_THRES_HIT),
+		exportermetrics.NICMetricField_QP_RQ_RSP_TX_NUM_RP_MAX_RATE_HIT.String():   val(rq.RespTx.NUM_RP_MAX_RATE_HIT),
+
+		// RQ Responder Rx
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_PACKET.String():                val(rq.RespRx.NUM_PACKET),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_SEND_MSGS_WITH_RKE.String():    val(rq.RespRx.NUM_SEND_MSGS_WITH_RKE),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_PKTS_WITH_ECN_MARKING.String(): val(rq.RespRx.NUM_PKTS_WITH_ECN_MARKING),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_CNPS_RECEIVED.String():         val(rq.RespRx.NUM_CNPS_RECEIVED),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_MAX_RECIRC_EXCEEDED_DROP.String():  val(rq.RespRx.MAX_RECIRC_EXCEEDED_DROP),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_MEM_WINDOW_INVALID.String():    val(rq.RespRx.NUM_MEM_WINDOW_INVALID),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_DUPL_WITH_WR_SEND_OPC.String(): val(rq.RespRx.NUM_DUPL_WITH_WR_SEND_OPC),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_DUPL_READ_BACKTRACK.String():   val(rq.RespRx.NUM_DUPL_READ_BACKTRACK),
+		exportermetrics.NICMetricField_QP_RQ_RSP_RX_NUM_DUPL_READ_ATOMIC_DROP.String(): val(rq.RespRx.NUM_DUPL_READ_ATOMIC_DROP),
+	}
+	if sq.DcQcn != nil {
+		values[exportermetrics.NICMetricField_QP_SQ_QCN_CURR_BYTE_COUNTER.String()] = val(sq.DcQcn.CURR_BYTE_COUNTER)
+		values[exportermetrics.NICMetricField_QP_SQ_QCN_NUM_BYTE_COUNTER_EXPIRED.String()] = val(sq.DcQcn.NUM_BYTE_COUNTER_EXPIRED)
+		values[exportermetrics.NICMetricField_QP_SQ_QCN_NUM_TIMER_EXPIRED.String()] = val(sq.DcQcn.NUM_TIMER_EXPIRED)
+		values[exportermetrics.NICMetricField_QP_SQ_QCN_NUM_ALPHA_TIMER_EXPIRED.String()] = val(sq.DcQcn.NUM_ALPHA_TIMER_EXPIRED)
+		values[exportermetrics.NICMetricField_QP_SQ_QCN_NUM_CNP_RCVD.String()] = val(sq.DcQcn.NUM_CNP_RCVD)
+		values[exportermetrics.NICMetricField_QP_SQ_QCN_NUM_CNP_PROCESSED.String()] = val(sq.DcQcn.NUM_CNP_PROCESSED)
+	}
+	if rq.DcQcn != nil {
+		values[exportermetrics.NICMetricField_QP_RQ_QCN_CURR_BYTE_COUNTER.String()] = val(rq.DcQcn.CURR_BYTE_COUNTER)
+		values[exportermetrics.NICMetricField_QP_RQ_QCN_NUM_BYTE_COUNTER_EXPIRED.String()] = val(rq.DcQcn.NUM_BYTE_COUNTER_EXPIRED)
+		values[exportermetrics.NICMetricField_QP_RQ_QCN_NUM_TIMER_EXPIRED.String()] = val(rq.DcQcn.NUM_TIMER_EXPIRED)
+		values[exportermetrics.NICMetricField_QP_RQ_QCN_NUM_ALPHA_TIMER_EXPIRED.String()] = val(rq.DcQcn.NUM_ALPHA_TIMER_EXPIRED)
+		values[exportermetrics.NICMetricField_QP_RQ_QCN_NUM_CNP_RCVD.String()] = val(rq.DcQcn.NUM_CNP_RCVD)
+		values[exportermetrics.NICMetricField_QP_RQ_QCN_NUM_CNP_PROCESSED.String()] = val(rq.DcQcn.NUM_CNP_PROCESSED)
+	}
+	return values
+}
+
+// qpSample is a queue pair to be exported with its labels
+type qpSample struct {
+	labels map[string]string
+	values map[string]float64 // values by QP field
+}
+
+// qpSelector collects the queue pairs of all NICs and selects the ones to
+// export by the pod filter and the top-k of the sort field
+type qpSelector struct {
+	sync.Mutex
+	topK      int
+	sortBy    string
+	pod       string
+	namespace string
+	samples   []qpSample
+}
+
+func newQPSelector(opts globals.DebugOptions) *qpSelector {
+	qs := &qpSelector{
+		topK:      opts.TopK,
+		sortBy:    defaultQPSortField,
+		pod:       opts.Pod,
+		namespace: opts.Namespace,
+	}
+	if opts.SortBy != "" {
+		sortBy := strings.ToUpper(opts.SortBy)
+		valid := false
+		for _, field := range qpFields {
+			if field == sortBy {
+				valid = true
+				break
+			}
+		}
+		if valid {
+			qs.sortBy = sortBy
+		} else {
+			logger.Log.Printf("invalid QP sort field %s, sorting by %s", opts.SortBy, defaultQPSortField)
+		}
+	}
+	return qs
+}
+
+// matches returns true if the workload of the LIF passes the pod filter
+func (qs *qpSelector) matches(workloadLabels map[string]string) bool {
+	if qs.pod != "" && workloadLabels[strings.ToLower(exportermetrics.MetricLabel_POD.String())] != qs.pod {
+		return false
+	}
+	if qs.namespace != "" && workloadLabels[strings.ToLower(exportermetrics.MetricLabel_NAMESPACE.String())] != qs.namespace {
+		return false
+	}
+	return true
+}
+
+func (qs *qpSelector) add(sample qpSample) {
+	qs.Lock()
+	defer qs.Unlock()
+	qs.samples = append(qs.samples, sample)
+}
+
+// selected returns the queue pairs to export, the top-k by the sort field
+// across all NICs if top-k is set
+func (qs *qpSelector) selected() []qpSample {
+	qs.Lock()
+	defer qs.Unlock()
+	if qs.topK <= 0 || len(qs.samples) <= qs.topK {
+		return qs.samples
+	}
+	sort.Slice(qs.samples, func(i, j int) bool {
+		a, b := qs.samples[i], qs.samples[j]
+		if a.values[qs.sortBy] != b.values[qs.sortBy] {
+			return a.values[qs.sortBy] > b.values[qs.sortBy]
+		}
+		// NICs are collected concurrently, keep the order of ties stable
+		if a.labels[LabelEthIntfName] != b.labels[LabelEthIntfName] {
+			return a.labels[LabelEthIntfName] < b.labels[LabelEthIntfName]
+		}
+		return a.labels[LabelQPID] < b.labels[LabelQPID]
+	})
+	return qs.samples[:qs.topK]
+}
+
+// setField sets the metric of the field if it is enabled or forced by the
+// debug mode
+func (na *NICAgentClient) setField(field string, labels map[string]string, value float64, force bool) {
+	if !force && !na.isFieldEnabled(field) {
+		return
+	}
+	if prommetric, ok := fieldMetricsMap[field]; ok {
+		prommetric.Metric.With(labels).Set(value)
+	}
+}
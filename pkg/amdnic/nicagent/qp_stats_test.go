@@ -0,0 +1,131 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+)
+
+func TestQPFields(t *testing.T) {
+	for _, field := range qpFields {
+		if _, ok := exportermetrics.NICMetricField_value[field]; !ok {
+			t.Errorf("%s is not a NIC metric field", field)
+		}
+		if _, ok := exportermetrics.NICMetricField_value[lifQPAggField(field)]; !ok {
+			t.Errorf("%s has no LIF aggregated field", field)
+		}
+	}
+
+	qp := &nicmetrics.QPStatsInfo{
+		Stats: &nicmetrics.QPStats{
+			Sq: &nicmetrics.SendQStats{
+				ReqTx: &nicmetrics.RequesterTxStats{NUM_PACKET: "10", NUM_LOCAL_ACK_TIMEOUTS: "2"},
+				ReqRx: &nicmetrics.RequesterRxStats{},
+			},
+			Rq: &nicmetrics.RecvQStats{
+				RespTx: &nicmetrics.ResponderTxStats{},
+				RespRx: &nicmetrics.ResponderRxStats{NUM_PACKET: "7"},
+			},
+		},
+	}
+	values := qpStatValues(qp)
+	if len(values) != len(qpFields)-12 {
+		t.Errorf("expected %d values without DCQCN stats, got %d", len(qpFields)-12, len(values))
+	}
+	if values["QP_SQ_REQ_TX_NUM_PACKET"] != 10 || values["QP_SQ_REQ_TX_NUM_LOCAL_ACK_TIMEOUTS"] != 2 ||
+		values["QP_RQ_RSP_RX_NUM_PACKET"] != 7 {
+		t.Errorf("unexpected values %v", values)
+	}
+}
+
+func TestQPSelector(t *testing.T) {
+	sample := func(lif, qp, pod string, txPkts, ackTimeouts float64) qpSample {
+		return qpSample{
+			labels: map[string]string{LabelEthIntfName: lif, LabelQPID: qp, "pod": pod, "namespace": "default"},
+			values: map[string]float64{
+				"QP_SQ_REQ_TX_NUM_PACKET":             txPkts,
+				"QP_SQ_REQ_TX_NUM_LOCAL_ACK_TIMEOUTS": ackTimeouts,
+			},
+		}
+	}
+	samples := []qpSample{
+		sample("enp68s0", "1", "train-0", 100, 0),
+		sample("enp68s0", "2", "train-0", 500, 3),
+		sample("enp132s0", "1", "train-1", 300, 9),
+		sample("enp132s0", "2", "infer-0", 300, 1),
+	}
+
+	tests := []struct {
+		name     string
+		opts     globals.DebugOptions
+		expected []string // eth interface and qp id of the selected queue pairs
+	}{
+		{
+			name:     "all queue pairs",
+			opts:     globals.DebugOptions{Mode: globals.DebugModeQP},
+			expected: []string{"enp68s0/1", "enp68s0/2", "enp132s0/1", "enp132s0/2"},
+		},
+		{
+			name:     "top 3 by default field, ties ordered by interface",
+			opts:     globals.DebugOptions{Mode: globals.DebugModeQP, TopK: 3},
+			expected: []string{"enp68s0/2", "enp132s0/1", "enp132s0/2"},
+		},
+		{
+			name:     "top 2 by local ack timeouts",
+			opts:     globals.DebugOptions{Mode: globals.DebugModeQP, TopK: 2, SortBy: "qp_sq_req_tx_num_local_ack_timeouts"},
+			expected: []string{"enp132s0/1", "enp68s0/2"},
+		},
+		{
+			name:     "invalid sort field falls back to default",
+			opts:     globals.DebugOptions{Mode: globals.DebugModeQP, TopK: 1, SortBy: "NIC_TOTAL"},
+			expected: []string{"enp68s0/2"},
+		},
+		{
+			name:     "pod filter",
+			opts:     globals.DebugOptions{Mode: globals.DebugModeQP, Pod: "train-0"},
+			expected: []string{"enp68s0/1", "enp68s0/2"},
+		},
+		{
+			name:     "namespace filter",
+			opts:     globals.DebugOptions{Mode: globals.DebugModeQP, Namespace: "kube-system"},
+			expected: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qs := newQPSelector(tt.opts)
+			for _, s := range samples {
+				if qs.matches(s.labels) {
+					qs.add(s)
+				}
+			}
+			var selected []string
+			for _, s := range qs.selected() {
+				selected = append(selected, s.labels[LabelEthIntfName]+"/"+s.labels[LabelQPID])
+			}
+			if !reflect.DeepEqual(selected, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, selected)
+			}
+		})
+	}
+}
@@ -18,7 +18,6 @@ package nicagent
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"os/exec"
 	"sync"
@@ -46,6 +45,9 @@ func (rc *RDMAStatsClient) Init() error {
 func (rc *RDMAStatsClient) IsActive() bool {
 	rc.Lock()
 	defer rc.Unlock()
+	if rdmaSysfsAvailable(rc.na.sysfsRoot) {
+		return true
+	}
 	if _, err := exec.LookPath(RDMABinary); err == nil {
 		return true
 	}
@@ -90,23 +92,66 @@ func (rc *RDMAStatsClient) populateRdmaDeviceLabels(rdmaDevName, pcieAddr string
 	return map[string]string{}, err
 }
 
+// getRdmaPortCounters reads the rdma device counters from sysfs, the `rdma
+// statistic` command is only used when the infiniband class is not available
+func (na *NICAgentClient) getRdmaPortCounters() ([]*rdmaPortCounters, error) {
+	if rdmaSysfsAvailable(na.sysfsRoot) {
+		return readRdmaSysfsPortCounters(na.sysfsRoot)
+	}
+	cmd := "rdma statistic -j"
+	res, err := ExecWithContextTimeout(cmd, longCmdTimeout, na.cmdExec)
+	if err != nil {
+		return nil, fmt.Errorf("RDMA cmd failure err :%v", err)
+	}
+	portCounters, err := parseRdmaStatisticOutput(res)
+	if err != nil {
+		return nil, fmt.Errorf("error unmarshaling rdma statistics data: %v", err)
+	}
+	return portCounters, nil
+}
+
+// getRdmaStats returns the RDMA fields of the rdma device ports exported as
+// metrics, the counters are mapped by the vendor of each device
+func (na *NICAgentClient) getRdmaStats() ([]*nicmetrics.RDMAStats, error) {
+	portCounters, err := na.getRdmaPortCounters()
+	if err != nil {
+		return nil, err
+	}
+	rdmaStats := make([]*nicmetrics.RDMAStats, 0, len(portCounters))
+	for _, pc := range portCounters {
+		vendorID, err := na.getRdmaDevVendor(pc.ifname)
+		if err != nil {
+			logger.Log.Printf("failed to get vendor ID for %s: %v", pc.ifname, err)
+			continue
+		}
+		if !isVendorAllowed(vendorID) {
+			continue
+		}
+		stats, _, err := mapRdmaCounters(vendorID, pc)
+		if err != nil {
+			logger.Log.Printf("failed to get rdma stats for %s: %v", pc.ifname, err)
+			continue
+		}
+		rdmaStats = append(rdmaStats, stats)
+	}
+	return rdmaStats, nil
+}
+
 func (rc *RDMAStatsClient) UpdateNICStats(ctx context.Context, workloads map[string]scheduler.Workload) error {
 	if !fetchRdmaMetrics {
 		return nil
 	}
 	rc.Lock()
 	defer rc.Unlock()
-	cmd := "rdma statistic -j"
-	res, err := ExecWithContextTimeout(cmd, longCmdTimeout, rc.na.cmdExec)
+	portCounters, err := rc.na.getRdmaPortCounters()
 	if err != nil {
-		logger.Log.Printf("RDMA cmd failure err :%v", err)
+		logger.Log.Printf("failed to get rdma stats: %v", err)
 		return err
 	}
-	var rdmaStats []nicmetrics.RDMAStats
-	err = json.Unmarshal(res, &rdmaStats)
-	if err != nil {
-		logger.Log.Printf("error unmarshaling rdma statistics data: %v", err)
-		return err
+
+	derivedEnabled := rc.na.isAnyFieldEnabled(derivedRdmaFields)
+	if derivedEnabled {
+		rc.na.rates.prune(derivedMetricsWindow)
 	}
 
 	hostNetDevices, err := rc.na.getNetDevicesList(nil)
@@ -115,9 +160,9 @@ func (rc *RDMAStatsClient) UpdateNICStats(ctx context.Context, workloads map[str
 		return err
 	}
 
-	for i := range rdmaStats {
-		rdmaDevName := rdmaStats[i].IFNAME
-		vendorID, err := getVendor(rdmaDevName, rc.na.cmdExec)
+	for _, pc := range portCounters {
+		rdmaDevName := pc.ifname
+		vendorID, err := rc.na.getRdmaDevVendor(rdmaDevName)
 		if err != nil {
 			logger.Log.Printf("failed to get vendor ID for %s: %v", rdmaDevName, err)
 			continue
@@ -125,6 +170,11 @@ func (rc *RDMAStatsClient) UpdateNICStats(ctx context.Context, workloads map[str
 		if !isVendorAllowed(vendorID) {
 			continue
 		}
+		stats, unmapped, err := mapRdmaCounters(vendorID, pc)
+		if err != nil {
+			logger.Log.Printf("failed to get rdma stats for %s: %v", rdmaDevName, err)
+			continue
+		}
 
 		if err := rc.na.addRdmaDevPcieAddrIfAbsent(rdmaDevName); err != nil {
 			logger.Log.Printf("failed to get rdma stats for %s: %v", rdmaDevName, err)
@@ -137,47 +187,69 @@ func (rc *RDMAStatsClient) UpdateNICStats(ctx context.Context, workloads map[str
 			continue
 		}
 
-		rc.na.m.rdmaTxUcastPkts.With(labels).Set(float64(rdmaStats[i].RDMA_TX_UCAST_PKTS))
-		rc.na.m.rdmaTxCnpPkts.With(labels).Set(float64(rdmaStats[i].RDMA_TX_CNP_PKTS))
-		rc.na.m.rdmaRxUcastPkts.With(labels).Set(float64(rdmaStats[i].RDMA_RX_UCAST_PKTS))
-		rc.na.m.rdmaRxCnpPkts.With(labels).Set(float64(rdmaStats[i].RDMA_RX_CNP_PKTS))
-		rc.na.m.rdmaRxEcnPkts.With(labels).Set(float64(rdmaStats[i].RDMA_RX_ECN_PKTS))
-
-		rc.na.m.rdmaReqRxPktSeqErr.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_RX_PKT_SEQ_ERR))
-		rc.na.m.rdmaReqRxRnrRetryErr.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_RX_RNR_RETRY_ERR))
-		rc.na.m.rdmaReqRxRmtAccErr.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_RX_RMT_ACC_ERR))
-		rc.na.m.rdmaReqRxRmtReqErr.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_RX_RMT_REQ_ERR))
-		rc.na.m.rdmaReqRxOperErr.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_RX_OPER_ERR))
-		rc.na.m.rdmaReqRxImplNakSeqErr.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_RX_IMPL_NAK_SEQ_ERR))
-		rc.na.m.rdmaReqRxCqeErr.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_RX_CQE_ERR))
-		rc.na.m.rdmaReqRxCqeFlush.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_RX_CQE_FLUSH))
-		rc.na.m.rdmaReqRxDupResp.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_RX_DUP_RESP))
-		rc.na.m.rdmaReqRxInvalidPkts.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_RX_INVALID_PKTS))
-
-		rc.na.m.rdmaReqTxLocErr.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_TX_LOC_ERR))
-		rc.na.m.rdmaReqTxLocOperErr.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_TX_LOC_OPER_ERR))
-		rc.na.m.rdmaReqTxMemMgmtErr.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_TX_MEM_MGMT_ERR))
-		rc.na.m.rdmaReqTxRetryExcdErr.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_TX_RETRY_EXCD_ERR))
-		rc.na.m.rdmaReqTxLocSglInvErr.With(labels).Set(float64(rdmaStats[i].RDMA_REQ_TX_LOC_SGL_INV_ERR))
-
-		rc.na.m.rdmaRespRxDupRequest.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_RX_DUP_REQUEST))
-		rc.na.m.rdmaRespRxOutofBuf.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_RX_OUTOF_BUF))
-		rc.na.m.rdmaRespRxOutoufSeq.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_RX_OUTOUF_SEQ))
-		rc.na.m.rdmaRespRxCqeErr.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_RX_CQE_ERR))
-		rc.na.m.rdmaRespRxCqeFlush.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_RX_CQE_FLUSH))
-		rc.na.m.rdmaRespRxLocLenErr.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_RX_LOC_LEN_ERR))
-		rc.na.m.rdmaRespRxInvalidRequest.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_RX_INVALID_REQUEST))
-		rc.na.m.rdmaRespRxLocOperErr.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_RX_LOC_OPER_ERR))
-		rc.na.m.rdmaRespRxOutofAtomic.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_RX_OUTOF_ATOMIC))
-
-		rc.na.m.rdmaRespTxPktSeqErr.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_TX_PKT_SEQ_ERR))
-		rc.na.m.rdmaRespTxRmtInvalReqErr.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_TX_RMT_INVAL_REQ_ERR))
-		rc.na.m.rdmaRespTxRmtAccErr.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_TX_RMT_ACC_ERR))
-		rc.na.m.rdmaRespTxRmtOperErr.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_TX_RMT_OPER_ERR))
-		rc.na.m.rdmaRespTxRnrRetryErr.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_TX_RNR_RETRY_ERR))
-		rc.na.m.rdmaRespTxLocSglInvErr.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_TX_LOC_SGL_INV_ERR))
-
-		rc.na.m.rdmaRespRxS0TableErr.With(labels).Set(float64(rdmaStats[i].RDMA_RESP_RX_S0_TABLE_ERR))
+		rc.na.m.rdmaTxUcastPkts.With(labels).Set(float64(stats.RDMA_TX_UCAST_PKTS))
+		rc.na.m.rdmaTxCnpPkts.With(labels).Set(float64(stats.RDMA_TX_CNP_PKTS))
+		rc.na.m.rdmaRxUcastPkts.With(labels).Set(float64(stats.RDMA_RX_UCAST_PKTS))
+		rc.na.m.rdmaRxCnpPkts.With(labels).Set(float64(stats.RDMA_RX_CNP_PKTS))
+		rc.na.m.rdmaRxEcnPkts.With(labels).Set(float64(stats.RDMA_RX_ECN_PKTS))
+
+		rc.na.m.rdmaReqRxPktSeqErr.With(labels).Set(float64(stats.RDMA_REQ_RX_PKT_SEQ_ERR))
+		rc.na.m.rdmaReqRxRnrRetryErr.With(labels).Set(float64(stats.RDMA_REQ_RX_RNR_RETRY_ERR))
+		rc.na.m.rdmaReqRxRmtAccErr.With(labels).Set(float64(stats.RDMA_REQ_RX_RMT_ACC_ERR))
+		rc.na.m.rdmaReqRxRmtReqErr.With(labels).Set(float64(stats.RDMA_REQ_RX_RMT_REQ_ERR))
+		rc.na.m.rdmaReqRxOperErr.With(labels).Set(float64(stats.RDMA_REQ_RX_OPER_ERR))
+		rc.na.m.rdmaReqRxImplNakSeqErr.With(labels).Set(float64(stats.RDMA_REQ_RX_IMPL_NAK_SEQ_ERR))
+		rc.na.m.rdmaReqRxCqeErr.With(labels).Set(float64(stats.RDMA_REQ_RX_CQE_ERR))
+		rc.na.m.rdmaReqRxCqeFlush.With(labels).Set(float64(stats.RDMA_REQ_RX_CQE_FLUSH))
+		rc.na.m.rdmaReqRxDupResp.With(labels).Set(float64(stats.RDMA_REQ_RX_DUP_RESP))
+		rc.na.m.rdmaReqRxInvalidPkts.With(labels).Set(float64(stats.RDMA_REQ_RX_INVALID_PKTS))
+
+		rc.na.m.rdmaReqTxLocErr.With(labels).Set(float64(stats.RDMA_REQ_TX_LOC_ERR))
+		rc.na.m.rdmaReqTxLocOperErr.With(labels).Set(float64(stats.RDMA_REQ_TX_LOC_OPER_ERR))
+		rc.na.m.rdmaReqTxMemMgmtErr.With(labels).Set(float64(stats.RDMA_REQ_TX_MEM_MGMT_ERR))
+		rc.na.m.rdmaReqTxRetryExcdErr.With(labels).Set(float64(stats.RDMA_REQ_TX_RETRY_EXCD_ERR))
+		rc.na.m.rdmaReqTxLocSglInvErr.With(labels).Set(float64(stats.RDMA_REQ_TX_LOC_SGL_INV_ERR))
+
+		rc.na.m.rdmaRespRxDupRequest.With(labels).Set(float64(stats.RDMA_RESP_RX_DUP_REQUEST))
+		rc.na.m.rdmaRespRxOutofBuf.With(labels).Set(float64(stats.RDMA_RESP_RX_OUTOF_BUF))
+		rc.na.m.rdmaRespRxOutoufSeq.With(labels).Set(float64(stats.RDMA_RESP_RX_OUTOUF_SEQ))
+		rc.na.m.rdmaRespRxCqeErr.With(labels).Set(float64(stats.RDMA_RESP_RX_CQE_ERR))
+		rc.na.m.rdmaRespRxCqeFlush.With(labels).Set(float64(stats.RDMA_RESP_RX_CQE_FLUSH))
+		rc.na.m.rdmaRespRxLocLenErr.With(labels).Set(float64(stats.RDMA_RESP_RX_LOC_LEN_ERR))
+		rc.na.m.rdmaRespRxInvalidRequest.With(labels).Set(float64(stats.RDMA_RESP_RX_INVALID_REQUEST))
+		rc.na.m.rdmaRespRxLocOperErr.With(labels).Set(float64(stats.RDMA_RESP_RX_LOC_OPER_ERR))
+		rc.na.m.rdmaRespRxOutofAtomic.With(labels).Set(float64(stats.RDMA_RESP_RX_OUTOF_ATOMIC))
+
+		rc.na.m.rdmaRespTxPktSeqErr.With(labels).Set(float64(stats.RDMA_RESP_TX_PKT_SEQ_ERR))
+		rc.na.m.rdmaRespTxRmtInvalReqErr.With(labels).Set(float64(stats.RDMA_RESP_TX_RMT_INVAL_REQ_ERR))
+		rc.na.m.rdmaRespTxRmtAccErr.With(labels).Set(float64(stats.RDMA_RESP_TX_RMT_ACC_ERR))
+		rc.na.m.rdmaRespTxRmtOperErr.With(labels).Set(float64(stats.RDMA_RESP_TX_RMT_OPER_ERR))
+		rc.na.m.rdmaRespTxRnrRetryErr.With(labels).Set(float64(stats.RDMA_RESP_TX_RNR_RETRY_ERR))
+		rc.na.m.rdmaRespTxLocSglInvErr.With(labels).Set(float64(stats.RDMA_RESP_TX_LOC_SGL_INV_ERR))
+
+		rc.na.m.rdmaRespRxS0TableErr.With(labels).Set(float64(stats.RDMA_RESP_RX_S0_TABLE_ERR))
+
+		if derivedEnabled {
+			rc.na.updateDerivedRdmaMetrics(stats, labels)
+		}
+		rc.updateVendorRdmaMetrics(vendorID, unmapped, labels)
 	}
 	return nil
 }
+
+// updateVendorRdmaMetrics exports the vendor counters without an RDMA field
+// equivalent under the vendor field
+func (rc *RDMAStatsClient) updateVendorRdmaMetrics(vendorID string, unmapped map[string]uint64, labels map[string]string) {
+	vm, ok := rdmaVendorCounterMaps[vendorID]
+	if !ok || len(unmapped) == 0 {
+		return
+	}
+	for name, val := range unmapped {
+		counterLabels := make(map[string]string, len(labels)+1)
+		for k, v := range labels {
+			counterLabels[k] = v
+		}
+		counterLabels[LabelCounter] = name
+		rc.na.setField(vm.field.String(), counterLabels, float64(val), false)
+	}
+}
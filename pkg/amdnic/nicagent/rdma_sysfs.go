@@ -0,0 +1,124 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// rdma port counter directories, the driver specific hw_counters take
+// precedence over the standard ib counters on a name clash
+var rdmaCounterDirs = []string{"counters", "hw_counters"}
+
+// rdmaSysfsAvailable returns true if the infiniband class is present under
+// the sysfs root
+func rdmaSysfsAvailable(sysRoot string) bool {
+	_, err := os.Stat(filepath.Join(sysRoot, InfinibandClassPath))
+	return err == nil
+}
+
+// readRdmaSysfsDevVendor returns the pci vendor id of the rdma device
+func readRdmaSysfsDevVendor(sysRoot, rdmaDev string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(sysRoot, InfinibandClassPath, rdmaDev, "device", "vendor"))
+	if err != nil {
+		return "", err
+	}
+	return strings.ToLower(strings.TrimSpace(string(data))), nil
+}
+
+// readRdmaSysfsDevPcieAddr returns the pci slot name of the rdma device from
+// the device uevent
+func readRdmaSysfsDevPcieAddr(sysRoot, rdmaDev string) (string, error) {
+	data, err := os.ReadFile(filepath.Join(sysRoot, InfinibandClassPath, rdmaDev, "device", "uevent"))
+	if err != nil {
+		return "", err
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if addr, ok := strings.CutPrefix(strings.TrimSpace(line), "PCI_SLOT_NAME="); ok && addr != "" {
+			return addr, nil
+		}
+	}
+	return "", fmt.Errorf("pcie addr info not found for %s", rdmaDev)
+}
+
+// readRdmaSysfsCounters reads all the counter files of a port directory into
+// a counter name to value map, unreadable counters are skipped
+func readRdmaSysfsCounters(dir string, counters map[string]uint64) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			continue
+		}
+		val, err := strconv.ParseUint(strings.TrimSpace(string(data)), 10, 64)
+		if err != nil {
+			continue
+		}
+		counters[entry.Name()] = val
+	}
+}
+
+// readRdmaSysfsPortCounters returns the raw counters of every rdma device
+// port under the sysfs root
+func readRdmaSysfsPortCounters(sysRoot string) ([]*rdmaPortCounters, error) {
+	classDir := filepath.Join(sysRoot, InfinibandClassPath)
+	devs, err := os.ReadDir(classDir)
+	if err != nil {
+		return nil, err
+	}
+	res := []*rdmaPortCounters{}
+	for _, dev := range devs {
+		portsDir := filepath.Join(classDir, dev.Name(), "ports")
+		ports, err := os.ReadDir(portsDir)
+		if err != nil {
+			continue
+		}
+		for _, port := range ports {
+			portNum, err := strconv.ParseUint(port.Name(), 10, 32)
+			if err != nil {
+				continue
+			}
+			counters := map[string]uint64{}
+			for _, dir := range rdmaCounterDirs {
+				readRdmaSysfsCounters(filepath.Join(portsDir, port.Name(), dir), counters)
+			}
+			res = append(res, &rdmaPortCounters{
+				ifname:   dev.Name(),
+				port:     uint32(portNum),
+				counters: counters,
+			})
+		}
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].ifname != res[j].ifname {
+			return res[i].ifname < res[j].ifname
+		}
+		return res[i].port < res[j].port
+	})
+	return res, nil
+}
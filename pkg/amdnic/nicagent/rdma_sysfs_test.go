@@ -0,0 +1,119 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetrics"
+)
+
+// writeSysfsFixture creates the files under the sysfs root
+func writeSysfsFixture(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+	for name, content := range files {
+		p := filepath.Join(root, name)
+		if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestReadRdmaSysfsPortCounters(t *testing.T) {
+	root := t.TempDir()
+	writeSysfsFixture(t, root, map[string]string{
+		"class/infiniband/ionic_0/device/vendor":                            "0x1DD8\n",
+		"class/infiniband/ionic_0/device/uevent":                            "DRIVER=ionic\nPCI_CLASS=20000\nPCI_SLOT_NAME=0000:44:00.0\n",
+		"class/infiniband/ionic_0/ports/1/hw_counters/tx_rdma_ucast_pkts":   "1200\n",
+		"class/infiniband/ionic_0/ports/1/hw_counters/rx_rdma_cnp_pkts":     "7\n",
+		"class/infiniband/ionic_0/ports/1/hw_counters/req_rx_dup_response":  "3\n",
+		"class/infiniband/ionic_0/ports/1/hw_counters/resp_rx_s0_table_err": "1\n",
+		"class/infiniband/ionic_0/ports/1/hw_counters/unmapped_counter":     "99\n",
+		"class/infiniband/ionic_0/ports/1/hw_counters/lifespan":             "10\n",
+		"class/infiniband/ionic_0/ports/1/counters/port_xmit_data":          "4096\n",
+		"class/infiniband/ionic_0/ports/1/counters/tx_rdma_ucast_pkts":      "1\n",
+		"class/infiniband/ionic_1/device/vendor":                            "0x1dd8\n",
+		"class/infiniband/ionic_1/device/uevent":                            "DRIVER=ionic\n",
+		"class/infiniband/ionic_1/ports/1/hw_counters/rx_rdma_ecn_pkts":     "garbage\n",
+		"class/infiniband/ionic_1/ports/1/hw_counters/req_tx_loc_acc_err":   "5\n",
+	})
+
+	if !rdmaSysfsAvailable(root) {
+		t.Fatalf("expected rdma sysfs to be available")
+	}
+	if rdmaSysfsAvailable(t.TempDir()) {
+		t.Fatalf("expected rdma sysfs to be unavailable on an empty root")
+	}
+
+	portCounters, err := readRdmaSysfsPortCounters(root)
+	if err != nil {
+		t.Fatalf("readRdmaSysfsPortCounters failed: %v", err)
+	}
+	if len(portCounters) != 2 {
+		t.Fatalf("got %d rdma ports, want 2", len(portCounters))
+	}
+	// the counter files are named as the `rdma statistic` keys
+	stats := make([]*nicmetrics.RDMAStats, 0, len(portCounters))
+	for _, pc := range portCounters {
+		s, _, err := mapRdmaCounters(AMDVendorID, pc)
+		if err != nil {
+			t.Fatalf("mapRdmaCounters failed for %s: %v", pc.ifname, err)
+		}
+		stats = append(stats, s)
+	}
+
+	tests := []struct {
+		name     string
+		got      uint64
+		expected uint64
+	}{
+		{"ionic_0 port", uint64(stats[0].PORT), 1},
+		{"hw_counters precede counters", stats[0].RDMA_TX_UCAST_PKTS, 1200},
+		{"rx cnp", stats[0].RDMA_RX_CNP_PKTS, 7},
+		{"renamed dup response", stats[0].RDMA_REQ_RX_DUP_RESP, 3},
+		{"s0 table error", stats[0].RDMA_RESP_RX_S0_TABLE_ERR, 1},
+		{"invalid counter skipped", stats[1].RDMA_RX_ECN_PKTS, 0},
+		{"renamed loc error", stats[1].RDMA_REQ_TX_LOC_ERR, 5},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("got %v, want %v", tt.got, tt.expected)
+			}
+		})
+	}
+	if stats[0].IFNAME != "ionic_0" || stats[1].IFNAME != "ionic_1" {
+		t.Errorf("unexpected device order %v, %v", stats[0].IFNAME, stats[1].IFNAME)
+	}
+
+	vendor, err := readRdmaSysfsDevVendor(root, "ionic_0")
+	if err != nil || vendor != AMDVendorID {
+		t.Errorf("readRdmaSysfsDevVendor() = %v, %v; want %v", vendor, err, AMDVendorID)
+	}
+	addr, err := readRdmaSysfsDevPcieAddr(root, "ionic_0")
+	if err != nil || addr != "0000:44:00.0" {
+		t.Errorf("readRdmaSysfsDevPcieAddr() = %v, %v; want 0000:44:00.0", addr, err)
+	}
+	if _, err := readRdmaSysfsDevPcieAddr(root, "ionic_1"); err == nil {
+		t.Errorf("expected error for missing PCI_SLOT_NAME")
+	}
+}
@@ -0,0 +1,165 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+)
+
+// rdmaPortCounters holds the raw counters of an rdma device port as named by
+// the driver
+type rdmaPortCounters struct {
+	ifname   string
+	port     uint32
+	counters map[string]uint64
+}
+
+// rdmaVendorCounterMap maps the counter names of a vendor driver onto the
+// `rdma statistic` keys of the AMD counters, which are the json tags of the
+// RDMA fields. Counters without an equivalent are exported under the vendor
+// field with the counter name as label.
+type rdmaVendorCounterMap struct {
+	name     string
+	field    exportermetrics.NICMetricField
+	counters map[string]string
+}
+
+var rdmaVendorCounterMaps = map[string]*rdmaVendorCounterMap{
+	MellanoxVendorID: {
+		name:  "mlx5",
+		field: exportermetrics.NICMetricField_RDMA_VENDOR_MLX5_COUNTER,
+		counters: map[string]string{
+			"port_unicast_xmit_packets":  "tx_rdma_ucast_pkts",
+			"port_unicast_rcv_packets":   "rx_rdma_ucast_pkts",
+			"np_cnp_sent":                "tx_rdma_cnp_pkts",
+			"rp_cnp_handled":             "rx_rdma_cnp_pkts",
+			"np_ecn_marked_roce_packets": "rx_rdma_ecn_pkts",
+			"packet_seq_err":             "req_rx_pkt_seq_err",
+			"rnr_nak_retry_err":          "req_rx_rnr_retry_err",
+			"req_remote_access_errors":   "req_rx_rmt_acc_err",
+			"req_remote_invalid_request": "req_rx_rmt_req_err",
+			"implied_nak_seq_err":        "req_rx_impl_nak_seq_err",
+			"req_cqe_error":              "req_rx_cqe_err",
+			"req_cqe_flush_error":        "req_rx_cqe_flush",
+			"duplicate_request":          "resp_rx_dup_request",
+			"out_of_buffer":              "resp_rx_outof_buf",
+			"out_of_sequence":            "resp_rx_outouf_seq",
+			"resp_cqe_error":             "resp_rx_cqe_err",
+			"resp_cqe_flush_error":       "resp_rx_cqe_flush",
+			"resp_local_length_error":    "resp_rx_loc_len_err",
+			"resp_remote_access_errors":  "resp_tx_rmt_acc_err",
+			"local_ack_timeout_err":      "local_ack_timeout_err",
+		},
+	},
+	BroadcomVendorID: {
+		name:  "bnxt",
+		field: exportermetrics.NICMetricField_RDMA_VENDOR_BNXT_COUNTER,
+		counters: map[string]string{
+			"tx_pkts":                "tx_rdma_ucast_pkts",
+			"rx_pkts":                "rx_rdma_ucast_pkts",
+			"tx_cnp_pkts":            "tx_rdma_cnp_pkts",
+			"rx_cnp_pkts":            "rx_rdma_cnp_pkts",
+			"rx_ecn_marked_pkts":     "rx_rdma_ecn_pkts",
+			"seq_err_naks_rcvd":      "req_rx_pkt_seq_err",
+			"rnr_naks_rcvd":          "req_rx_rnr_retry_err",
+			"remote_access_err":      "req_rx_rmt_acc_err",
+			"remote_invalid_req_err": "req_rx_rmt_req_err",
+			"remote_op_err":          "req_rx_oper_err",
+			"local_protection_err":   "req_tx_loc_acc_err",
+			"local_qp_op_err":        "req_tx_loc_oper_err",
+			"mem_mgmt_op_err":        "req_tx_mem_mgmt_err",
+			"max_retry_exceeded":     "req_tx_retry_excd_err",
+			"dup_req":                "resp_rx_dup_request",
+			"res_oos_drop_count":     "resp_rx_outouf_seq",
+			"res_length_mismatch":    "resp_rx_loc_len_err",
+		},
+	},
+}
+
+// mapRdmaCounters converts the raw port counters of a device of the vendor
+// into the RDMA fields. For vendors with a counter map the counters without an
+// equivalent are returned by name, for all others the counters are expected
+// to carry the AMD names and the rest are dropped.
+func mapRdmaCounters(vendorID string, pc *rdmaPortCounters) (*nicmetrics.RDMAStats, map[string]uint64, error) {
+	counters := pc.counters
+	var unmapped map[string]uint64
+	if vm, ok := rdmaVendorCounterMaps[vendorID]; ok {
+		counters = make(map[string]uint64, len(pc.counters))
+		unmapped = map[string]uint64{}
+		for name, val := range pc.counters {
+			if key, ok := vm.counters[name]; ok {
+				counters[key] = val
+			} else {
+				unmapped[name] = val
+			}
+		}
+	}
+	data, err := json.Marshal(counters)
+	if err != nil {
+		return nil, nil, err
+	}
+	stats := &nicmetrics.RDMAStats{}
+	if err := json.Unmarshal(data, stats); err != nil {
+		return nil, nil, fmt.Errorf("failed to map %s port %d counters, %v", pc.ifname, pc.port, err)
+	}
+	stats.IFNAME = pc.ifname
+	stats.PORT = pc.port
+	return stats, unmapped, nil
+}
+
+// parseRdmaStatisticOutput parses the `rdma statistic -j` output into the
+// raw port counters, non numeric entries are skipped
+func parseRdmaStatisticOutput(out []byte) ([]*rdmaPortCounters, error) {
+	var entries []map[string]interface{}
+	dec := json.NewDecoder(bytes.NewReader(out))
+	dec.UseNumber()
+	if err := dec.Decode(&entries); err != nil {
+		return nil, err
+	}
+	res := make([]*rdmaPortCounters, 0, len(entries))
+	for _, entry := range entries {
+		pc := &rdmaPortCounters{counters: map[string]uint64{}}
+		for key, val := range entry {
+			switch key {
+			case "ifname":
+				pc.ifname, _ = val.(string)
+				continue
+			case "port":
+				if num, ok := val.(json.Number); ok {
+					port, _ := strconv.ParseUint(num.String(), 10, 32)
+					pc.port = uint32(port)
+				}
+				continue
+			}
+			num, ok := val.(json.Number)
+			if !ok {
+				continue
+			}
+			if v, err := strconv.ParseUint(num.String(), 10, 64); err == nil {
+				pc.counters[key] = v
+			}
+		}
+		res = append(res, pc)
+	}
+	return res, nil
+}
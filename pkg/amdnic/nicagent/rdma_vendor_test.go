@@ -0,0 +1,194 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdnic/gen/nicmetrics"
+)
+
+func readRdmaStatisticFixture(t *testing.T, name string) []*rdmaPortCounters {
+	t.Helper()
+	out, err := os.ReadFile(filepath.Join("testdata", "rdma", name))
+	if err != nil {
+		t.Fatalf("failed to read fixture %s: %v", name, err)
+	}
+	portCounters, err := parseRdmaStatisticOutput(out)
+	if err != nil {
+		t.Fatalf("failed to parse fixture %s: %v", name, err)
+	}
+	return portCounters
+}
+
+func TestRdmaVendorCounterMaps(t *testing.T) {
+	// the mapped names must be the rdma statistic keys of the RDMA fields
+	keys := map[string]bool{}
+	rt := reflect.TypeOf(nicmetrics.RDMAStats{})
+	for i := 0; i < rt.NumField(); i++ {
+		if strings.HasPrefix(rt.Field(i).Name, "RDMA_") {
+			keys[strings.Split(rt.Field(i).Tag.Get("json"), ",")[0]] = true
+		}
+	}
+	for vendorID, vm := range rdmaVendorCounterMaps {
+		if !strings.HasPrefix(vm.field.String(), "RDMA_VENDOR_") {
+			t.Errorf("%s: unexpected vendor field %v", vendorID, vm.field)
+		}
+		mapped := map[string]string{}
+		for counter, key := range vm.counters {
+			if !keys[key] {
+				t.Errorf("%s: counter %s mapped to unknown key %s", vm.name, counter, key)
+			}
+			if other, ok := mapped[key]; ok {
+				t.Errorf("%s: counters %s and %s both mapped to %s", vm.name, counter, other, key)
+			}
+			mapped[key] = counter
+		}
+	}
+}
+
+func TestMapRdmaCountersMlx5(t *testing.T) {
+	portCounters := readRdmaStatisticFixture(t, "rdma_statistic_mlx5_j.json")
+	if len(portCounters) != 2 {
+		t.Fatalf("got %d rdma ports, want 2", len(portCounters))
+	}
+	stats, unmapped, err := mapRdmaCounters(MellanoxVendorID, portCounters[0])
+	if err != nil {
+		t.Fatalf("mapRdmaCounters failed: %v", err)
+	}
+	if stats.IFNAME != "mlx5_0" || stats.PORT != 1 {
+		t.Errorf("unexpected port %s/%d", stats.IFNAME, stats.PORT)
+	}
+	tests := []struct {
+		name     string
+		got      uint64
+		expected uint64
+	}{
+		{"np_cnp_sent", stats.RDMA_TX_CNP_PKTS, 88},
+		{"rp_cnp_handled", stats.RDMA_RX_CNP_PKTS, 77},
+		{"np_ecn_marked_roce_packets", stats.RDMA_RX_ECN_PKTS, 120},
+		{"packet_seq_err", stats.RDMA_REQ_RX_PKT_SEQ_ERR, 9},
+		{"rnr_nak_retry_err", stats.RDMA_REQ_RX_RNR_RETRY_ERR, 1},
+		{"implied_nak_seq_err", stats.RDMA_REQ_RX_IMPL_NAK_SEQ_ERR, 3},
+		{"req_cqe_flush_error", stats.RDMA_REQ_RX_CQE_FLUSH, 1},
+		{"out_of_buffer", stats.RDMA_RESP_RX_OUTOF_BUF, 2},
+		{"out_of_sequence", stats.RDMA_RESP_RX_OUTOUF_SEQ, 11},
+		{"duplicate_request", stats.RDMA_RESP_RX_DUP_REQUEST, 4},
+		{"local_ack_timeout_err", stats.RDMA_LOCAL_ACK_TIMEOUT_ERR, 6},
+		{"unmapped roce_adp_retrans", unmapped["roce_adp_retrans"], 15},
+		{"unmapped rx_write_requests", unmapped["rx_write_requests"], 5310},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.got != tt.expected {
+				t.Errorf("got %v, want %v", tt.got, tt.expected)
+			}
+		})
+	}
+	if _, ok := unmapped["np_cnp_sent"]; ok {
+		t.Errorf("mapped counter np_cnp_sent must not be exported as vendor counter")
+	}
+	if len(unmapped) != 10 {
+		t.Errorf("got %d unmapped counters, want 10: %v", len(unmapped), unmapped)
+	}
+
+	// full uint64 range survives the json parsing
+	stats, _, err = mapRdmaCounters(MellanoxVendorID, portCounters[1])
+	if err != nil || stats.RDMA_TX_CNP_PKTS != ^uint64(0) {
+		t.Errorf("got %v, %v; want max uint64", stats.RDMA_TX_CNP_PKTS, err)
+	}
+}
+
+func TestMapRdmaCountersBnxt(t *testing.T) {
+	root := t.TempDir()
+	writeSysfsFixture(t, root, map[string]string{
+		"class/infiniband/bnxt_re0/device/vendor":                          "0x14e4\n",
+		"class/infiniband/bnxt_re0/ports/1/hw_counters/tx_pkts":            "5000\n",
+		"class/infiniband/bnxt_re0/ports/1/hw_counters/rx_pkts":            "4000\n",
+		"class/infiniband/bnxt_re0/ports/1/hw_counters/rx_ecn_marked_pkts": "12\n",
+		"class/infiniband/bnxt_re0/ports/1/hw_counters/max_retry_exceeded": "3\n",
+		"class/infiniband/bnxt_re0/ports/1/hw_counters/to_retransmits":     "21\n",
+		"class/infiniband/bnxt_re0/ports/1/hw_counters/active_qps":         "8\n",
+		"class/infiniband/bnxt_re0/ports/1/counters/port_rcv_errors":       "1\n",
+	})
+	portCounters, err := readRdmaSysfsPortCounters(root)
+	if err != nil || len(portCounters) != 1 {
+		t.Fatalf("readRdmaSysfsPortCounters() = %v, %v; want 1 port", portCounters, err)
+	}
+	vendorID, err := readRdmaSysfsDevVendor(root, "bnxt_re0")
+	if err != nil || vendorID != BroadcomVendorID {
+		t.Fatalf("readRdmaSysfsDevVendor() = %v, %v; want %v", vendorID, err, BroadcomVendorID)
+	}
+	stats, unmapped, err := mapRdmaCounters(vendorID, portCounters[0])
+	if err != nil {
+		t.Fatalf("mapRdmaCounters failed: %v", err)
+	}
+	if stats.RDMA_TX_UCAST_PKTS != 5000 || stats.RDMA_RX_UCAST_PKTS != 4000 ||
+		stats.RDMA_RX_ECN_PKTS != 12 || stats.RDMA_REQ_TX_RETRY_EXCD_ERR != 3 {
+		t.Errorf("unexpected mapped stats %+v", stats)
+	}
+	expected := map[string]uint64{"to_retransmits": 21, "active_qps": 8, "port_rcv_errors": 1}
+	if !reflect.DeepEqual(unmapped, expected) {
+		t.Errorf("got unmapped %v, want %v", unmapped, expected)
+	}
+}
+
+func TestMapRdmaCountersAMD(t *testing.T) {
+	portCounters := readRdmaStatisticFixture(t, "rdma_statistic_ionic_j.json")
+	if len(portCounters) != 1 {
+		t.Fatalf("got %d rdma ports, want 1", len(portCounters))
+	}
+	stats, unmapped, err := mapRdmaCounters(AMDVendorID, portCounters[0])
+	if err != nil {
+		t.Fatalf("mapRdmaCounters failed: %v", err)
+	}
+	if unmapped != nil {
+		t.Errorf("AMD counters must not be exported as vendor counters, got %v", unmapped)
+	}
+	if stats.IFNAME != "ionic_0" || stats.RDMA_TX_UCAST_PKTS != 1200 || stats.RDMA_RX_UCAST_PKTS != 900 ||
+		stats.RDMA_REQ_TX_RETRY_EXCD_ERR != 2 || stats.RDMA_LOCAL_ACK_TIMEOUT_ERR != 4 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestGetRdmaStatsVendorFilter(t *testing.T) {
+	if isNonAMDMetricsEnabled() {
+		t.Skip("non AMD NIC metrics are enabled")
+	}
+	root := t.TempDir()
+	writeSysfsFixture(t, root, map[string]string{
+		"class/infiniband/ionic_0/device/vendor":                           "0x1dd8\n",
+		"class/infiniband/ionic_0/ports/1/hw_counters/tx_rdma_ucast_pkts":  "1200\n",
+		"class/infiniband/mlx5_0/device/vendor":                            "0x15b3\n",
+		"class/infiniband/mlx5_0/ports/1/hw_counters/out_of_sequence":      "4\n",
+		"class/infiniband/unknown_0/ports/1/hw_counters/rx_write_requests": "9\n",
+	})
+	// the vendor of unknown_0 is neither in sysfs nor returned by the executer
+	na := &NICAgentClient{cmdExec: fakeCmdExec{}, sysfsRoot: root}
+
+	stats, err := na.getRdmaStats()
+	if err != nil {
+		t.Fatalf("getRdmaStats failed: %v", err)
+	}
+	if len(stats) != 1 || stats[0].IFNAME != "ionic_0" || stats[0].RDMA_TX_UCAST_PKTS != 1200 {
+		t.Errorf("got %+v, want only the ionic_0 port", stats)
+	}
+}
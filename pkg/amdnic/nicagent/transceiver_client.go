@@ -0,0 +1,452 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+)
+
+// transceiver sensors
+const (
+	sensorTemperature = "temperature"
+	sensorVoltage     = "voltage"
+	sensorTxPower     = "tx_power"
+	sensorRxPower     = "rx_power"
+	sensorBias        = "bias_current"
+)
+
+// threshold keys of a sensor
+const (
+	thresholdHighAlarm   = "high alarm"
+	thresholdHighWarning = "high warning"
+	thresholdLowAlarm    = "low alarm"
+	thresholdLowWarning  = "low warning"
+)
+
+var (
+	// lane of a per lane diagnostic, `(Channel 1)` or `(Chan 1)`
+	transceiverLaneRe = regexp.MustCompile(`\((?:channel|chan|lane)\s*(\d+)\)`)
+	// threshold of a diagnostic, `Module voltage high warning threshold`
+	transceiverThresholdRe = regexp.MustCompile(`(high|low) (alarm|warning) threshold$`)
+	// first number of a diagnostic value, `0.8123 mW / -0.90 dBm`
+	transceiverValueRe = regexp.MustCompile(`^(-?[0-9]+(?:\.[0-9]+)?)(?:\s|$)`)
+)
+
+// transceiverReading is a diagnostic value of the module, lane is empty for
+// the module level sensors
+type transceiverReading struct {
+	sensor string
+	lane   string
+	value  float64
+}
+
+// transceiverExceeded is a diagnostic value beyond its threshold
+type transceiverExceeded struct {
+	sensor   string
+	lane     string
+	severity string
+}
+
+// transceiverDOM is the decoded module EEPROM diagnostics of a port
+type transceiverDOM struct {
+	moduleType   string
+	vendor       string
+	partNumber   string
+	serialNumber string
+	readings     []transceiverReading
+	thresholds   map[string]map[string]float64 // thresholds by sensor and threshold key
+	exceeded     []transceiverExceeded
+}
+
+// transceiverSensor returns the sensor of a diagnostic key
+func transceiverSensor(key string) string {
+	switch {
+	case strings.HasPrefix(key, "module temperature"):
+		return sensorTemperature
+	case strings.HasPrefix(key, "module voltage"):
+		return sensorVoltage
+	case strings.Contains(key, "bias current"):
+		return sensorBias
+	case strings.Contains(key, "output power"), strings.HasPrefix(key, "transmit avg optical power"), strings.Contains(key, "tx power"):
+		return sensorTxPower
+	case strings.Contains(key, "rx power"), strings.HasPrefix(key, "rcvr"), strings.HasPrefix(key, "receiver"):
+		return sensorRxPower
+	}
+	return ""
+}
+
+// parseTransceiverDOM parses the `ethtool -m` output, the module type
+// specific (SFF-8472, SFF-8636, CMIS) EEPROM layout is decoded by ethtool
+func parseTransceiverDOM(out []byte) (*transceiverDOM, error) {
+	dom := &transceiverDOM{thresholds: map[string]map[string]float64{}}
+	for _, line := range strings.Split(string(out), "\n") {
+		key, val, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		rawKey := strings.TrimSpace(key)
+		key = strings.ToLower(rawKey)
+		val = strings.TrimSpace(val)
+
+		switch key {
+		case "identifier":
+			dom.moduleType = val
+			if i, j := strings.Index(val, "("), strings.LastIndex(val, ")"); i >= 0 && j > i {
+				dom.moduleType = val[i+1 : j]
+			}
+			continue
+		case "vendor name":
+			dom.vendor = val
+			continue
+		case "vendor pn":
+			dom.partNumber = val
+			continue
+		case "vendor sn":
+			dom.serialNumber = val
+			continue
+		}
+
+		sensor := transceiverSensor(key)
+		if sensor == "" {
+			continue
+		}
+		num := transceiverValueRe.FindStringSubmatch(val)
+		if num == nil {
+			// alarm and warning flags are reported as On/Off
+			continue
+		}
+		value, err := strconv.ParseFloat(num[1], 64)
+		if err != nil {
+			continue
+		}
+		if m := transceiverThresholdRe.FindStringSubmatch(key); m != nil {
+			if dom.thresholds[sensor] == nil {
+				dom.thresholds[sensor] = map[string]float64{}
+			}
+			dom.thresholds[sensor][m[1]+" "+m[2]] = value
+			continue
+		}
+		if strings.Contains(key, "alarm") || strings.Contains(key, "warning") {
+			continue
+		}
+		lane := ""
+		if m := transceiverLaneRe.FindStringSubmatch(key); m != nil {
+			lane = m[1]
+		}
+		dom.readings = append(dom.readings, transceiverReading{sensor: sensor, lane: lane, value: value})
+	}
+	if dom.moduleType == "" && len(dom.readings) == 0 {
+		return nil, fmt.Errorf("no module diagnostics found")
+	}
+	return dom, nil
+}
+
+// effectiveThresholds returns the thresholds of the sensor with the
+// configured warning thresholds taking precedence over the module ones
+func (dom *transceiverDOM) effectiveThresholds(sensor string, cfg *exportermetrics.NICTransceiverThresholds) map[string]float64 {
+	thresholds := map[string]float64{}
+	for k, v := range dom.thresholds[sensor] {
+		thresholds[k] = v
+	}
+	override := func(key string, val float64) {
+		if val != 0 {
+			thresholds[key] = val
+		}
+	}
+	switch sensor {
+	case sensorTemperature:
+		override(thresholdHighWarning, cfg.GetTemperatureHigh())
+	case sensorVoltage:
+		override(thresholdLowWarning, cfg.GetVoltageLow())
+		override(thresholdHighWarning, cfg.GetVoltageHigh())
+	case sensorTxPower:
+		override(thresholdLowWarning, cfg.GetTxPowerLow())
+	case sensorRxPower:
+		override(thresholdLowWarning, cfg.GetRxPowerLow())
+	case sensorBias:
+		override(thresholdHighWarning, cfg.GetBiasCurrentHigh())
+	}
+	return thresholds
+}
+
+// evaluateThresholds finds the readings beyond their alarm or warning
+// thresholds, an alarm takes precedence over a warning
+func (dom *transceiverDOM) evaluateThresholds(cfg *exportermetrics.NICTransceiverThresholds) {
+	dom.exceeded = nil
+	for _, r := range dom.readings {
+		thresholds := dom.effectiveThresholds(r.sensor, cfg)
+		beyond := func(key string, high bool) bool {
+			t, ok := thresholds[key]
+			if !ok {
+				return false
+			}
+			if high {
+				return r.value > t
+			}
+			return r.value < t
+		}
+		severity := ""
+		switch {
+		case beyond(thresholdHighAlarm, true), beyond(thresholdLowAlarm, false):
+			severity = "alarm"
+		case beyond(thresholdHighWarning, true), beyond(thresholdLowWarning, false):
+			severity = "warning"
+		}
+		if severity != "" {
+			dom.exceeded = append(dom.exceeded, transceiverExceeded{sensor: r.sensor, lane: r.lane, severity: severity})
+		}
+	}
+}
+
+type TransceiverClient struct {
+	sync.Mutex
+	na          *NICAgentClient
+	doms        map[string]*transceiverDOM // diagnostics by port id
+	lastRefresh time.Time
+	kick        chan struct{}
+	// settings, updated on config change
+	enabled         bool
+	refreshInterval time.Duration
+	thresholds      *exportermetrics.NICTransceiverThresholds
+}
+
+func newTransceiverClient(na *NICAgentClient) *TransceiverClient {
+	return &TransceiverClient{
+		na:              na,
+		doms:            map[string]*transceiverDOM{},
+		kick:            make(chan struct{}, 1),
+		refreshInterval: DefaultTransceiverRefreshInterval,
+	}
+}
+
+// setConfig updates the transceiver settings
+func (tc *TransceiverClient) setConfig(enabled bool, refreshInterval time.Duration,
+	thresholds *exportermetrics.NICTransceiverThresholds) {
+	tc.Lock()
+	defer tc.Unlock()
+	tc.enabled = enabled
+	tc.refreshInterval = refreshInterval
+	tc.thresholds = thresholds
+}
+
+// config returns the transceiver settings
+func (tc *TransceiverClient) config() (bool, time.Duration, *exportermetrics.NICTransceiverThresholds) {
+	tc.Lock()
+	defer tc.Unlock()
+	return tc.enabled, tc.refreshInterval, tc.thresholds
+}
+
+func (tc *TransceiverClient) Init() error {
+	go tc.refreshLoop(tc.na.ctx)
+	return nil
+}
+
+func (tc *TransceiverClient) IsActive() bool {
+	if _, err := exec.LookPath(EthtoolBinary); err == nil {
+		return true
+	}
+	return false
+}
+
+func (tc *TransceiverClient) GetClientName() string {
+	return TransceiverClientName
+}
+
+// refreshLoop reads the module diagnostics on the refresh interval, the
+// EEPROM reads are slow so they are decoupled from the scrapes
+func (tc *TransceiverClient) refreshLoop(ctx context.Context) {
+	for {
+		enabled, refreshInterval, thresholds := tc.config()
+		if enabled && (utils.IsSimEnabled() || tc.IsActive()) {
+			tc.refresh(thresholds)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-tc.kick:
+		case <-time.After(refreshInterval):
+		}
+	}
+}
+
+// refresh reads the module diagnostics of every port with a PF netdev
+func (tc *TransceiverClient) refresh(thresholds *exportermetrics.NICTransceiverThresholds) {
+	tc.na.Lock()
+	nics := make([]*NIC, 0, len(tc.na.nics))
+	for _, nic := range tc.na.nics {
+		nics = append(nics, nic)
+	}
+	tc.na.Unlock()
+
+	doms := map[string]*transceiverDOM{}
+	for _, nic := range nics {
+		for _, port := range nic.Ports {
+			netDev := nic.GetPortNetDev(port.UUID)
+			if netDev == "" {
+				continue
+			}
+			out, err := ExecWithContext(fmt.Sprintf(EthToolModuleCmd, netDev), tc.na.cmdExec)
+			if err != nil {
+				logger.Debugf("failed to read module eeprom of %s, err: %v", netDev, err)
+				continue
+			}
+			dom, err := parseTransceiverDOM(out)
+			if err != nil {
+				logger.Debugf("%s: %v", netDev, err)
+				continue
+			}
+			dom.evaluateThresholds(thresholds)
+			doms[port.UUID] = dom
+		}
+	}
+
+	tc.Lock()
+	defer tc.Unlock()
+	for portUUID, dom := range doms {
+		tc.logExceeded(portUUID, dom)
+	}
+	tc.doms = doms
+	tc.lastRefresh = time.Now()
+}
+
+// logExceeded logs the thresholds newly exceeded since the last refresh
+func (tc *TransceiverClient) logExceeded(portUUID string, dom *transceiverDOM) {
+	prev := map[transceiverExceeded]bool{}
+	if old, ok := tc.doms[portUUID]; ok {
+		for _, e := range old.exceeded {
+			prev[e] = true
+		}
+	}
+	for _, e := range dom.exceeded {
+		if !prev[e] {
+			logger.Log.Printf("transceiver %s %s (port %s) %s lane %q exceeded the %s threshold",
+				dom.vendor, dom.partNumber, portUUID, e.sensor, e.lane, e.severity)
+		}
+	}
+}
+
+// UpdateNICStats exports the cached module diagnostics
+func (tc *TransceiverClient) UpdateNICStats(ctx context.Context, workloads map[string]scheduler.Workload) error {
+	tc.Lock()
+	defer tc.Unlock()
+	if !tc.enabled {
+		return nil
+	}
+	if tc.lastRefresh.IsZero() {
+		// first collection, refresh without waiting for the interval
+		select {
+		case tc.kick <- struct{}{}:
+		default:
+		}
+	}
+
+	tc.na.Lock()
+	defer tc.na.Unlock()
+	for _, nic := range tc.na.nics {
+		labels := tc.na.populateLabelsFromNIC(nic.UUID)
+		portUUIDs := []string{}
+		for portUUID := range nic.Ports {
+			if _, ok := tc.doms[portUUID]; ok {
+				portUUIDs = append(portUUIDs, portUUID)
+			}
+		}
+		sort.Strings(portUUIDs)
+		for _, portUUID := range portUUIDs {
+			dom := tc.doms[portUUID]
+			labels[LabelPortName] = nic.GetPortName(portUUID)
+			labels[LabelPortID] = nic.GetPortIndex(portUUID)
+			labels[LabelPcieBusId] = nic.GetPortPcieAddr(portUUID)
+			tc.updateDOMMetrics(dom, labels)
+		}
+	}
+	return nil
+}
+
+func (tc *TransceiverClient) updateDOMMetrics(dom *transceiverDOM, labels map[string]string) {
+	m := tc.na.m
+	withLabels := func(extra map[string]string) map[string]string {
+		l := make(map[string]string, len(labels)+len(extra))
+		for k, v := range labels {
+			l[k] = v
+		}
+		for k, v := range extra {
+			l[k] = v
+		}
+		return l
+	}
+
+	m.nicTransceiverInfo.With(withLabels(map[string]string{
+		LabelTransceiverType:   dom.moduleType,
+		LabelTransceiverVendor: dom.vendor,
+		LabelTransceiverPN:     dom.partNumber,
+		LabelTransceiverSN:     dom.serialNumber,
+	})).Set(1)
+
+	for _, r := range dom.readings {
+		switch r.sensor {
+		case sensorTemperature:
+			m.nicTransceiverTemperature.With(labels).Set(r.value)
+		case sensorVoltage:
+			m.nicTransceiverVoltage.With(labels).Set(r.value)
+		case sensorTxPower:
+			m.nicTransceiverTxPower.With(withLabels(map[string]string{LabelLane: r.lane})).Set(r.value)
+		case sensorRxPower:
+			m.nicTransceiverRxPower.With(withLabels(map[string]string{LabelLane: r.lane})).Set(r.value)
+		case sensorBias:
+			m.nicTransceiverBiasCurrent.With(withLabels(map[string]string{LabelLane: r.lane})).Set(r.value)
+		}
+	}
+
+	for _, e := range dom.exceeded {
+		m.nicTransceiverThresholdExceeded.With(withLabels(map[string]string{
+			LabelSensor:   e.sensor,
+			LabelLane:     e.lane,
+			LabelSeverity: e.severity,
+		})).Set(1)
+	}
+}
+
+func (na *NICAgentClient) initTransceiverConfig(config *exportermetrics.NICMetricConfig) {
+	refreshInterval := DefaultTransceiverRefreshInterval
+	if interval := config.GetTransceiverConfig().GetRefreshInterval(); interval != "" {
+		d, err := time.ParseDuration(interval)
+		if err != nil || d <= 0 {
+			logger.Log.Printf("invalid transceiver refresh interval %q, using %v", interval, DefaultTransceiverRefreshInterval)
+		} else {
+			refreshInterval = d
+		}
+	}
+	for _, client := range na.nicClients {
+		if tc, ok := client.(*TransceiverClient); ok {
+			tc.setConfig(fetchTransceiverMetrics, refreshInterval, config.GetTransceiverConfig().GetWarningThresholds())
+		}
+	}
+	logger.Log.Printf("transceiver refresh interval set to %v", refreshInterval)
+}
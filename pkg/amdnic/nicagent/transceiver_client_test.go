@@ -0,0 +1,200 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package nicagent
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+)
+
+func readTransceiverFixture(t *testing.T, name string) *transceiverDOM {
+	t.Helper()
+	out, err := os.ReadFile(filepath.Join("testdata", "transceiver", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	dom, err := parseTransceiverDOM(out)
+	if err != nil {
+		t.Fatalf("parse %s: %v", name, err)
+	}
+	return dom
+}
+
+func TestParseTransceiverDOM(t *testing.T) {
+	tests := []struct {
+		fixture      string
+		moduleType   string
+		vendor       string
+		partNumber   string
+		serialNumber string
+		expected     map[transceiverReading]bool // subset of the expected readings
+		numReadings  int
+	}{
+		{
+			fixture:      "ethtool_m_qsfp28.txt",
+			moduleType:   "QSFP28",
+			vendor:       "FINISAR CORP",
+			partNumber:   "FTLC9558REPM",
+			serialNumber: "X8BA1PN",
+			expected: map[transceiverReading]bool{
+				{sensor: sensorTemperature, value: 36.45}:         true,
+				{sensor: sensorVoltage, value: 3.2871}:            true,
+				{sensor: sensorBias, lane: "2", value: 6.812}:     true,
+				{sensor: sensorTxPower, lane: "1", value: 0.8123}: true,
+				{sensor: sensorRxPower, lane: "3", value: 0.0412}: true,
+			},
+			numReadings: 14,
+		},
+		{
+			fixture:      "ethtool_m_cmis.txt",
+			moduleType:   "QSFP+ or later with CMIS",
+			vendor:       "AMD",
+			partNumber:   "QSFP112-400G-SR4",
+			serialNumber: "AMD24410QA7",
+			expected: map[transceiverReading]bool{
+				{sensor: sensorTemperature, value: 72.10}:         true,
+				{sensor: sensorVoltage, value: 3.3012}:            true,
+				{sensor: sensorBias, lane: "4", value: 7.244}:     true,
+				{sensor: sensorTxPower, lane: "3", value: 1.0987}: true,
+				{sensor: sensorRxPower, lane: "1", value: 0.9820}: true,
+			},
+			numReadings: 14,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			dom := readTransceiverFixture(t, tt.fixture)
+			if dom.moduleType != tt.moduleType || dom.vendor != tt.vendor ||
+				dom.partNumber != tt.partNumber || dom.serialNumber != tt.serialNumber {
+				t.Errorf("unexpected module info %q %q %q %q", dom.moduleType, dom.vendor, dom.partNumber, dom.serialNumber)
+			}
+			if len(dom.readings) != tt.numReadings {
+				t.Errorf("expected %d readings, got %d: %+v", tt.numReadings, len(dom.readings), dom.readings)
+			}
+			found := map[transceiverReading]bool{}
+			for _, r := range dom.readings {
+				if tt.expected[r] {
+					found[r] = true
+				}
+			}
+			if !reflect.DeepEqual(found, tt.expected) {
+				t.Errorf("expected readings %+v, found %+v", tt.expected, found)
+			}
+		})
+	}
+
+	if _, err := parseTransceiverDOM([]byte("netlink error: No such device\n")); err == nil {
+		t.Errorf("expected an error for output without diagnostics")
+	}
+}
+
+func TestTransceiverThresholds(t *testing.T) {
+	tests := []struct {
+		name       string
+		fixture    string
+		thresholds *exportermetrics.NICTransceiverThresholds
+		expected   []transceiverExceeded
+	}{
+		{
+			name:     "rx power below module low warning",
+			fixture:  "ethtool_m_qsfp28.txt",
+			expected: []transceiverExceeded{{sensor: sensorRxPower, lane: "3", severity: "warning"}},
+		},
+		{
+			name:       "configured rx power warning overrides module threshold",
+			fixture:    "ethtool_m_qsfp28.txt",
+			thresholds: &exportermetrics.NICTransceiverThresholds{RxPowerLow: 0.74},
+			expected: []transceiverExceeded{
+				{sensor: sensorRxPower, lane: "2", severity: "warning"},
+				{sensor: sensorRxPower, lane: "3", severity: "warning"},
+			},
+		},
+		{
+			name:     "within module thresholds",
+			fixture:  "ethtool_m_cmis.txt",
+			expected: nil,
+		},
+		{
+			name:       "temperature above configured warning",
+			fixture:    "ethtool_m_cmis.txt",
+			thresholds: &exportermetrics.NICTransceiverThresholds{TemperatureHigh: 70},
+			expected:   []transceiverExceeded{{sensor: sensorTemperature, severity: "warning"}},
+		},
+		{
+			name:       "bias current above configured warning",
+			fixture:    "ethtool_m_cmis.txt",
+			thresholds: &exportermetrics.NICTransceiverThresholds{BiasCurrentHigh: 7.25},
+			expected:   []transceiverExceeded{{sensor: sensorBias, lane: "3", severity: "warning"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dom := readTransceiverFixture(t, tt.fixture)
+			dom.evaluateThresholds(tt.thresholds)
+			if !reflect.DeepEqual(dom.exceeded, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, dom.exceeded)
+			}
+		})
+	}
+}
+
+func TestTransceiverConfig(t *testing.T) {
+	saved := fetchTransceiverMetrics
+	defer func() { fetchTransceiverMetrics = saved }()
+
+	na := &NICAgentClient{cmdExec: fakeCmdExec{}}
+	tc := newTransceiverClient(na)
+	na.nicClients = []NICInterface{tc}
+
+	// the refresh loop reads the settings while the config is updated
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go tc.refreshLoop(ctx)
+
+	thresholds := &exportermetrics.NICTransceiverThresholds{TemperatureHigh: 70}
+	fetchTransceiverMetrics = true
+	na.initTransceiverConfig(&exportermetrics.NICMetricConfig{
+		TransceiverConfig: &exportermetrics.NICTransceiverConfig{
+			RefreshInterval:   "30s",
+			WarningThresholds: thresholds,
+		},
+	})
+	enabled, interval, got := tc.config()
+	if !enabled || interval != 30*time.Second || got != thresholds {
+		t.Errorf("got %v %v %v, want enabled, 30s and the configured thresholds", enabled, interval, got)
+	}
+
+	fetchTransceiverMetrics = false
+	na.initTransceiverConfig(&exportermetrics.NICMetricConfig{
+		TransceiverConfig: &exportermetrics.NICTransceiverConfig{RefreshInterval: "-1s"},
+	})
+	enabled, interval, got = tc.config()
+	if enabled || interval != DefaultTransceiverRefreshInterval || got != nil {
+		t.Errorf("got %v %v %v, want disabled with the default interval", enabled, interval, got)
+	}
+	if err := tc.UpdateNICStats(ctx, nil); err != nil {
+		t.Errorf("update failed: %v", err)
+	}
+}
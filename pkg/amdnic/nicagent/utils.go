@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"time"
@@ -88,6 +89,23 @@ func getVendor(rdmaDev string, cmdExec cmdexec.CommandExecuter) (string, error)
 	return strings.ToLower(strings.TrimSpace(string(data))), nil
 }
 
+// readNetDevPcieAddr returns the pcie bus id of the network device from the
+// sysfs device link, empty if the device is not in the exporter namespace
+func readNetDevPcieAddr(sysRoot, netDev string) string {
+	if netDev == "" {
+		return ""
+	}
+	target, err := os.Readlink(filepath.Join(sysRoot, "class/net", netDev, "device"))
+	if err != nil {
+		return ""
+	}
+	addr := filepath.Base(target)
+	if strings.Count(addr, ":") != 2 {
+		return ""
+	}
+	return addr
+}
+
 // appendLabelsWithoutDuplicates appends newLabels to existingLabels, skipping any that already exist
 func appendLabelsWithoutDuplicates(existingLabels []string, newLabels []string) []string {
 	labelSet := make(map[string]bool)
@@ -36,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/informers"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/metadata"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/clientcmd"
@@ -66,12 +67,19 @@ type K8sClient struct {
 	started              bool
 	nodeInformer         cache.SharedIndexInformer
 	podInformer          cache.SharedIndexInformer
+	namespaceInformer    cache.SharedIndexInformer
+	metadataClient       metadata.Interface
+	ownerMu              sync.Mutex
+	ownerKinds           map[string]*ownerKind  // apiVersion/kind -> owner resource
+	ownerCache           map[string]*ownerEntry // apiVersion/kind/namespace/name -> owner references
 	nodelabellerCfg      utils.NodeHealthLabellerConfig
 	podName              string
 	podNamespace         string
 	eventSourceComponent string
 	// disabled after the first RBAC Forbidden on event creation.
 	eventsForbidden bool
+	// called when pods on the node are added, removed or change state
+	podEventHandlers []func()
 }
 
 var errWatchForbidden = errors.New("watch forbidden by RBAC")
@@ -106,15 +114,22 @@ func NewClient(ctx context.Context, configPath, nodeName string) (*K8sClient, er
 		logger.Log.Printf("clientset from config failed %v", err)
 		return nil, err
 	}
+	// owners of the pods are only looked up by their metadata
+	metadataClient, err := metadata.NewForConfig(config)
+	if err != nil {
+		logger.Log.Printf("metadata client from config failed %v", err)
+		return nil, err
+	}
 
 	k8c := &K8sClient{
-		ctx:          ctx,
-		clientset:    clientset,
-		nodeName:     nodeName,
-		stopCh:       make(chan struct{}),
-		started:      false,
-		podName:      readFileContent(podNameFile),
-		podNamespace: readFileContent(podNamespaceFile),
+		ctx:            ctx,
+		clientset:      clientset,
+		metadataClient: metadataClient,
+		nodeName:       nodeName,
+		stopCh:         make(chan struct{}),
+		started:        false,
+		podName:        readFileContent(podNameFile),
+		podNamespace:   readFileContent(podNamespaceFile),
 	}
 
 	return k8c, nil
@@ -295,6 +310,38 @@ func (k *K8sClient) UpdateHealthLabel(nodelabellerCfg *utils.NodeHealthLabellerC
 	return nil
 }
 
+// AddPodEventHandler registers a handler called on pod add, delete and
+// runtime state changes of the pods on this node, handlers must not block
+func (k *K8sClient) AddPodEventHandler(handler func()) {
+	k.Lock()
+	defer k.Unlock()
+	k.podEventHandlers = append(k.podEventHandlers, handler)
+}
+
+func (k *K8sClient) notifyPodEvent() {
+	k.Lock()
+	handlers := append([]func(){}, k.podEventHandlers...)
+	k.Unlock()
+	for _, handler := range handlers {
+		handler()
+	}
+}
+
+// podRuntimeChanged returns true when the pod phase or its containers changed,
+// device allocations follow the container lifecycle
+func podRuntimeChanged(oldPod, newPod *v1.Pod) bool {
+	if oldPod.Status.Phase != newPod.Status.Phase ||
+		len(oldPod.Status.ContainerStatuses) != len(newPod.Status.ContainerStatuses) {
+		return true
+	}
+	for i := range newPod.Status.ContainerStatuses {
+		if oldPod.Status.ContainerStatuses[i].ContainerID != newPod.Status.ContainerStatuses[i].ContainerID {
+			return true
+		}
+	}
+	return false
+}
+
 // Watch starts the label watchers with reconnection support
 func (k *K8sClient) Watch() error {
 	k.Lock()
@@ -388,6 +435,7 @@ func (k *K8sClient) startWatchers() error {
 				logger.Log.Printf("pod[%v-%v] added with labels: %+v",
 					pod.Name, pod.Namespace, pod.Labels)
 			}
+			k.notifyPodEvent()
 		},
 		UpdateFunc: func(oldObj, newObj interface{}) {
 			oldPod := oldObj.(*v1.Pod)
@@ -396,11 +444,15 @@ func (k *K8sClient) startWatchers() error {
 				logger.Log.Printf("pod[%v-%v] updated with labels: %+v",
 					newPod.Name, newPod.Namespace, newPod.Labels)
 			}
+			if podRuntimeChanged(oldPod, newPod) {
+				k.notifyPodEvent()
+			}
 		},
 		DeleteFunc: func(obj interface{}) {
 			if pod, ok := obj.(*v1.Pod); ok {
 				logger.Log.Printf("pod[%v-%v] deleted", pod.Name, pod.Namespace)
 			}
+			k.notifyPodEvent()
 		},
 	})
 
@@ -408,6 +460,10 @@ func (k *K8sClient) startWatchers() error {
 	stopCh := make(chan struct{})
 	defer close(stopCh)
 
+	// namespace labels are optional, the pod and node watchers keep running
+	// when namespaces can't be watched
+	k.startNamespaceWatcher(stopCh)
+
 	go k.nodeInformer.Run(stopCh)
 	go k.podInformer.Run(stopCh)
 
@@ -435,6 +491,55 @@ func (k *K8sClient) startWatchers() error {
 	}
 }
 
+// startNamespaceWatcher caches the namespaces for the namespace labels, the
+// watcher stops on its own if namespaces are forbidden by RBAC
+func (k *K8sClient) startNamespaceWatcher(stopCh <-chan struct{}) {
+	nsFactory := informers.NewSharedInformerFactory(k.clientset, 0)
+	nsInformer := nsFactory.Core().V1().Namespaces().Informer()
+	nsStopCh := make(chan struct{})
+	var once sync.Once
+	// nolint
+	_ = nsInformer.SetWatchErrorHandler(func(_ *cache.Reflector, err error) {
+		if apierrors.IsForbidden(err) {
+			once.Do(func() {
+				logger.Log.Printf("namespace watch forbidden by RBAC; namespace labels will be unavailable. " +
+					"Grant the exporter ServiceAccount 'list/watch' on namespaces to enable them.")
+				close(nsStopCh)
+			})
+		}
+	})
+	k.Lock()
+	k.namespaceInformer = nsInformer
+	k.Unlock()
+	go func() {
+		select {
+		case <-stopCh:
+		case <-nsStopCh:
+		}
+		once.Do(func() { close(nsStopCh) })
+	}()
+	go nsInformer.Run(nsStopCh)
+}
+
+// getNamespaceLabels returns the labels of the namespace from the namespace
+// cache, nil if namespaces are not watched
+func (k *K8sClient) getNamespaceLabels(name string) map[string]string {
+	k.Lock()
+	nsInformer := k.namespaceInformer
+	k.Unlock()
+	if nsInformer == nil || !nsInformer.HasSynced() {
+		return nil
+	}
+	obj, exists, err := nsInformer.GetStore().GetByKey(name)
+	if err != nil || !exists {
+		return nil
+	}
+	if ns, ok := obj.(*v1.Namespace); ok {
+		return ns.Labels
+	}
+	return nil
+}
+
 func (k *K8sClient) Stop() {
 	close(k.stopCh)
 }
@@ -480,12 +585,16 @@ func (k *K8sClient) GetAllPods() (map[string]exporterTypes.K8sPodInfo, error) {
 			Namespace: pod.Namespace,
 		}
 		k8PodLabelsMap[podKey.String()] = exporterTypes.K8sPodInfo{
-			Name:      pod.Name,
-			Namespace: pod.Namespace,
-			UID:       string(pod.ObjectMeta.GetUID()),
-			Labels:    pod.Labels,
+			Name:            pod.Name,
+			Namespace:       pod.Namespace,
+			UID:             string(pod.ObjectMeta.GetUID()),
+			Labels:          pod.Labels,
+			Annotations:     pod.Annotations,
+			NamespaceLabels: k.getNamespaceLabels(pod.Namespace),
+			Owners:          resolvePodOwners(pod, k.lookupOwner),
 		}
 	}
+	k.pruneOwnerCache()
 	return k8PodLabelsMap, nil
 }
 
@@ -0,0 +1,236 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package k8sclient
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	exporterTypes "github.com/ROCm/device-metrics-exporter/pkg/types"
+)
+
+const (
+	// maxOwnerDepth bounds the owner chain of a pod
+	maxOwnerDepth = 8
+	// ownerCacheTTL is how long the owner references of an owner are reused
+	// before the owner is fetched again
+	ownerCacheTTL = 10 * time.Minute
+	// ownerRetryInterval is how long a failed owner lookup is not retried
+	ownerRetryInterval = time.Minute
+	// ownerGetTimeout bounds a metadata get of a pod owner
+	ownerGetTimeout = 5 * time.Second
+)
+
+// owner kinds which are never owned by a controller, not worth a lookup
+var rootOwnerKinds = map[string]bool{
+	"v1/Node": true,
+}
+
+// ownerKind is the api resource of an owner kind found through discovery
+type ownerKind struct {
+	gvr        schema.GroupVersionResource
+	namespaced bool
+	err        error
+	forbidden  bool // get forbidden by RBAC, logged once
+	expiry     time.Time
+}
+
+// ownerEntry caches the owner references of a pod owner
+type ownerEntry struct {
+	uid    types.UID
+	owners []metav1.OwnerReference
+	found  bool
+	expiry time.Time
+}
+
+// ownerLookup returns the owner references of a pod owner, ok is false when
+// the owner is not known
+type ownerLookup func(namespace string, ref metav1.OwnerReference) (owners []metav1.OwnerReference, ok bool)
+
+// resolvePodOwners returns the owner chain of the pod starting from its
+// controller. Each owner is followed to its own controller through lookup
+// (ReplicaSet to Deployment, Job to CronJob or JobSet, StatefulSet to
+// LeaderWorkerSet...) until the top level owner or an owner not known to
+// lookup.
+func resolvePodOwners(pod *v1.Pod, lookup ownerLookup) []exporterTypes.PodOwner {
+	owners := []exporterTypes.PodOwner{}
+	seen := map[string]bool{}
+	ref := metav1.GetControllerOf(pod)
+	for ref != nil && len(owners) < maxOwnerDepth {
+		key := ref.APIVersion + "/" + ref.Kind + "/" + ref.Name
+		if seen[key] {
+			break
+		}
+		seen[key] = true
+		owners = append(owners, exporterTypes.PodOwner{Kind: ref.Kind, Name: ref.Name})
+		if lookup == nil {
+			break
+		}
+		refs, ok := lookup(pod.Namespace, *ref)
+		if !ok {
+			break
+		}
+		ref = controllerRef(refs)
+	}
+	return owners
+}
+
+// controllerRef returns the controller among the owner references
+func controllerRef(refs []metav1.OwnerReference) *metav1.OwnerReference {
+	for i := range refs {
+		if refs[i].Controller != nil && *refs[i].Controller {
+			return &refs[i]
+		}
+	}
+	return nil
+}
+
+// lookupOwner returns the owner references of a pod owner. Only the owners
+// of the pods on the node are looked up, each with a metadata get whose
+// result is cached for ownerCacheTTL, so no owner kind is watched cluster wide.
+func (k *K8sClient) lookupOwner(namespace string, ref metav1.OwnerReference) ([]metav1.OwnerReference, bool) {
+	kindKey := ref.APIVersion + "/" + ref.Kind
+	if rootOwnerKinds[kindKey] || k.metadataClient == nil {
+		return nil, false
+	}
+	k.ownerMu.Lock()
+	defer k.ownerMu.Unlock()
+
+	now := time.Now()
+	kind := k.ownerKinds[kindKey]
+	if kind == nil || now.After(kind.expiry) {
+		kind = k.discoverOwnerKind(ref, now)
+		if k.ownerKinds == nil {
+			k.ownerKinds = make(map[string]*ownerKind)
+		}
+		k.ownerKinds[kindKey] = kind
+	}
+	if kind.err != nil {
+		return nil, false
+	}
+	if !kind.namespaced {
+		namespace = ""
+	}
+	key := kindKey + "/" + namespace + "/" + ref.Name
+	entry := k.ownerCache[key]
+	if entry == nil || now.After(entry.expiry) {
+		entry = k.getOwner(kind, namespace, ref, now)
+		if k.ownerCache == nil {
+			k.ownerCache = make(map[string]*ownerEntry)
+		}
+		k.ownerCache[key] = entry
+	}
+	// an owner recreated under the same name is not the owner of the pod
+	if !entry.found || (ref.UID != "" && entry.uid != ref.UID) {
+		return nil, false
+	}
+	return entry.owners, true
+}
+
+// discoverOwnerKind finds the api resource of an owner kind, failures are
+// retried after ownerRetryInterval
+func (k *K8sClient) discoverOwnerKind(ref metav1.OwnerReference, now time.Time) *ownerKind {
+	gvr, namespaced, err := k.ownerResource(ref)
+	if err != nil {
+		logger.Log.Printf("owner kind %v/%v lookup failed, %v; owners above %v will be unavailable",
+			ref.APIVersion, ref.Kind, err, ref.Kind)
+		return &ownerKind{err: err, expiry: now.Add(ownerRetryInterval)}
+	}
+	return &ownerKind{gvr: gvr, namespaced: namespaced, expiry: now.Add(ownerCacheTTL)}
+}
+
+// ownerResource finds the api resource of an owner kind
+func (k *K8sClient) ownerResource(ref metav1.OwnerReference) (schema.GroupVersionResource, bool, error) {
+	gv, err := schema.ParseGroupVersion(ref.APIVersion)
+	if err != nil {
+		return schema.GroupVersionResource{}, false, err
+	}
+	resources, err := k.clientset.Discovery().ServerResourcesForGroupVersion(ref.APIVersion)
+	if err != nil {
+		return schema.GroupVersionResource{}, false, err
+	}
+	for _, r := range resources.APIResources {
+		if r.Kind == ref.Kind && !strings.Contains(r.Name, "/") {
+			return gv.WithResource(r.Name), r.Namespaced, nil
+		}
+	}
+	return schema.GroupVersionResource{}, false, fmt.Errorf("kind %v not served by %v", ref.Kind, ref.APIVersion)
+}
+
+// getOwner fetches the metadata of a pod owner. A missing owner is cached as
+// not found like a fetched one, other failures are retried after
+// ownerRetryInterval.
+func (k *K8sClient) getOwner(kind *ownerKind, namespace string, ref metav1.OwnerReference, now time.Time) *ownerEntry {
+	ctx := k.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, ownerGetTimeout)
+	defer cancel()
+
+	var meta *metav1.PartialObjectMetadata
+	var err error
+	resource := k.metadataClient.Resource(kind.gvr)
+	if kind.namespaced {
+		meta, err = resource.Namespace(namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	} else {
+		meta, err = resource.Get(ctx, ref.Name, metav1.GetOptions{})
+	}
+	switch {
+	case err == nil:
+		return &ownerEntry{uid: meta.UID, owners: meta.OwnerReferences, found: true, expiry: now.Add(ownerCacheTTL)}
+	case apierrors.IsNotFound(err):
+		return &ownerEntry{expiry: now.Add(ownerCacheTTL)}
+	case apierrors.IsForbidden(err):
+		if !kind.forbidden {
+			kind.forbidden = true
+			logger.Log.Printf("%v get forbidden by RBAC; owners above %v will be unavailable. "+
+				"Grant the exporter ServiceAccount 'get' on %v to enable them.",
+				kind.gvr.Resource, ref.Kind, kind.gvr.GroupResource())
+		}
+	default:
+		logger.Log.Printf("owner %v %v/%v get failed, %v", ref.Kind, namespace, ref.Name, err)
+	}
+	return &ownerEntry{expiry: now.Add(ownerRetryInterval)}
+}
+
+// pruneOwnerCache drops the expired owners, the owners of the pods which left
+// the node are not fetched again so they are dropped once expired
+func (k *K8sClient) pruneOwnerCache() {
+	k.ownerMu.Lock()
+	defer k.ownerMu.Unlock()
+	now := time.Now()
+	for key, entry := range k.ownerCache {
+		if now.After(entry.expiry) {
+			delete(k.ownerCache, key)
+		}
+	}
+	for key, kind := range k.ownerKinds {
+		if now.After(kind.expiry) {
+			delete(k.ownerKinds, key)
+		}
+	}
+}
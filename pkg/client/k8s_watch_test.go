@@ -19,6 +19,7 @@ package k8sclient
 import (
 	"context"
 	"errors"
+	"reflect"
 	"testing"
 	"time"
 
@@ -26,9 +27,14 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/kubernetes"
 	corev1 "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/metadata"
+
+	exporterTypes "github.com/ROCm/device-metrics-exporter/pkg/types"
 )
 
 // node/pod stubs whose List/Watch always fail with the configured error.
@@ -56,14 +62,28 @@ func (s *watchStubPods) Watch(context.Context, metav1.ListOptions) (watch.Interf
 	return nil, s.err
 }
 
+type watchStubNamespaces struct {
+	corev1.NamespaceInterface
+	err error
+}
+
+func (s *watchStubNamespaces) List(context.Context, metav1.ListOptions) (*v1.NamespaceList, error) {
+	return nil, s.err
+}
+func (s *watchStubNamespaces) Watch(context.Context, metav1.ListOptions) (watch.Interface, error) {
+	return nil, s.err
+}
+
 type watchStubCoreV1 struct {
 	corev1.CoreV1Interface
-	nodes corev1.NodeInterface
-	pods  corev1.PodInterface
+	nodes      corev1.NodeInterface
+	pods       corev1.PodInterface
+	namespaces corev1.NamespaceInterface
 }
 
-func (s *watchStubCoreV1) Nodes() corev1.NodeInterface     { return s.nodes }
-func (s *watchStubCoreV1) Pods(string) corev1.PodInterface { return s.pods }
+func (s *watchStubCoreV1) Nodes() corev1.NodeInterface           { return s.nodes }
+func (s *watchStubCoreV1) Pods(string) corev1.PodInterface       { return s.pods }
+func (s *watchStubCoreV1) Namespaces() corev1.NamespaceInterface { return s.namespaces }
 
 type watchStubClientset struct {
 	kubernetes.Interface
@@ -81,8 +101,9 @@ func TestStartWatchers_DisablesOnForbidden(t *testing.T) {
 		nodeName: "node1",
 		stopCh:   make(chan struct{}),
 		clientset: &watchStubClientset{core: &watchStubCoreV1{
-			nodes: &watchStubNodes{err: forbidden},
-			pods:  &watchStubPods{err: forbidden},
+			nodes:      &watchStubNodes{err: forbidden},
+			pods:       &watchStubPods{err: forbidden},
+			namespaces: &watchStubNamespaces{err: forbidden},
 		}},
 	}
 
@@ -98,3 +119,294 @@ func TestStartWatchers_DisablesOnForbidden(t *testing.T) {
 		t.Fatal("startWatchers did not detect RBAC Forbidden on list/watch")
 	}
 }
+
+func TestPodRuntimeChanged(t *testing.T) {
+	running := v1.PodStatus{
+		Phase:             v1.PodRunning,
+		ContainerStatuses: []v1.ContainerStatus{{Name: "c", ContainerID: "containerd://a1"}},
+	}
+	restarted := v1.PodStatus{
+		Phase:             v1.PodRunning,
+		ContainerStatuses: []v1.ContainerStatus{{Name: "c", ContainerID: "containerd://b2"}},
+	}
+	tests := []struct {
+		name     string
+		old, new v1.PodStatus
+		expected bool
+	}{
+		{"pending to running", v1.PodStatus{Phase: v1.PodPending}, running, true},
+		{"container restarted", running, restarted, true},
+		{"no runtime change", running, running, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			oldPod := &v1.Pod{Status: tt.old}
+			newPod := &v1.Pod{Status: tt.new}
+			if got := podRuntimeChanged(oldPod, newPod); got != tt.expected {
+				t.Errorf("podRuntimeChanged() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestResolvePodOwners(t *testing.T) {
+	controller := true
+	ref := func(kind, name string) metav1.OwnerReference {
+		return metav1.OwnerReference{Kind: kind, Name: name, Controller: &controller}
+	}
+	owned := func(kind, name string, labels map[string]string) *v1.Pod {
+		return &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+			Namespace:       "ml",
+			Labels:          labels,
+			OwnerReferences: []metav1.OwnerReference{ref(kind, name)},
+		}}
+	}
+	// owner references of the owners in the cache, by kind/name
+	cached := map[string][]metav1.OwnerReference{
+		"ReplicaSet/trainer-5d8f7c9b4": {ref("Deployment", "trainer")},
+		"Deployment/trainer":           nil,
+		"ReplicaSet/cache-5d8f7c9b4":   nil,
+		"Job/backup-29012460":          {ref("CronJob", "backup")},
+		"CronJob/backup":               nil,
+		"Job/ft-workers-0":             {ref("JobSet", "ft")},
+		"JobSet/ft":                    nil,
+		"StatefulSet/vllm-0":           {ref("LeaderWorkerSet", "vllm")},
+		"LeaderWorkerSet/vllm":         nil,
+		"ReplicaSet/loop-a":            {ref("ReplicaSet", "loop-b")},
+		"ReplicaSet/loop-b":            {ref("ReplicaSet", "loop-a")},
+	}
+	lookup := func(namespace string, owner metav1.OwnerReference) ([]metav1.OwnerReference, bool) {
+		if namespace != "ml" {
+			return nil, false
+		}
+		refs, ok := cached[owner.Kind+"/"+owner.Name]
+		return refs, ok
+	}
+	tests := []struct {
+		name     string
+		pod      *v1.Pod
+		lookup   ownerLookup
+		expected []exporterTypes.PodOwner
+	}{
+		{
+			name:     "bare pod",
+			pod:      &v1.Pod{},
+			lookup:   lookup,
+			expected: []exporterTypes.PodOwner{},
+		},
+		{
+			name:   "deployment",
+			pod:    owned("ReplicaSet", "trainer-5d8f7c9b4", map[string]string{"pod-template-hash": "5d8f7c9b4"}),
+			lookup: lookup,
+			expected: []exporterTypes.PodOwner{
+				{Kind: "ReplicaSet", Name: "trainer-5d8f7c9b4"},
+				{Kind: "Deployment", Name: "trainer"},
+			},
+		},
+		{
+			name:     "bare replicaset with a template hash",
+			pod:      owned("ReplicaSet", "cache-5d8f7c9b4", map[string]string{"pod-template-hash": "5d8f7c9b4"}),
+			lookup:   lookup,
+			expected: []exporterTypes.PodOwner{{Kind: "ReplicaSet", Name: "cache-5d8f7c9b4"}},
+		},
+		{
+			name:   "cronjob",
+			pod:    owned("Job", "backup-29012460", nil),
+			lookup: lookup,
+			expected: []exporterTypes.PodOwner{
+				{Kind: "Job", Name: "backup-29012460"},
+				{Kind: "CronJob", Name: "backup"},
+			},
+		},
+		{
+			name:   "jobset",
+			pod:    owned("Job", "ft-workers-0", nil),
+			lookup: lookup,
+			expected: []exporterTypes.PodOwner{
+				{Kind: "Job", Name: "ft-workers-0"},
+				{Kind: "JobSet", Name: "ft"},
+			},
+		},
+		{
+			name:   "leaderworkerset",
+			pod:    owned("StatefulSet", "vllm-0", nil),
+			lookup: lookup,
+			expected: []exporterTypes.PodOwner{
+				{Kind: "StatefulSet", Name: "vllm-0"},
+				{Kind: "LeaderWorkerSet", Name: "vllm"},
+			},
+		},
+		{
+			name:     "owner not cached",
+			pod:      owned("PyTorchJob", "llama-ft", nil),
+			lookup:   lookup,
+			expected: []exporterTypes.PodOwner{{Kind: "PyTorchJob", Name: "llama-ft"}},
+		},
+		{
+			name:     "no owner cache",
+			pod:      owned("ReplicaSet", "trainer-5d8f7c9b4", map[string]string{"pod-template-hash": "5d8f7c9b4"}),
+			expected: []exporterTypes.PodOwner{{Kind: "ReplicaSet", Name: "trainer-5d8f7c9b4"}},
+		},
+		{
+			name:   "owner cycle",
+			pod:    owned("ReplicaSet", "loop-a", nil),
+			lookup: lookup,
+			expected: []exporterTypes.PodOwner{
+				{Kind: "ReplicaSet", Name: "loop-a"},
+				{Kind: "ReplicaSet", Name: "loop-b"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := resolvePodOwners(tt.pod, tt.lookup); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("resolvePodOwners() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+// discovery stub serving the resources of the group versions
+type ownerStubDiscovery struct {
+	discovery.DiscoveryInterface
+	resources map[string][]metav1.APIResource
+	calls     int
+}
+
+func (s *ownerStubDiscovery) ServerResourcesForGroupVersion(gv string) (*metav1.APIResourceList, error) {
+	s.calls++
+	r, ok := s.resources[gv]
+	if !ok {
+		return nil, apierrors.NewNotFound(schema.GroupResource{}, gv)
+	}
+	return &metav1.APIResourceList{GroupVersion: gv, APIResources: r}, nil
+}
+
+type ownerStubClientset struct {
+	kubernetes.Interface
+	discovery *ownerStubDiscovery
+}
+
+func (s *ownerStubClientset) Discovery() discovery.DiscoveryInterface { return s.discovery }
+
+// metadata stub returning the objects by resource/namespace/name
+type ownerStubMetadata struct {
+	objs map[string]*metav1.PartialObjectMetadata
+	errs map[string]error
+	gets int
+}
+
+func (s *ownerStubMetadata) Resource(gvr schema.GroupVersionResource) metadata.Getter {
+	return &ownerStubResource{m: s, resource: gvr.Resource}
+}
+
+type ownerStubResource struct {
+	metadata.Getter
+	m         *ownerStubMetadata
+	resource  string
+	namespace string
+}
+
+func (s *ownerStubResource) Namespace(ns string) metadata.ResourceInterface {
+	return &ownerStubResource{m: s.m, resource: s.resource, namespace: ns}
+}
+
+func (s *ownerStubResource) Get(_ context.Context, name string, _ metav1.GetOptions, _ ...string) (*metav1.PartialObjectMetadata, error) {
+	s.m.gets++
+	key := s.resource + "/" + s.namespace + "/" + name
+	if err, ok := s.m.errs[key]; ok {
+		return nil, err
+	}
+	if obj, ok := s.m.objs[key]; ok {
+		return obj, nil
+	}
+	return nil, apierrors.NewNotFound(schema.GroupResource{Resource: s.resource}, name)
+}
+
+func TestLookupOwner(t *testing.T) {
+	controller := true
+	ref := func(apiVersion, kind, name, uid string) metav1.OwnerReference {
+		return metav1.OwnerReference{APIVersion: apiVersion, Kind: kind, Name: name, UID: types.UID(uid), Controller: &controller}
+	}
+	disc := &ownerStubDiscovery{resources: map[string][]metav1.APIResource{
+		"apps/v1": {
+			{Name: "replicasets", Kind: "ReplicaSet", Namespaced: true},
+			{Name: "replicasets/scale", Kind: "Scale", Namespaced: true},
+			{Name: "deployments", Kind: "Deployment", Namespaced: true},
+		},
+		"batch/v1": {{Name: "jobs", Kind: "Job", Namespaced: true}},
+	}}
+	md := &ownerStubMetadata{
+		objs: map[string]*metav1.PartialObjectMetadata{
+			"replicasets/ml/trainer-5d8f7c9b4": {ObjectMeta: metav1.ObjectMeta{UID: "rs-uid",
+				OwnerReferences: []metav1.OwnerReference{ref("apps/v1", "Deployment", "trainer", "deploy-uid")}}},
+			"deployments/ml/trainer": {ObjectMeta: metav1.ObjectMeta{UID: "deploy-uid"}},
+		},
+		errs: map[string]error{
+			"jobs/ml/backup": apierrors.NewForbidden(schema.GroupResource{Group: "batch", Resource: "jobs"}, "backup", nil),
+		},
+	}
+	k := &K8sClient{
+		ctx:            context.Background(),
+		clientset:      &ownerStubClientset{discovery: disc},
+		metadataClient: md,
+	}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{
+		Namespace:       "ml",
+		OwnerReferences: []metav1.OwnerReference{ref("apps/v1", "ReplicaSet", "trainer-5d8f7c9b4", "rs-uid")},
+	}}
+	expected := []exporterTypes.PodOwner{
+		{Kind: "ReplicaSet", Name: "trainer-5d8f7c9b4"},
+		{Kind: "Deployment", Name: "trainer"},
+	}
+
+	// the owners and their kinds are fetched once and then served from the cache
+	for i := 0; i < 3; i++ {
+		if got := resolvePodOwners(pod, k.lookupOwner); !reflect.DeepEqual(got, expected) {
+			t.Fatalf("resolvePodOwners() = %v, want %v", got, expected)
+		}
+	}
+	if md.gets != 2 || disc.calls != 2 {
+		t.Errorf("got %d gets and %d discovery calls, want 2 and 2", md.gets, disc.calls)
+	}
+
+	tests := []struct {
+		name string
+		ref  metav1.OwnerReference
+		gets int
+	}{
+		{"missing owner", ref("apps/v1", "ReplicaSet", "gone-7f9", ""), 1},
+		{"forbidden owner", ref("batch/v1", "Job", "backup", ""), 1},
+		{"recreated owner", ref("apps/v1", "ReplicaSet", "trainer-5d8f7c9b4", "old-uid"), 0},
+		{"kind not served", ref("kubeflow.org/v1", "PyTorchJob", "llama-ft", ""), 0},
+		{"root owner", ref("v1", "Node", "node1", ""), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gets := md.gets
+			for i := 0; i < 2; i++ {
+				if owners, ok := k.lookupOwner("ml", tt.ref); ok {
+					t.Fatalf("lookupOwner() = %v, want not found", owners)
+				}
+			}
+			if md.gets-gets != tt.gets {
+				t.Errorf("got %d gets, want %d", md.gets-gets, tt.gets)
+			}
+		})
+	}
+
+	// expired owners are fetched again and dropped from the cache
+	rsKey := "apps/v1/ReplicaSet/ml/trainer-5d8f7c9b4"
+	k.ownerCache[rsKey].expiry = time.Now().Add(-time.Second)
+	k.pruneOwnerCache()
+	if _, ok := k.ownerCache[rsKey]; ok {
+		t.Errorf("expired owner %v not pruned", rsKey)
+	}
+	gets := md.gets
+	if got := resolvePodOwners(pod, k.lookupOwner); !reflect.DeepEqual(got, expected) {
+		t.Fatalf("resolvePodOwners() = %v, want %v", got, expected)
+	}
+	if md.gets-gets != 1 {
+		t.Errorf("got %d gets after expiry, want 1", md.gets-gets)
+	}
+}
@@ -27,10 +27,20 @@ const (
 	ProfilerDisabled       EventReason = "ProfilerDisabled"
 	RocpctlFatalExit       EventReason = "RocpctlFatalExit"
 
+	// GPU settings changed between scrapes
+	GPUFirmwareChanged   EventReason = "GPUFirmwareChanged"
+	GPUPowerCapChanged   EventReason = "GPUPowerCapChanged"
+	GPUPerfLevelChanged  EventReason = "GPUPerfLevelChanged"
+	GPUClockRangeChanged EventReason = "GPUClockRangeChanged"
+
 	// Exporter
 	HTTPServerFailed    EventReason = "HTTPServerFailed"
 	ConfigWatcherFailed EventReason = "ConfigWatcherFailed"
 
 	// Scheduler
-	SlurmWatcherFailed EventReason = "SlurmWatcherFailed"
+	SlurmWatcherFailed    EventReason = "SlurmWatcherFailed"
+	FluxClientFailed      EventReason = "FluxClientFailed"
+	PBSWatcherFailed      EventReason = "PBSWatcherFailed"
+	GenericWatcherFailed  EventReason = "GenericWatcherFailed"
+	ContainerClientFailed EventReason = "ContainerClientFailed"
 )
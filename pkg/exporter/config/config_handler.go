@@ -187,6 +187,15 @@ func (c *ConfigHandler) GetServerPort() uint32 {
 	return c.runningConfig.GetServerPort()
 }
 
+const (
+	defaultGroupRotation = time.Minute
+	minGroupRotation     = 10 * time.Second
+
+	defaultProfilerPeriod     = 10 * time.Second
+	minProfilerPeriod         = time.Second
+	defaultProfilerMaxBackoff = 5 * time.Minute
+)
+
 func (c *ConfigHandler) GetProfilerConfig() *exportermetrics.ProfilerConfig {
 	c.Lock()
 	defer c.Unlock()
@@ -203,9 +212,29 @@ func (c *ConfigHandler) GetProfilerConfig() *exportermetrics.ProfilerConfig {
 				samplingInterval = 1000
 			}
 
+			// Validate GroupRotationInterval, the groups are profiled at most
+			// once per profiler cache refresh
+			rotation := profilerCfg.GetGroupRotationInterval()
+			if len(profilerCfg.GetCounterGroups()) != 0 {
+				d, err := time.ParseDuration(rotation)
+				if rotation == "" || err != nil {
+					if rotation != "" {
+						logger.Log.Printf("Invalid GroupRotationInterval '%s': %v. Defaulting to %v", rotation, err, defaultGroupRotation)
+					}
+					d = defaultGroupRotation
+				} else if d < minGroupRotation {
+					logger.Log.Printf("GroupRotationInterval %v is less than minimum %v. Using %v", d, minGroupRotation, minGroupRotation)
+					d = minGroupRotation
+				}
+				rotation = d.String()
+			}
+
 			return &exportermetrics.ProfilerConfig{
-				SamplingInterval: samplingInterval,
-				PtlDelay:         ptlDelay,
+				SamplingInterval:      samplingInterval,
+				PtlDelay:              ptlDelay,
+				CounterGroups:         profilerCfg.GetCounterGroups(),
+				GroupRotationInterval: rotation,
+				Scheduler:             normalizeProfilerScheduler(profilerCfg.GetScheduler()),
 			}
 		}
 	}
@@ -215,6 +244,46 @@ func (c *ConfigHandler) GetProfilerConfig() *exportermetrics.ProfilerConfig {
 	}
 }
 
+// normalizeProfilerScheduler returns the scheduler config with defaults
+// applied, nil when the scheduler is disabled
+func normalizeProfilerScheduler(sched *exportermetrics.ProfilerSchedulerConfig) *exportermetrics.ProfilerSchedulerConfig {
+	if !sched.GetEnable() {
+		return nil
+	}
+	parse := func(name, value string, def, min time.Duration) time.Duration {
+		if value == "" {
+			return def
+		}
+		d, err := time.ParseDuration(value)
+		if err != nil {
+			logger.Log.Printf("Invalid profiler scheduler %s '%s': %v. Defaulting to %v", name, value, err, def)
+			return def
+		}
+		if d < min {
+			logger.Log.Printf("profiler scheduler %s %v is less than minimum %v. Using %v", name, d, min, min)
+			return min
+		}
+		return d
+	}
+	period := parse("Period", sched.GetPeriod(), defaultProfilerPeriod, minProfilerPeriod)
+	maxBackoff := parse("MaxBackoff", sched.GetMaxBackoff(), defaultProfilerMaxBackoff, period)
+	dutyCycle := sched.GetDutyCycle()
+	if dutyCycle == 0 || dutyCycle > 100 {
+		if dutyCycle != 0 {
+			logger.Log.Printf("Invalid profiler scheduler DutyCycle %d. Must be 1-100. Defaulting to 100", dutyCycle)
+		}
+		dutyCycle = 100
+	}
+	return &exportermetrics.ProfilerSchedulerConfig{
+		Enable:           true,
+		Period:           period.String(),
+		DutyCycle:        dutyCycle,
+		SkipIdle:         sched.GetSkipIdle(),
+		OptOutAnnotation: sched.GetOptOutAnnotation(),
+		MaxBackoff:       maxBackoff.String(),
+	}
+}
+
 func (c *ConfigHandler) GetLoggerConfig() *exportermetrics.LoggingConfig {
 	c.Lock()
 	defer c.Unlock()
@@ -20,6 +20,7 @@ import (
 	"testing"
 	"time"
 
+	"google.golang.org/protobuf/proto"
 	"gotest.tools/assert"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
@@ -44,3 +45,74 @@ func TestGetGPUCperMaxAge(t *testing.T) {
 	cfg.GPUConfig.HealthThresholds.GPU_CPER_MAX_AGE = "not-a-duration"
 	assert.Equal(t, time.Duration(0), handler.GetGPUCperMaxAge())
 }
+
+func TestGetProfilerConfigGroupRotation(t *testing.T) {
+	logger.Init(true)
+	handler := NewConfigHandler("config.json", GPUAgentConfig{GrpcPort: globals.GPUAgentPort})
+
+	cfg := handler.GetConfig()
+	cfg.GPUConfig = &exportermetrics.GPUMetricConfig{
+		ProfilerConfig: &exportermetrics.ProfilerConfig{},
+	}
+	// rotation is not used without groups
+	assert.Equal(t, "", handler.GetProfilerConfig().GetGroupRotationInterval())
+
+	cfg.GPUConfig.ProfilerConfig.CounterGroups = []*exportermetrics.ProfilerCounterGroup{{Name: "cache"}}
+	tests := []struct {
+		rotation string
+		want     string
+	}{
+		{"", "1m0s"},
+		{"not-a-duration", "1m0s"},
+		{"1s", "10s"},
+		{"5m", "5m0s"},
+	}
+	for _, tc := range tests {
+		cfg.GPUConfig.ProfilerConfig.GroupRotationInterval = tc.rotation
+		pcfg := handler.GetProfilerConfig()
+		assert.Equal(t, tc.want, pcfg.GetGroupRotationInterval(), "rotation %q", tc.rotation)
+		assert.Equal(t, 1, len(pcfg.GetCounterGroups()))
+	}
+}
+
+func TestNormalizeProfilerScheduler(t *testing.T) {
+	logger.Init(true)
+	tests := []struct {
+		name  string
+		sched *exportermetrics.ProfilerSchedulerConfig
+		want  *exportermetrics.ProfilerSchedulerConfig
+	}{
+		{
+			name:  "disabled",
+			sched: &exportermetrics.ProfilerSchedulerConfig{Period: "30s"},
+			want:  nil,
+		},
+		{
+			name:  "defaults",
+			sched: &exportermetrics.ProfilerSchedulerConfig{Enable: true},
+			want:  &exportermetrics.ProfilerSchedulerConfig{Enable: true, Period: "10s", DutyCycle: 100, MaxBackoff: "5m0s"},
+		},
+		{
+			name: "invalid values",
+			sched: &exportermetrics.ProfilerSchedulerConfig{Enable: true, Period: "100ms", DutyCycle: 200,
+				MaxBackoff: "bad", SkipIdle: true, OptOutAnnotation: "amd.com/no-profiling"},
+			want: &exportermetrics.ProfilerSchedulerConfig{Enable: true, Period: "1s", DutyCycle: 100,
+				MaxBackoff: "5m0s", SkipIdle: true, OptOutAnnotation: "amd.com/no-profiling"},
+		},
+		{
+			name:  "backoff below period",
+			sched: &exportermetrics.ProfilerSchedulerConfig{Enable: true, Period: "1m", DutyCycle: 20, MaxBackoff: "30s"},
+			want:  &exportermetrics.ProfilerSchedulerConfig{Enable: true, Period: "1m0s", DutyCycle: 20, MaxBackoff: "1m0s"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := normalizeProfilerScheduler(tc.sched)
+			if tc.want == nil {
+				assert.Assert(t, got == nil)
+				return
+			}
+			assert.Assert(t, proto.Equal(got, tc.want), "got %v, want %v", got, tc.want)
+		})
+	}
+}
@@ -22,14 +22,17 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/pprof"
+	"net/url"
 	"os"
 	"path"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/fsnotify/fsnotify"
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gpuagent"
@@ -44,6 +47,7 @@ import (
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
 	metricsserver "github.com/ROCm/device-metrics-exporter/pkg/exporter/svc"
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+	"github.com/ROCm/device-metrics-exporter/pkg/topology"
 )
 
 var (
@@ -69,6 +73,11 @@ type Exporter struct {
 	disableK8sApi        bool
 	disableK8sScl        bool
 	enableSlurmScl       bool
+	enableFluxScl        bool
+	enablePBSScl         bool
+	enableGenericScl     bool
+	enableContainerScl   bool
+	containerSocket      string
 	enableSriov          bool
 	enableCRI            bool
 	exitOnAgentDown      bool
@@ -93,17 +102,11 @@ func prometheusMiddleware(next http.Handler) http.Handler {
 			defer prometheusMiddlewareMu.Unlock()
 
 			// Check for debug parameter to enable debug metrics temporarily
-			debugMode := globals.DebugMode(r.URL.Query().Get("debug"))
 			ctx := r.Context()
-			if debugMode != globals.DebugModeNone {
-				switch debugMode {
-				case globals.DebugModeQP:
-					logger.Log.Printf("Debug (%s) mode enabled via query parameter", debugMode)
-					ctx = globals.WithDebugMode(ctx, debugMode)
-				default:
-					// Continue without setting debug mode
-					logger.Log.Printf("Invalid debug mode '%s' requested. Valid values: qp", debugMode)
-				}
+			debugOpts := parseDebugOptions(r.URL.Query())
+			if debugOpts.Mode != globals.DebugModeNone {
+				logger.Log.Printf("Debug (%s) mode enabled via query parameter, options: %+v", debugOpts.Mode, debugOpts)
+				ctx = globals.WithDebugOptions(ctx, debugOpts)
 			}
 
 			_ = mh.UpdateMetrics(ctx)
@@ -114,6 +117,32 @@ func prometheusMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// parseDebugOptions reads the debug mode and its options from the query of a
+// metrics request, invalid values are logged and ignored
+func parseDebugOptions(query url.Values) globals.DebugOptions {
+	opts := globals.DebugOptions{Mode: globals.DebugMode(strings.ToLower(query.Get("debug")))}
+	if !opts.Mode.IsValid() {
+		// Continue without setting debug mode
+		logger.Log.Printf("Invalid debug mode '%s' requested. Valid values: qp, lif, all", opts.Mode)
+		return globals.DebugOptions{Mode: globals.DebugModeNone}
+	}
+	if opts.Mode == globals.DebugModeNone {
+		return opts
+	}
+	if topk := query.Get("topk"); topk != "" {
+		k, err := strconv.Atoi(topk)
+		if err != nil || k < 0 {
+			logger.Log.Printf("Invalid debug topk '%s' requested, exporting all queue pairs", topk)
+		} else {
+			opts.TopK = k
+		}
+	}
+	opts.SortBy = strings.ToUpper(query.Get("sort_by"))
+	opts.Pod = query.Get("pod")
+	opts.Namespace = query.Get("namespace")
+	return opts
+}
+
 // startMetricsServer starts the HTTP metrics server; a listener failure triggers a fatal exit.
 func startMetricsServer(c *config.ConfigHandler, bindAddr string) *http.Server {
 
@@ -128,6 +157,8 @@ func startMetricsServer(c *config.ConfigHandler, bindAddr string) *http.Server {
 	router.Methods("GET").Subrouter().HandleFunc(globals.AMDGPUHandlerPrefix, mh.HandleGPUMetricsQuery)
 	// new route for querying inband ras errors
 	router.Methods("GET").Subrouter().HandleFunc(globals.AMDGPUInbandRASHandlerPrefix, mh.HandleInbandRASErrorsQuery)
+	// GPU/NIC PCIe and NUMA topology
+	router.Methods("GET").Subrouter().HandleFunc(globals.TopologyHandlerPrefix, topology.HandleQuery)
 	// pprof
 	router.Methods("GET").Subrouter().Handle("/debug/vars", expvar.Handler())
 	router.Methods("GET").Subrouter().HandleFunc("/debug/pprof/", pprof.Index)
@@ -337,6 +368,22 @@ func (e *Exporter) GetK8sApiClient() *k8sclient.K8sClient {
 	return nil
 }
 
+// initWorkloadCache refreshes the cached pod resources on pod events and
+// exports the cache state
+func (e *Exporter) initWorkloadCache() {
+	cache, ok := e.k8sScl.(scheduler.WorkloadCache)
+	if !ok {
+		return
+	}
+	if e.k8sApiClient != nil {
+		e.k8sApiClient.AddPodEventHandler(cache.Refresh)
+	}
+	hostname, _ := utils.GetHostName()
+	if c := scheduler.NewCacheCollector(e.k8sScl, prometheus.Labels{"hostname": hostname}); c != nil {
+		mh.RegisterCollector(c)
+	}
+}
+
 func (e *Exporter) startWatchers() {
 	if e.k8sApiClient == nil {
 		logger.Log.Printf("k8sApi client is not initialized, skipping watchers")
@@ -430,6 +477,35 @@ func WithSlurmClient(enable bool) ExporterOption {
 	}
 }
 
+func WithFluxClient(enable bool) ExporterOption {
+	return func(e *Exporter) {
+		logger.Log.Printf("flux scheduler mode set to %v", enable)
+		e.enableFluxScl = enable
+	}
+}
+
+func WithPBSClient(enable bool) ExporterOption {
+	return func(e *Exporter) {
+		logger.Log.Printf("pbs scheduler mode set to %v", enable)
+		e.enablePBSScl = enable
+	}
+}
+
+func WithGenericClient(enable bool) ExporterOption {
+	return func(e *Exporter) {
+		logger.Log.Printf("generic workload mode set to %v", enable)
+		e.enableGenericScl = enable
+	}
+}
+
+func WithContainerClient(enable bool, socket string) ExporterOption {
+	return func(e *Exporter) {
+		logger.Log.Printf("container scheduler mode set to %v", enable)
+		e.enableContainerScl = enable
+		e.containerSocket = socket
+	}
+}
+
 func WithSocketConnection(socketPath string) ExporterOption {
 	return func(e *Exporter) {
 		logger.Log.Printf("socket connection enabled with path: %v", socketPath)
@@ -452,6 +528,21 @@ func WithExitOnRocpctlError(exit bool) ExporterOption {
 	}
 }
 
+// registerIFOEHealthClient registers the IFOE client of the gpu agent with
+// the IFOE health service
+func (e *Exporter) registerIFOEHealthClient() {
+	if !e.enableIFOEMonitoring || gpuclient == nil {
+		return
+	}
+	ifoeClient := gpuclient.GetIFOEClient()
+	if ifoeClient == nil {
+		return
+	}
+	if err := e.svcHandler.RegisterIFOEHealthClient(ifoeClient); err != nil {
+		logger.Log.Printf("ifoe health client registration err: %+v", err)
+	}
+}
+
 // StartMain - doesn't return it exits only on failure
 func (e *Exporter) StartMain(enableDebugAPI bool) {
 	defer e.Close()
@@ -476,6 +567,7 @@ func (e *Exporter) StartMain(enableDebugAPI bool) {
 			logger.Log.Printf("failed to create k8s scheduler client: %v", err)
 		} else {
 			e.k8sScl = k8sScl
+			e.initWorkloadCache()
 		}
 		e.startWatchers()
 	}
@@ -486,6 +578,10 @@ func (e *Exporter) StartMain(enableDebugAPI bool) {
 			gpuagent.WithSRIOV(e.enableSriov),
 			gpuagent.WithK8sSchedulerClient(e.k8sScl),
 			gpuagent.WithSlurmClient(e.enableSlurmScl),
+			gpuagent.WithFluxClient(e.enableFluxScl),
+			gpuagent.WithPBSClient(e.enablePBSScl),
+			gpuagent.WithGenericClient(e.enableGenericScl),
+			gpuagent.WithContainerClient(e.enableContainerScl, e.containerSocket),
 			gpuagent.WithGPUMonitoring(true),
 			gpuagent.WithIFOEMonitoring(e.enableIFOEMonitoring),
 			gpuagent.WithExitOnAgentDown(e.exitOnAgentDown),
@@ -503,6 +599,7 @@ func (e *Exporter) StartMain(enableDebugAPI bool) {
 		if err := e.svcHandler.RegisterGPUHealthClient(gpuclient); err != nil {
 			logger.Log.Printf("health client registration err: %+v", err)
 		}
+		e.registerIFOEHealthClient()
 	}
 
 	if !e.enableGPUMonitoring && e.enableIFOEMonitoring {
@@ -524,6 +621,7 @@ func (e *Exporter) StartMain(enableDebugAPI bool) {
 			logger.Log.Printf("gpuclient init err :%+v", err)
 		}
 		go gpuclient.StartMonitor()
+		e.registerIFOEHealthClient()
 	}
 
 	if e.enableNICMonitoring {
@@ -614,7 +712,7 @@ func (e *Exporter) Close() error {
 		e.svcHandler = nil
 	}
 
-	cleanupResources(e.enableGPUMonitoring, e.enableNICMonitoring)
+	cleanupResources(e.enableGPUMonitoring, e.enableNICMonitoring, e.enableIFOEMonitoring)
 	events.Stop()
 	return nil
 }
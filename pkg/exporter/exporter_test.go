@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"strings"
@@ -494,6 +495,32 @@ func TestExporterMiddleware(t *testing.T) {
 	assert.Equal(t, rr.statusCode, http.StatusOK, "Status should be OK")
 }
 
+func TestParseDebugOptions(t *testing.T) {
+	logger.Init(false)
+
+	tests := []struct {
+		query    string
+		expected globals.DebugOptions
+	}{
+		{query: "", expected: globals.DebugOptions{}},
+		{query: "debug=qp", expected: globals.DebugOptions{Mode: globals.DebugModeQP}},
+		{query: "debug=LIF", expected: globals.DebugOptions{Mode: globals.DebugModeLIF}},
+		{query: "debug=bogus&topk=5", expected: globals.DebugOptions{}},
+		{
+			query:    "debug=qp&topk=10&sort_by=qp_sq_req_tx_num_local_ack_timeouts&pod=train-0&namespace=ml",
+			expected: globals.DebugOptions{Mode: globals.DebugModeQP, TopK: 10, SortBy: "QP_SQ_REQ_TX_NUM_LOCAL_ACK_TIMEOUTS", Pod: "train-0", Namespace: "ml"},
+		},
+		{query: "debug=all&topk=-1", expected: globals.DebugOptions{Mode: globals.DebugModeAll}},
+		{query: "topk=10", expected: globals.DebugOptions{}},
+	}
+
+	for _, tt := range tests {
+		query, err := url.ParseQuery(tt.query)
+		assert.NilError(t, err)
+		assert.DeepEqual(t, parseDebugOptions(query), tt.expected)
+	}
+}
+
 // Helper test response writer
 type testResponseWriter struct {
 	statusCode int
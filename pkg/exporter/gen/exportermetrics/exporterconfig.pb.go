@@ -298,6 +298,24 @@ const (
 	GPUMetricField_GPU_ECC_DEFERRED_JPEG      GPUMetricField = 139
 	GPUMetricField_GPU_ECC_DEFERRED_IH        GPUMetricField = 140
 	GPUMetricField_GPU_ECC_DEFERRED_MPIO      GPUMetricField = 141
+	// per process VRAM usage from KFD sysfs
+	GPUMetricField_GPU_PROCESS_USED_VRAM GPUMetricField = 142
+	// firmware versions of the GPU components, info metric
+	GPUMetricField_GPU_FIRMWARE_INFO GPUMetricField = 143
+	// power cap per cap type, and the range it can be set to from hwmon
+	GPUMetricField_GPU_POWER_CAP     GPUMetricField = 144
+	GPUMetricField_GPU_POWER_CAP_MIN GPUMetricField = 145
+	GPUMetricField_GPU_POWER_CAP_MAX GPUMetricField = 146
+	// performance level, info metric
+	GPUMetricField_GPU_PERF_LEVEL_INFO GPUMetricField = 147
+	// configured clock frequency range per clock type
+	GPUMetricField_GPU_CLOCK_RANGE_MIN GPUMetricField = 148
+	GPUMetricField_GPU_CLOCK_RANGE_MAX GPUMetricField = 149
+	// rollups of the partitions of a physical GPU, partition_mode label
+	GPUMetricField_GPU_PARTITION_ROLLUP_USED_VRAM        GPUMetricField = 150
+	GPUMetricField_GPU_PARTITION_ROLLUP_GFX_ACTIVITY_AVG GPUMetricField = 151
+	GPUMetricField_GPU_PARTITION_ROLLUP_GFX_ACTIVITY_MAX GPUMetricField = 152
+	GPUMetricField_GPU_PARTITION_ROLLUP_PACKAGE_POWER    GPUMetricField = 153
 	// Profiler Metrics (reserving 801 to 1200)
 	GPUMetricField_GPU_PROF_GRBM_GUI_ACTIVE                    GPUMetricField = 801
 	GPUMetricField_GPU_PROF_SQ_WAVES                           GPUMetricField = 802
@@ -360,6 +378,16 @@ const (
 	GPUMetricField_GPU_PROF_OCCUPANCY_PER_CU GPUMetricField = 1012
 	// SIMD_UTILIZATION
 	GPUMetricField_GPU_PROF_SIMD_UTILIZATION GPUMetricField = 1013
+	// ops per byte of memory traffic, from TOTAL_*_OPS, FETCH_SIZE, WRITE_SIZE
+	GPUMetricField_GPU_PROF_ARITHMETIC_INTENSITY GPUMetricField = 1014
+	// TFLOPs over the sampling interval, from TOTAL_*_OPS
+	GPUMetricField_GPU_PROF_ACHIEVED_TFLOPS GPUMetricField = 1015
+	// L2 cache hit rate percent, from TCC_HIT_sum, TCC_MISS_sum
+	GPUMetricField_GPU_PROF_L2_HIT_RATE GPUMetricField = 1016
+	// age in seconds of the profiler sample being exported
+	GPUMetricField_GPU_PROF_SAMPLE_AGE GPUMetricField = 1017
+	// info metric of GPUs excluded from profiling with the reason
+	GPUMetricField_GPU_PROF_SUPPRESSED GPUMetricField = 1018
 )
 
 // Enum value maps for GPUMetricField.
@@ -507,6 +535,18 @@ var (
 		139:  "GPU_ECC_DEFERRED_JPEG",
 		140:  "GPU_ECC_DEFERRED_IH",
 		141:  "GPU_ECC_DEFERRED_MPIO",
+		142:  "GPU_PROCESS_USED_VRAM",
+		143:  "GPU_FIRMWARE_INFO",
+		144:  "GPU_POWER_CAP",
+		145:  "GPU_POWER_CAP_MIN",
+		146:  "GPU_POWER_CAP_MAX",
+		147:  "GPU_PERF_LEVEL_INFO",
+		148:  "GPU_CLOCK_RANGE_MIN",
+		149:  "GPU_CLOCK_RANGE_MAX",
+		150:  "GPU_PARTITION_ROLLUP_USED_VRAM",
+		151:  "GPU_PARTITION_ROLLUP_GFX_ACTIVITY_AVG",
+		152:  "GPU_PARTITION_ROLLUP_GFX_ACTIVITY_MAX",
+		153:  "GPU_PARTITION_ROLLUP_PACKAGE_POWER",
 		801:  "GPU_PROF_GRBM_GUI_ACTIVE",
 		802:  "GPU_PROF_SQ_WAVES",
 		803:  "GPU_PROF_GRBM_COUNT",
@@ -559,6 +599,11 @@ var (
 		1011: "GPU_PROF_OCCUPANCY_PER_ACTIVE_CU",
 		1012: "GPU_PROF_OCCUPANCY_PER_CU",
 		1013: "GPU_PROF_SIMD_UTILIZATION",
+		1014: "GPU_PROF_ARITHMETIC_INTENSITY",
+		1015: "GPU_PROF_ACHIEVED_TFLOPS",
+		1016: "GPU_PROF_L2_HIT_RATE",
+		1017: "GPU_PROF_SAMPLE_AGE",
+		1018: "GPU_PROF_SUPPRESSED",
 	}
 	GPUMetricField_value = map[string]int32{
 		"GPU_NODES_TOTAL":                                    0,
@@ -703,6 +748,18 @@ var (
 		"GPU_ECC_DEFERRED_JPEG":                       139,
 		"GPU_ECC_DEFERRED_IH":                         140,
 		"GPU_ECC_DEFERRED_MPIO":                       141,
+		"GPU_PROCESS_USED_VRAM":                       142,
+		"GPU_FIRMWARE_INFO":                           143,
+		"GPU_POWER_CAP":                               144,
+		"GPU_POWER_CAP_MIN":                           145,
+		"GPU_POWER_CAP_MAX":                           146,
+		"GPU_PERF_LEVEL_INFO":                         147,
+		"GPU_CLOCK_RANGE_MIN":                         148,
+		"GPU_CLOCK_RANGE_MAX":                         149,
+		"GPU_PARTITION_ROLLUP_USED_VRAM":              150,
+		"GPU_PARTITION_ROLLUP_GFX_ACTIVITY_AVG":       151,
+		"GPU_PARTITION_ROLLUP_GFX_ACTIVITY_MAX":       152,
+		"GPU_PARTITION_ROLLUP_PACKAGE_POWER":          153,
 		"GPU_PROF_GRBM_GUI_ACTIVE":                    801,
 		"GPU_PROF_SQ_WAVES":                           802,
 		"GPU_PROF_GRBM_COUNT":                         803,
@@ -755,6 +812,11 @@ var (
 		"GPU_PROF_OCCUPANCY_PER_ACTIVE_CU":            1011,
 		"GPU_PROF_OCCUPANCY_PER_CU":                   1012,
 		"GPU_PROF_SIMD_UTILIZATION":                   1013,
+		"GPU_PROF_ARITHMETIC_INTENSITY":               1014,
+		"GPU_PROF_ACHIEVED_TFLOPS":                    1015,
+		"GPU_PROF_L2_HIT_RATE":                        1016,
+		"GPU_PROF_SAMPLE_AGE":                         1017,
+		"GPU_PROF_SUPPRESSED":                         1018,
 	}
 )
 
@@ -806,6 +868,12 @@ const (
 	// VM_VF      - Virtual Machine with VF passthrough
 	// VM_PF      - Virtual Machine with PF passthrough
 	GPUMetricLabel_DEPLOYMENT_MODE GPUMetricLabel = 6
+	// container image and compose project of the container holding the GPU,
+	// only valid with the container runtime client (non k8s deployments)
+	GPUMetricLabel_CONTAINER_IMAGE GPUMetricLabel = 7
+	GPUMetricLabel_COMPOSE_PROJECT GPUMetricLabel = 8
+	// pcie bus id of the NIC closest to the GPU in the PCIe/NUMA topology
+	GPUMetricLabel_AFFINITY_NIC GPUMetricLabel = 9
 )
 
 // Enum value maps for GPUMetricLabel.
@@ -818,6 +886,9 @@ var (
 		4: "GPU_MEMORY_PARTITION_TYPE",
 		5: "KFD_PROCESS_ID",
 		6: "DEPLOYMENT_MODE",
+		7: "CONTAINER_IMAGE",
+		8: "COMPOSE_PROJECT",
+		9: "AFFINITY_NIC",
 	}
 	GPUMetricLabel_value = map[string]int32{
 		"GPU_UUID":                   0,
@@ -827,6 +898,9 @@ var (
 		"GPU_MEMORY_PARTITION_TYPE":  4,
 		"KFD_PROCESS_ID":             5,
 		"DEPLOYMENT_MODE":            6,
+		"CONTAINER_IMAGE":            7,
+		"COMPOSE_PROJECT":            8,
+		"AFFINITY_NIC":               9,
 	}
 )
 
@@ -974,6 +1048,9 @@ const (
 	NICMetricField_NIC_PORT_STATS_RX_PPS                   NICMetricField = 53
 	NICMetricField_NIC_PORT_STATS_RX_BPS                   NICMetricField = 54
 	NICMetricField_NIC_PORT_STATS_RSFEC_UNCORRECTABLE_WORD NICMetricField = 55
+	// derived port rates over the DerivedMetricsWindow
+	NICMetricField_NIC_PORT_STATS_RX_BYTES_RATE NICMetricField = 56
+	NICMetricField_NIC_PORT_STATS_TX_BYTES_RATE NICMetricField = 57
 	// Lif stats
 	NICMetricField_NIC_LIF_STATS_RX_UNICAST_PACKETS        NICMetricField = 100
 	NICMetricField_NIC_LIF_STATS_RX_UNICAST_DROP_PACKETS   NICMetricField = 101
@@ -1022,6 +1099,15 @@ const (
 	NICMetricField_RDMA_RESP_TX_RNR_RETRY_ERR     NICMetricField = 233
 	NICMetricField_RDMA_RESP_TX_LOC_SGL_INV_ERR   NICMetricField = 234
 	NICMetricField_RDMA_RESP_RX_S0_TABLE_ERR      NICMetricField = 235
+	// derived RDMA rates over the DerivedMetricsWindow
+	NICMetricField_RDMA_RX_CNP_RATE          NICMetricField = 236
+	NICMetricField_RDMA_TX_CNP_RATE          NICMetricField = 237
+	NICMetricField_RDMA_RX_ECN_RATE          NICMetricField = 238
+	NICMetricField_RDMA_RETRANSMISSION_RATIO NICMetricField = 239
+	NICMetricField_RDMA_RNR_NAK_RATE         NICMetricField = 240
+	// vendor specific RDMA counters without an RDMA_* equivalent
+	NICMetricField_RDMA_VENDOR_MLX5_COUNTER NICMetricField = 241
+	NICMetricField_RDMA_VENDOR_BNXT_COUNTER NICMetricField = 242
 	// QP  stats
 	NICMetricField_QP_SQ_REQ_TX_NUM_PACKET                NICMetricField = 300
 	NICMetricField_QP_SQ_REQ_TX_NUM_SEND_MSGS_WITH_RKE    NICMetricField = 301
@@ -1172,6 +1258,15 @@ const (
 	NICMetricField_ETH_FRAMES_TX_4096B_8191B NICMetricField = 576
 	NICMetricField_ETH_FRAMES_RX_PRIPAUSE    NICMetricField = 577
 	NICMetricField_ETH_FRAMES_TX_PRIPAUSE    NICMetricField = 578
+	// Transceiver module diagnostics (DOM), refreshed on the
+	// TransceiverConfig RefreshInterval
+	NICMetricField_NIC_TRANSCEIVER_TEMPERATURE        NICMetricField = 600
+	NICMetricField_NIC_TRANSCEIVER_VOLTAGE            NICMetricField = 601
+	NICMetricField_NIC_TRANSCEIVER_TX_POWER           NICMetricField = 602
+	NICMetricField_NIC_TRANSCEIVER_RX_POWER           NICMetricField = 603
+	NICMetricField_NIC_TRANSCEIVER_BIAS_CURRENT       NICMetricField = 604
+	NICMetricField_NIC_TRANSCEIVER_INFO               NICMetricField = 605
+	NICMetricField_NIC_TRANSCEIVER_THRESHOLD_EXCEEDED NICMetricField = 606
 	// Backward compatibility aliases (deprecated, use PRI_N format)
 	//
 	// Deprecated: Marked as deprecated in exporterconfig.proto.
@@ -1267,6 +1362,8 @@ var (
 		53:  "NIC_PORT_STATS_RX_PPS",
 		54:  "NIC_PORT_STATS_RX_BPS",
 		55:  "NIC_PORT_STATS_RSFEC_UNCORRECTABLE_WORD",
+		56:  "NIC_PORT_STATS_RX_BYTES_RATE",
+		57:  "NIC_PORT_STATS_TX_BYTES_RATE",
 		100: "NIC_LIF_STATS_RX_UNICAST_PACKETS",
 		101: "NIC_LIF_STATS_RX_UNICAST_DROP_PACKETS",
 		102: "NIC_LIF_STATS_RX_MULTICAST_DROP_PACKETS",
@@ -1313,6 +1410,13 @@ var (
 		233: "RDMA_RESP_TX_RNR_RETRY_ERR",
 		234: "RDMA_RESP_TX_LOC_SGL_INV_ERR",
 		235: "RDMA_RESP_RX_S0_TABLE_ERR",
+		236: "RDMA_RX_CNP_RATE",
+		237: "RDMA_TX_CNP_RATE",
+		238: "RDMA_RX_ECN_RATE",
+		239: "RDMA_RETRANSMISSION_RATIO",
+		240: "RDMA_RNR_NAK_RATE",
+		241: "RDMA_VENDOR_MLX5_COUNTER",
+		242: "RDMA_VENDOR_BNXT_COUNTER",
 		300: "QP_SQ_REQ_TX_NUM_PACKET",
 		301: "QP_SQ_REQ_TX_NUM_SEND_MSGS_WITH_RKE",
 		302: "QP_SQ_REQ_TX_NUM_LOCAL_ACK_TIMEOUTS",
@@ -1460,6 +1564,13 @@ var (
 		576: "ETH_FRAMES_TX_4096B_8191B",
 		577: "ETH_FRAMES_RX_PRIPAUSE",
 		578: "ETH_FRAMES_TX_PRIPAUSE",
+		600: "NIC_TRANSCEIVER_TEMPERATURE",
+		601: "NIC_TRANSCEIVER_VOLTAGE",
+		602: "NIC_TRANSCEIVER_TX_POWER",
+		603: "NIC_TRANSCEIVER_RX_POWER",
+		604: "NIC_TRANSCEIVER_BIAS_CURRENT",
+		605: "NIC_TRANSCEIVER_INFO",
+		606: "NIC_TRANSCEIVER_THRESHOLD_EXCEEDED",
 		// Duplicate value: 520: "ETH_FRAMES_RX_PRI0",
 		// Duplicate value: 521: "ETH_FRAMES_RX_PRI1",
 		// Duplicate value: 522: "ETH_FRAMES_RX_PRI2",
@@ -1534,6 +1645,8 @@ var (
 		"NIC_PORT_STATS_RX_PPS":                            53,
 		"NIC_PORT_STATS_RX_BPS":                            54,
 		"NIC_PORT_STATS_RSFEC_UNCORRECTABLE_WORD":          55,
+		"NIC_PORT_STATS_RX_BYTES_RATE":                     56,
+		"NIC_PORT_STATS_TX_BYTES_RATE":                     57,
 		"NIC_LIF_STATS_RX_UNICAST_PACKETS":                 100,
 		"NIC_LIF_STATS_RX_UNICAST_DROP_PACKETS":            101,
 		"NIC_LIF_STATS_RX_MULTICAST_DROP_PACKETS":          102,
@@ -1580,6 +1693,13 @@ var (
 		"RDMA_RESP_TX_RNR_RETRY_ERR":                       233,
 		"RDMA_RESP_TX_LOC_SGL_INV_ERR":                     234,
 		"RDMA_RESP_RX_S0_TABLE_ERR":                        235,
+		"RDMA_RX_CNP_RATE":                                 236,
+		"RDMA_TX_CNP_RATE":                                 237,
+		"RDMA_RX_ECN_RATE":                                 238,
+		"RDMA_RETRANSMISSION_RATIO":                        239,
+		"RDMA_RNR_NAK_RATE":                                240,
+		"RDMA_VENDOR_MLX5_COUNTER":                         241,
+		"RDMA_VENDOR_BNXT_COUNTER":                         242,
 		"QP_SQ_REQ_TX_NUM_PACKET":                          300,
 		"QP_SQ_REQ_TX_NUM_SEND_MSGS_WITH_RKE":              301,
 		"QP_SQ_REQ_TX_NUM_LOCAL_ACK_TIMEOUTS":              302,
@@ -1727,6 +1847,13 @@ var (
 		"ETH_FRAMES_TX_4096B_8191B":                        576,
 		"ETH_FRAMES_RX_PRIPAUSE":                           577,
 		"ETH_FRAMES_TX_PRIPAUSE":                           578,
+		"NIC_TRANSCEIVER_TEMPERATURE":                      600,
+		"NIC_TRANSCEIVER_VOLTAGE":                          601,
+		"NIC_TRANSCEIVER_TX_POWER":                         602,
+		"NIC_TRANSCEIVER_RX_POWER":                         603,
+		"NIC_TRANSCEIVER_BIAS_CURRENT":                     604,
+		"NIC_TRANSCEIVER_INFO":                             605,
+		"NIC_TRANSCEIVER_THRESHOLD_EXCEEDED":               606,
 		"ETH_FRAMES_RX_PRI0":                               520,
 		"ETH_FRAMES_RX_PRI1":                               521,
 		"ETH_FRAMES_RX_PRI2":                               522,
@@ -1780,6 +1907,8 @@ const (
 	// mandatory field NIC_ID cannot be disabled
 	NICMetricLabel_NIC_ID           NICMetricLabel = 1
 	NICMetricLabel_FIRMWARE_VERSION NICMetricLabel = 2
+	// gpu id of the GPU closest to the NIC in the PCIe/NUMA topology
+	NICMetricLabel_AFFINITY_GPU NICMetricLabel = 3
 )
 
 // Enum value maps for NICMetricLabel.
@@ -1788,11 +1917,13 @@ var (
 		0: "NIC_UUID",
 		1: "NIC_ID",
 		2: "FIRMWARE_VERSION",
+		3: "AFFINITY_GPU",
 	}
 	NICMetricLabel_value = map[string]int32{
 		"NIC_UUID":         0,
 		"NIC_ID":           1,
 		"FIRMWARE_VERSION": 2,
+		"AFFINITY_GPU":     3,
 	}
 )
 
@@ -1848,6 +1979,18 @@ const (
 	IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS13 IFOEMetricField = 19
 	IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS14 IFOEMetricField = 20
 	IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS15 IFOEMetricField = 21
+	// FEC codewords by symbol errors as one family with a bin label, replaces
+	// IFOE_FEC_CODEWORD_SYMBOL_ERRORS0-15 unless LegacyFECMetrics is set
+	IFOEMetricField_IFOE_FEC_CODEWORD_SYMBOL_ERRORS        IFOEMetricField = 22
+	IFOEMetricField_IFOE_PORT_OPER_STATUS                  IFOEMetricField = 23
+	IFOEMetricField_IFOE_PORT_FEC_CODEWORD_RATE            IFOEMetricField = 24
+	IFOEMetricField_IFOE_PORT_FEC_ERRORED_CODEWORD_RATE    IFOEMetricField = 25
+	IFOEMetricField_IFOE_STATION_OPER_STATUS               IFOEMetricField = 26
+	IFOEMetricField_IFOE_STATION_AVAILABLE_BANDWIDTH       IFOEMetricField = 27
+	IFOEMetricField_IFOE_STATION_ACTIVE_PORTS              IFOEMetricField = 28
+	IFOEMetricField_IFOE_STATION_FAILEDOVER_STREAMS        IFOEMetricField = 29
+	IFOEMetricField_IFOE_STATION_PAUSED_STREAMS            IFOEMetricField = 30
+	IFOEMetricField_IFOE_STATION_FEC_ERRORED_CODEWORD_RATE IFOEMetricField = 31
 )
 
 // Enum value maps for IFOEMetricField.
@@ -1875,30 +2018,50 @@ var (
 		19: "IFOE_FEC_CODEWORD_SYMBOL_ERRORS13",
 		20: "IFOE_FEC_CODEWORD_SYMBOL_ERRORS14",
 		21: "IFOE_FEC_CODEWORD_SYMBOL_ERRORS15",
+		22: "IFOE_FEC_CODEWORD_SYMBOL_ERRORS",
+		23: "IFOE_PORT_OPER_STATUS",
+		24: "IFOE_PORT_FEC_CODEWORD_RATE",
+		25: "IFOE_PORT_FEC_ERRORED_CODEWORD_RATE",
+		26: "IFOE_STATION_OPER_STATUS",
+		27: "IFOE_STATION_AVAILABLE_BANDWIDTH",
+		28: "IFOE_STATION_ACTIVE_PORTS",
+		29: "IFOE_STATION_FAILEDOVER_STREAMS",
+		30: "IFOE_STATION_PAUSED_STREAMS",
+		31: "IFOE_STATION_FEC_ERRORED_CODEWORD_RATE",
 	}
 	IFOEMetricField_value = map[string]int32{
-		"IFOE_TOTAL_DEVICES":                0,
-		"IFOE_TOTAL_STATIONS":               1,
-		"IFOE_TOTAL_PORTS":                  2,
-		"IFOE_NUMBER_FAILEDOVER_STREAMS":    3,
-		"IFOE_NUMBER_PAUSED_STREAMS":        4,
-		"IFOE_BIT_ERROR_RATE":               5,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS0":  6,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS1":  7,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS2":  8,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS3":  9,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS4":  10,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS5":  11,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS6":  12,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS7":  13,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS8":  14,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS9":  15,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS10": 16,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS11": 17,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS12": 18,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS13": 19,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS14": 20,
-		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS15": 21,
+		"IFOE_TOTAL_DEVICES":                     0,
+		"IFOE_TOTAL_STATIONS":                    1,
+		"IFOE_TOTAL_PORTS":                       2,
+		"IFOE_NUMBER_FAILEDOVER_STREAMS":         3,
+		"IFOE_NUMBER_PAUSED_STREAMS":             4,
+		"IFOE_BIT_ERROR_RATE":                    5,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS0":       6,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS1":       7,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS2":       8,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS3":       9,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS4":       10,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS5":       11,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS6":       12,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS7":       13,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS8":       14,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS9":       15,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS10":      16,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS11":      17,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS12":      18,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS13":      19,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS14":      20,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS15":      21,
+		"IFOE_FEC_CODEWORD_SYMBOL_ERRORS":        22,
+		"IFOE_PORT_OPER_STATUS":                  23,
+		"IFOE_PORT_FEC_CODEWORD_RATE":            24,
+		"IFOE_PORT_FEC_ERRORED_CODEWORD_RATE":    25,
+		"IFOE_STATION_OPER_STATUS":               26,
+		"IFOE_STATION_AVAILABLE_BANDWIDTH":       27,
+		"IFOE_STATION_ACTIVE_PORTS":              28,
+		"IFOE_STATION_FAILEDOVER_STREAMS":        29,
+		"IFOE_STATION_PAUSED_STREAMS":            30,
+		"IFOE_STATION_FEC_ERRORED_CODEWORD_RATE": 31,
 	}
 )
 
@@ -2158,6 +2321,37 @@ type GPUMetricConfig struct {
 	// wrong values as 0
 	ProfilerMetrics map[string]bool `protobuf:"bytes,7,rep,name=ProfilerMetrics,proto3" json:"ProfilerMetrics,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 	ProfilerConfig  *ProfilerConfig `protobuf:"bytes,8,opt,name=ProfilerConfig,proto3" json:"ProfilerConfig,omitempty"`
+	// Map of generic workload labels to be exported (prometheus label name as
+	// Key, workload descriptor label key as value)
+	ExtraWorkloadLabels map[string]string `protobuf:"bytes,9,rep,name=ExtraWorkloadLabels,proto3" json:"ExtraWorkloadLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// max number of processes exported per GPU for the per process metrics,
+	// processes are ranked by CU occupancy then VRAM usage
+	// default/0 - 16, negative - all the processes
+	ProcessMetricsTopN int32 `protobuf:"varint,10,opt,name=ProcessMetricsTopN,proto3" json:"ProcessMetricsTopN,omitempty"`
+	// Map of pod annotations to be exported (prometheus label name as Key,
+	// pod annotation as value)
+	ExtraPodAnnotations map[string]string `protobuf:"bytes,11,rep,name=ExtraPodAnnotations,proto3" json:"ExtraPodAnnotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map of labels of the pod namespace to be exported (prometheus label
+	// name as Key, namespace label as value)
+	ExtraNamespaceLabels map[string]string `protobuf:"bytes,12,rep,name=ExtraNamespaceLabels,proto3" json:"ExtraNamespaceLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map of owning controllers to be exported (prometheus label name as Key,
+	// owner kind as value), the name of the owner of that kind in the pod
+	// owner chain is exported, "*" exports the top level owner as <kind>/<name>
+	OwnerKindLabel map[string]string `protobuf:"bytes,13,rep,name=OwnerKindLabel,proto3" json:"OwnerKindLabel,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Collector of the GPU metrics read from the amdgpu sysfs and hwmon
+	// attributes, exported with the source="sysfs" label
+	// disabled/empty - not used
+	// fallback - used while gpuagent is unavailable
+	// primary - used instead of gpuagent
+	SysfsCollector string `protobuf:"bytes,14,opt,name=SysfsCollector,proto3" json:"SysfsCollector,omitempty"`
+	// export the static identity labels of the GPU (uuid, serial number,
+	// card and driver versions, partition types) on a single gpu_info
+	// series, the other GPU metrics only carry the hostname, gpu_id and
+	// gpu_partition_id labels to join with it
+	InfoMetrics bool `protobuf:"varint,15,opt,name=InfoMetrics,proto3" json:"InfoMetrics,omitempty"`
+	// export the GPU_PARTITION_ROLLUP_* metrics aggregating the compute
+	// partitions of each physical GPU, disabled by default
+	PartitionRollupMetrics bool `protobuf:"varint,16,opt,name=PartitionRollupMetrics,proto3" json:"PartitionRollupMetrics,omitempty"`
 }
 
 func (x *GPUMetricConfig) Reset() {
@@ -2248,6 +2442,62 @@ func (x *GPUMetricConfig) GetProfilerConfig() *ProfilerConfig {
 	return nil
 }
 
+func (x *GPUMetricConfig) GetExtraWorkloadLabels() map[string]string {
+	if x != nil {
+		return x.ExtraWorkloadLabels
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetProcessMetricsTopN() int32 {
+	if x != nil {
+		return x.ProcessMetricsTopN
+	}
+	return 0
+}
+
+func (x *GPUMetricConfig) GetExtraPodAnnotations() map[string]string {
+	if x != nil {
+		return x.ExtraPodAnnotations
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetExtraNamespaceLabels() map[string]string {
+	if x != nil {
+		return x.ExtraNamespaceLabels
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetOwnerKindLabel() map[string]string {
+	if x != nil {
+		return x.OwnerKindLabel
+	}
+	return nil
+}
+
+func (x *GPUMetricConfig) GetSysfsCollector() string {
+	if x != nil {
+		return x.SysfsCollector
+	}
+	return ""
+}
+
+func (x *GPUMetricConfig) GetInfoMetrics() bool {
+	if x != nil {
+		return x.InfoMetrics
+	}
+	return false
+}
+
+func (x *GPUMetricConfig) GetPartitionRollupMetrics() bool {
+	if x != nil {
+		return x.PartitionRollupMetrics
+	}
+	return false
+}
+
 type ProfilerConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2259,6 +2509,17 @@ type ProfilerConfig struct {
 	// set it to 0 to disable
 	// only applicable for PTL supported devices
 	PtlDelay uint32 `protobuf:"varint,2,opt,name=PtlDelay,proto3" json:"PtlDelay,omitempty"`
+	// named counter groups, when set a single group is profiled at a time
+	// and the groups are rotated every GroupRotationInterval
+	CounterGroups []*ProfilerCounterGroup `protobuf:"bytes,3,rep,name=CounterGroups,proto3" json:"CounterGroups,omitempty"`
+	// time each counter group is profiled before rotating to the next in
+	// duration format (e.g. 30s, 5m), default 1m
+	GroupRotationInterval string `protobuf:"bytes,4,opt,name=GroupRotationInterval,proto3" json:"GroupRotationInterval,omitempty"`
+	// background sampling of the profiler, when disabled rocpctl runs on
+	// scrape requests
+	Scheduler *ProfilerSchedulerConfig `protobuf:"bytes,5,opt,name=Scheduler,proto3" json:"Scheduler,omitempty"`
+	// per GPU opt out of profiling by the workload holding the GPU
+	OptOut *ProfilerOptOutConfig `protobuf:"bytes,6,opt,name=OptOut,proto3" json:"OptOut,omitempty"`
 }
 
 func (x *ProfilerConfig) Reset() {
@@ -2307,6 +2568,260 @@ func (x *ProfilerConfig) GetPtlDelay() uint32 {
 	return 0
 }
 
+func (x *ProfilerConfig) GetCounterGroups() []*ProfilerCounterGroup {
+	if x != nil {
+		return x.CounterGroups
+	}
+	return nil
+}
+
+func (x *ProfilerConfig) GetGroupRotationInterval() string {
+	if x != nil {
+		return x.GroupRotationInterval
+	}
+	return ""
+}
+
+func (x *ProfilerConfig) GetScheduler() *ProfilerSchedulerConfig {
+	if x != nil {
+		return x.Scheduler
+	}
+	return nil
+}
+
+func (x *ProfilerConfig) GetOptOut() *ProfilerOptOutConfig {
+	if x != nil {
+		return x.OptOut
+	}
+	return nil
+}
+
+// ProfilerOptOutConfig suppresses profiling of the GPUs allocated to
+// workloads opting out, suppressed GPUs are excluded from rocpctl
+type ProfilerOptOutConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// pod annotations, a pod setting one of them to "true" opts out
+	PodAnnotations []string `protobuf:"bytes,1,rep,name=PodAnnotations,proto3" json:"PodAnnotations,omitempty"`
+	// pod labels, a pod setting one of them to "true" opts out
+	PodLabels []string `protobuf:"bytes,2,rep,name=PodLabels,proto3" json:"PodLabels,omitempty"`
+	// honor the AMD_PROFILER_OPT_OUT env of Slurm jobs
+	SlurmJobEnv bool `protobuf:"varint,3,opt,name=SlurmJobEnv,proto3" json:"SlurmJobEnv,omitempty"`
+}
+
+func (x *ProfilerOptOutConfig) Reset() {
+	*x = ProfilerOptOutConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfilerOptOutConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfilerOptOutConfig) ProtoMessage() {}
+
+func (x *ProfilerOptOutConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfilerOptOutConfig.ProtoReflect.Descriptor instead.
+func (*ProfilerOptOutConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProfilerOptOutConfig) GetPodAnnotations() []string {
+	if x != nil {
+		return x.PodAnnotations
+	}
+	return nil
+}
+
+func (x *ProfilerOptOutConfig) GetPodLabels() []string {
+	if x != nil {
+		return x.PodLabels
+	}
+	return nil
+}
+
+func (x *ProfilerOptOutConfig) GetSlurmJobEnv() bool {
+	if x != nil {
+		return x.SlurmJobEnv
+	}
+	return false
+}
+
+// ProfilerSchedulerConfig runs rocpctl on its own period independent of the
+// scrape interval, scrapes are served from the latest sample
+type ProfilerSchedulerConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Enable bool `protobuf:"varint,1,opt,name=Enable,proto3" json:"Enable,omitempty"`
+	// time between the start of two samples in duration format, default 10s,
+	// minimum 1s
+	Period string `protobuf:"bytes,2,opt,name=Period,proto3" json:"Period,omitempty"`
+	// maximum percent of time rocpctl may be running, the next sample is
+	// delayed when a sample takes longer, default 100
+	DutyCycle uint32 `protobuf:"varint,3,opt,name=DutyCycle,proto3" json:"DutyCycle,omitempty"`
+	// skip sampling while all GPUs are idle
+	SkipIdle bool `protobuf:"varint,4,opt,name=SkipIdle,proto3" json:"SkipIdle,omitempty"`
+	// pod annotation set to "true" by jobs opting out of profiling, same as
+	// adding it to OptOut.PodAnnotations
+	OptOutAnnotation string `protobuf:"bytes,5,opt,name=OptOutAnnotation,proto3" json:"OptOutAnnotation,omitempty"`
+	// skipped samples back off exponentially from Period up to MaxBackoff,
+	// default 5m
+	MaxBackoff string `protobuf:"bytes,6,opt,name=MaxBackoff,proto3" json:"MaxBackoff,omitempty"`
+}
+
+func (x *ProfilerSchedulerConfig) Reset() {
+	*x = ProfilerSchedulerConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfilerSchedulerConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfilerSchedulerConfig) ProtoMessage() {}
+
+func (x *ProfilerSchedulerConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfilerSchedulerConfig.ProtoReflect.Descriptor instead.
+func (*ProfilerSchedulerConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ProfilerSchedulerConfig) GetEnable() bool {
+	if x != nil {
+		return x.Enable
+	}
+	return false
+}
+
+func (x *ProfilerSchedulerConfig) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *ProfilerSchedulerConfig) GetDutyCycle() uint32 {
+	if x != nil {
+		return x.DutyCycle
+	}
+	return 0
+}
+
+func (x *ProfilerSchedulerConfig) GetSkipIdle() bool {
+	if x != nil {
+		return x.SkipIdle
+	}
+	return false
+}
+
+func (x *ProfilerSchedulerConfig) GetOptOutAnnotation() string {
+	if x != nil {
+		return x.OptOutAnnotation
+	}
+	return ""
+}
+
+func (x *ProfilerSchedulerConfig) GetMaxBackoff() string {
+	if x != nil {
+		return x.MaxBackoff
+	}
+	return ""
+}
+
+// ProfilerCounterGroup is a set of profiler counters read together
+type ProfilerCounterGroup struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// group name, the built-in groups memory-bound, compute-bound and cache
+	// are used when Fields is empty
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// GPU_PROF_* fields or rocprofiler counter names of the group, derived
+	// fields add the counters of their formula
+	Fields []string `protobuf:"bytes,2,rep,name=Fields,proto3" json:"Fields,omitempty"`
+}
+
+func (x *ProfilerCounterGroup) Reset() {
+	*x = ProfilerCounterGroup{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProfilerCounterGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProfilerCounterGroup) ProtoMessage() {}
+
+func (x *ProfilerCounterGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProfilerCounterGroup.ProtoReflect.Descriptor instead.
+func (*ProfilerCounterGroup) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ProfilerCounterGroup) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProfilerCounterGroup) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
 type HealthServiceConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -2322,7 +2837,7 @@ type HealthServiceConfig struct {
 func (x *HealthServiceConfig) Reset() {
 	*x = HealthServiceConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[3]
+		mi := &file_exporterconfig_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2335,7 +2850,7 @@ func (x *HealthServiceConfig) String() string {
 func (*HealthServiceConfig) ProtoMessage() {}
 
 func (x *HealthServiceConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[3]
+	mi := &file_exporterconfig_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2348,7 +2863,7 @@ func (x *HealthServiceConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use HealthServiceConfig.ProtoReflect.Descriptor instead.
 func (*HealthServiceConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{3}
+	return file_exporterconfig_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *HealthServiceConfig) GetEnable() bool {
@@ -2389,7 +2904,7 @@ type LoggingConfig struct {
 func (x *LoggingConfig) Reset() {
 	*x = LoggingConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[4]
+		mi := &file_exporterconfig_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2402,7 +2917,7 @@ func (x *LoggingConfig) String() string {
 func (*LoggingConfig) ProtoMessage() {}
 
 func (x *LoggingConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[4]
+	mi := &file_exporterconfig_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2415,7 +2930,7 @@ func (x *LoggingConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use LoggingConfig.ProtoReflect.Descriptor instead.
 func (*LoggingConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{4}
+	return file_exporterconfig_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *LoggingConfig) GetLevel() string {
@@ -2469,7 +2984,7 @@ type CommonConfig struct {
 func (x *CommonConfig) Reset() {
 	*x = CommonConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[5]
+		mi := &file_exporterconfig_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2482,7 +2997,7 @@ func (x *CommonConfig) String() string {
 func (*CommonConfig) ProtoMessage() {}
 
 func (x *CommonConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[5]
+	mi := &file_exporterconfig_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2495,7 +3010,7 @@ func (x *CommonConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CommonConfig.ProtoReflect.Descriptor instead.
 func (*CommonConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{5}
+	return file_exporterconfig_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CommonConfig) GetMetricsFieldPrefix() string {
@@ -2534,12 +3049,31 @@ type NICMetricConfig struct {
 	HealthCheckConfig *NICHealthCheckConfig `protobuf:"bytes,4,opt,name=HealthCheckConfig,proto3" json:"HealthCheckConfig,omitempty"`
 	// Map of extra pod labels to be exported (prometheus metric name as Key, pod label as value)
 	ExtraPodLabels map[string]string `protobuf:"bytes,5,rep,name=ExtraPodLabels,proto3" json:"ExtraPodLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map of pod annotations to be exported (prometheus label name as Key,
+	// pod annotation as value)
+	ExtraPodAnnotations map[string]string `protobuf:"bytes,6,rep,name=ExtraPodAnnotations,proto3" json:"ExtraPodAnnotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map of labels of the pod namespace to be exported (prometheus label
+	// name as Key, namespace label as value)
+	ExtraNamespaceLabels map[string]string `protobuf:"bytes,7,rep,name=ExtraNamespaceLabels,proto3" json:"ExtraNamespaceLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map of owning controllers to be exported (prometheus label name as Key,
+	// owner kind as value), the name of the owner of that kind in the pod
+	// owner chain is exported, "*" exports the top level owner as <kind>/<name>
+	OwnerKindLabel map[string]string `protobuf:"bytes,8,rep,name=OwnerKindLabel,proto3" json:"OwnerKindLabel,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// window of the derived rate metrics as a duration string (e.g. 30s, 5m),
+	// default 1m
+	DerivedMetricsWindow string `protobuf:"bytes,9,opt,name=DerivedMetricsWindow,proto3" json:"DerivedMetricsWindow,omitempty"`
+	// transceiver diagnostics config
+	TransceiverConfig *NICTransceiverConfig `protobuf:"bytes,10,opt,name=TransceiverConfig,proto3" json:"TransceiverConfig,omitempty"`
+	// export the static identity labels of the NIC (uuid, serial number,
+	// firmware version, affinity gpu) on a single nic_info series, the other
+	// NIC metrics only carry the hostname and nic_id labels to join with it
+	InfoMetrics bool `protobuf:"varint,11,opt,name=InfoMetrics,proto3" json:"InfoMetrics,omitempty"`
 }
 
 func (x *NICMetricConfig) Reset() {
 	*x = NICMetricConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[6]
+		mi := &file_exporterconfig_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2552,7 +3086,7 @@ func (x *NICMetricConfig) String() string {
 func (*NICMetricConfig) ProtoMessage() {}
 
 func (x *NICMetricConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[6]
+	mi := &file_exporterconfig_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2565,7 +3099,7 @@ func (x *NICMetricConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NICMetricConfig.ProtoReflect.Descriptor instead.
 func (*NICMetricConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{6}
+	return file_exporterconfig_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *NICMetricConfig) GetFields() []string {
@@ -2603,86 +3137,537 @@ func (x *NICMetricConfig) GetExtraPodLabels() map[string]string {
 	return nil
 }
 
-type NICHealthCheckConfig struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *NICMetricConfig) GetExtraPodAnnotations() map[string]string {
+	if x != nil {
+		return x.ExtraPodAnnotations
+	}
+	return nil
+}
 
-	// report interface admin down state as unhealthy
-	InterfaceAdminDownAsUnhealthy bool `protobuf:"varint,1,opt,name=InterfaceAdminDownAsUnhealthy,proto3" json:"InterfaceAdminDownAsUnhealthy,omitempty"`
+func (x *NICMetricConfig) GetExtraNamespaceLabels() map[string]string {
+	if x != nil {
+		return x.ExtraNamespaceLabels
+	}
+	return nil
+}
+
+func (x *NICMetricConfig) GetOwnerKindLabel() map[string]string {
+	if x != nil {
+		return x.OwnerKindLabel
+	}
+	return nil
+}
+
+func (x *NICMetricConfig) GetDerivedMetricsWindow() string {
+	if x != nil {
+		return x.DerivedMetricsWindow
+	}
+	return ""
+}
+
+func (x *NICMetricConfig) GetTransceiverConfig() *NICTransceiverConfig {
+	if x != nil {
+		return x.TransceiverConfig
+	}
+	return nil
+}
+
+func (x *NICMetricConfig) GetInfoMetrics() bool {
+	if x != nil {
+		return x.InfoMetrics
+	}
+	return false
+}
+
+type NICTransceiverConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// refresh interval of the module diagnostics as a duration string
+	// (e.g. 5m, 1h), default 5m
+	RefreshInterval string `protobuf:"bytes,1,opt,name=RefreshInterval,proto3" json:"RefreshInterval,omitempty"`
+	// warning thresholds, a threshold that is not set falls back to the
+	// warning threshold reported by the module
+	WarningThresholds *NICTransceiverThresholds `protobuf:"bytes,2,opt,name=WarningThresholds,proto3" json:"WarningThresholds,omitempty"`
+}
+
+func (x *NICTransceiverConfig) Reset() {
+	*x = NICTransceiverConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NICTransceiverConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NICTransceiverConfig) ProtoMessage() {}
+
+func (x *NICTransceiverConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NICTransceiverConfig.ProtoReflect.Descriptor instead.
+func (*NICTransceiverConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *NICTransceiverConfig) GetRefreshInterval() string {
+	if x != nil {
+		return x.RefreshInterval
+	}
+	return ""
+}
+
+func (x *NICTransceiverConfig) GetWarningThresholds() *NICTransceiverThresholds {
+	if x != nil {
+		return x.WarningThresholds
+	}
+	return nil
+}
+
+type NICTransceiverThresholds struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// module temperature high threshold in degrees C
+	TemperatureHigh float64 `protobuf:"fixed64,1,opt,name=TemperatureHigh,proto3" json:"TemperatureHigh,omitempty"`
+	// module supply voltage low and high thresholds in V
+	VoltageLow  float64 `protobuf:"fixed64,2,opt,name=VoltageLow,proto3" json:"VoltageLow,omitempty"`
+	VoltageHigh float64 `protobuf:"fixed64,3,opt,name=VoltageHigh,proto3" json:"VoltageHigh,omitempty"`
+	// per lane tx and rx optical power low thresholds in mW
+	TxPowerLow float64 `protobuf:"fixed64,4,opt,name=TxPowerLow,proto3" json:"TxPowerLow,omitempty"`
+	RxPowerLow float64 `protobuf:"fixed64,5,opt,name=RxPowerLow,proto3" json:"RxPowerLow,omitempty"`
+	// per lane laser bias current high threshold in mA
+	BiasCurrentHigh float64 `protobuf:"fixed64,6,opt,name=BiasCurrentHigh,proto3" json:"BiasCurrentHigh,omitempty"`
+}
+
+func (x *NICTransceiverThresholds) Reset() {
+	*x = NICTransceiverThresholds{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NICTransceiverThresholds) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NICTransceiverThresholds) ProtoMessage() {}
+
+func (x *NICTransceiverThresholds) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NICTransceiverThresholds.ProtoReflect.Descriptor instead.
+func (*NICTransceiverThresholds) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *NICTransceiverThresholds) GetTemperatureHigh() float64 {
+	if x != nil {
+		return x.TemperatureHigh
+	}
+	return 0
+}
+
+func (x *NICTransceiverThresholds) GetVoltageLow() float64 {
+	if x != nil {
+		return x.VoltageLow
+	}
+	return 0
+}
+
+func (x *NICTransceiverThresholds) GetVoltageHigh() float64 {
+	if x != nil {
+		return x.VoltageHigh
+	}
+	return 0
+}
+
+func (x *NICTransceiverThresholds) GetTxPowerLow() float64 {
+	if x != nil {
+		return x.TxPowerLow
+	}
+	return 0
+}
+
+func (x *NICTransceiverThresholds) GetRxPowerLow() float64 {
+	if x != nil {
+		return x.RxPowerLow
+	}
+	return 0
+}
+
+func (x *NICTransceiverThresholds) GetBiasCurrentHigh() float64 {
+	if x != nil {
+		return x.BiasCurrentHigh
+	}
+	return 0
+}
+
+type NICHealthCheckConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// report interface admin down state as unhealthy
+	InterfaceAdminDownAsUnhealthy bool `protobuf:"varint,1,opt,name=InterfaceAdminDownAsUnhealthy,proto3" json:"InterfaceAdminDownAsUnhealthy,omitempty"`
+	// report the lifs of a port with operational (link) state down as unhealthy
+	LinkDownAsUnhealthy bool `protobuf:"varint,2,opt,name=LinkDownAsUnhealthy,proto3" json:"LinkDownAsUnhealthy,omitempty"`
+	// link down events of the port within the window
+	LinkFlap *NICHealthCounterRule `protobuf:"bytes,3,opt,name=LinkFlap,proto3" json:"LinkFlap,omitempty"`
+	// RS-FEC uncorrectable words of the port within the window
+	RSFECUncorrectable *NICHealthCounterRule `protobuf:"bytes,4,opt,name=RSFECUncorrectable,proto3" json:"RSFECUncorrectable,omitempty"`
+	// RDMA requests of the device that exceeded the retry count within the window
+	RDMARetryExceeded *NICHealthCounterRule `protobuf:"bytes,5,opt,name=RDMARetryExceeded,proto3" json:"RDMARetryExceeded,omitempty"`
+	// RDMA local ack timeouts of the device within the window
+	RDMALocalAckTimeout *NICHealthCounterRule `protobuf:"bytes,6,opt,name=RDMALocalAckTimeout,proto3" json:"RDMALocalAckTimeout,omitempty"`
+	// pause and priority pause frames received and sent by the port within
+	// the window
+	PFCPauseStorm *NICHealthCounterRule `protobuf:"bytes,7,opt,name=PFCPauseStorm,proto3" json:"PFCPauseStorm,omitempty"`
+}
+
+func (x *NICHealthCheckConfig) Reset() {
+	*x = NICHealthCheckConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NICHealthCheckConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NICHealthCheckConfig) ProtoMessage() {}
+
+func (x *NICHealthCheckConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NICHealthCheckConfig.ProtoReflect.Descriptor instead.
+func (*NICHealthCheckConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *NICHealthCheckConfig) GetInterfaceAdminDownAsUnhealthy() bool {
+	if x != nil {
+		return x.InterfaceAdminDownAsUnhealthy
+	}
+	return false
+}
+
+func (x *NICHealthCheckConfig) GetLinkDownAsUnhealthy() bool {
+	if x != nil {
+		return x.LinkDownAsUnhealthy
+	}
+	return false
+}
+
+func (x *NICHealthCheckConfig) GetLinkFlap() *NICHealthCounterRule {
+	if x != nil {
+		return x.LinkFlap
+	}
+	return nil
+}
+
+func (x *NICHealthCheckConfig) GetRSFECUncorrectable() *NICHealthCounterRule {
+	if x != nil {
+		return x.RSFECUncorrectable
+	}
+	return nil
+}
+
+func (x *NICHealthCheckConfig) GetRDMARetryExceeded() *NICHealthCounterRule {
+	if x != nil {
+		return x.RDMARetryExceeded
+	}
+	return nil
+}
+
+func (x *NICHealthCheckConfig) GetRDMALocalAckTimeout() *NICHealthCounterRule {
+	if x != nil {
+		return x.RDMALocalAckTimeout
+	}
+	return nil
+}
+
+func (x *NICHealthCheckConfig) GetPFCPauseStorm() *NICHealthCounterRule {
+	if x != nil {
+		return x.PFCPauseStorm
+	}
+	return nil
+}
+
+// NICHealthCounterRule reports the lifs as unhealthy when a counter increases
+// by at least the threshold within the window
+type NICHealthCounterRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// counter increase reported as unhealthy, 0 disables the rule
+	Threshold uint64 `protobuf:"varint,1,opt,name=Threshold,proto3" json:"Threshold,omitempty"`
+	// window as a duration string (e.g. 5m, 1h), default 5m
+	Window string `protobuf:"bytes,2,opt,name=Window,proto3" json:"Window,omitempty"`
+}
+
+func (x *NICHealthCounterRule) Reset() {
+	*x = NICHealthCounterRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NICHealthCounterRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NICHealthCounterRule) ProtoMessage() {}
+
+func (x *NICHealthCounterRule) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NICHealthCounterRule.ProtoReflect.Descriptor instead.
+func (*NICHealthCounterRule) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *NICHealthCounterRule) GetThreshold() uint64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *NICHealthCounterRule) GetWindow() string {
+	if x != nil {
+		return x.Window
+	}
+	return ""
+}
+
+type IFOEMetricConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// list of all IFOEMetricField to be exported
+	Fields []string `protobuf:"bytes,1,rep,name=Fields,proto3" json:"Fields,omitempty"`
+	// list of labels to be exported
+	Labels []string `protobuf:"bytes,2,rep,name=Labels,proto3" json:"Labels,omitempty"`
+	// User Defined Custom Labels and values
+	CustomLabels map[string]string `protobuf:"bytes,3,rep,name=CustomLabels,proto3" json:"CustomLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map of extra pod labels to be exported (prometheus metric name as Key, pod label as value)
+	ExtraPodLabels map[string]string `protobuf:"bytes,4,rep,name=ExtraPodLabels,proto3" json:"ExtraPodLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map of pod annotations to be exported (prometheus label name as Key,
+	// pod annotation as value)
+	ExtraPodAnnotations map[string]string `protobuf:"bytes,5,rep,name=ExtraPodAnnotations,proto3" json:"ExtraPodAnnotations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map of labels of the pod namespace to be exported (prometheus label
+	// name as Key, namespace label as value)
+	ExtraNamespaceLabels map[string]string `protobuf:"bytes,6,rep,name=ExtraNamespaceLabels,proto3" json:"ExtraNamespaceLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// Map of owning controllers to be exported (prometheus label name as Key,
+	// owner kind as value), the name of the owner of that kind in the pod
+	// owner chain is exported, "*" exports the top level owner as <kind>/<name>
+	OwnerKindLabel map[string]string `protobuf:"bytes,7,rep,name=OwnerKindLabel,proto3" json:"OwnerKindLabel,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// IFOE health check config
+	HealthCheckConfig *IFOEHealthCheckConfig `protobuf:"bytes,8,opt,name=HealthCheckConfig,proto3" json:"HealthCheckConfig,omitempty"`
+	// export the FEC codewords as the IFOE_FEC_CODEWORD_SYMBOL_ERRORS0-15
+	// metrics, one per symbol error bin, instead of the
+	// IFOE_FEC_CODEWORD_SYMBOL_ERRORS family with a bin label
+	LegacyFECMetrics bool `protobuf:"varint,9,opt,name=LegacyFECMetrics,proto3" json:"LegacyFECMetrics,omitempty"`
+	// export the static identity labels of the IFOE port (card and driver
+	// versions, serial number) on a single ifoe_info series, the other IFOE
+	// metrics only carry the hostname and gpu_uuid labels to join with it
+	InfoMetrics bool `protobuf:"varint,10,opt,name=InfoMetrics,proto3" json:"InfoMetrics,omitempty"`
+}
+
+func (x *IFOEMetricConfig) Reset() {
+	*x = IFOEMetricConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exporterconfig_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *IFOEMetricConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IFOEMetricConfig) ProtoMessage() {}
+
+func (x *IFOEMetricConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IFOEMetricConfig.ProtoReflect.Descriptor instead.
+func (*IFOEMetricConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *IFOEMetricConfig) GetFields() []string {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *IFOEMetricConfig) GetLabels() []string {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *IFOEMetricConfig) GetCustomLabels() map[string]string {
+	if x != nil {
+		return x.CustomLabels
+	}
+	return nil
+}
+
+func (x *IFOEMetricConfig) GetExtraPodLabels() map[string]string {
+	if x != nil {
+		return x.ExtraPodLabels
+	}
+	return nil
 }
 
-func (x *NICHealthCheckConfig) Reset() {
-	*x = NICHealthCheckConfig{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *IFOEMetricConfig) GetExtraPodAnnotations() map[string]string {
+	if x != nil {
+		return x.ExtraPodAnnotations
 	}
+	return nil
 }
 
-func (x *NICHealthCheckConfig) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *IFOEMetricConfig) GetExtraNamespaceLabels() map[string]string {
+	if x != nil {
+		return x.ExtraNamespaceLabels
+	}
+	return nil
 }
 
-func (*NICHealthCheckConfig) ProtoMessage() {}
+func (x *IFOEMetricConfig) GetOwnerKindLabel() map[string]string {
+	if x != nil {
+		return x.OwnerKindLabel
+	}
+	return nil
+}
 
-func (x *NICHealthCheckConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[7]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *IFOEMetricConfig) GetHealthCheckConfig() *IFOEHealthCheckConfig {
+	if x != nil {
+		return x.HealthCheckConfig
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use NICHealthCheckConfig.ProtoReflect.Descriptor instead.
-func (*NICHealthCheckConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{7}
+func (x *IFOEMetricConfig) GetLegacyFECMetrics() bool {
+	if x != nil {
+		return x.LegacyFECMetrics
+	}
+	return false
 }
 
-func (x *NICHealthCheckConfig) GetInterfaceAdminDownAsUnhealthy() bool {
+func (x *IFOEMetricConfig) GetInfoMetrics() bool {
 	if x != nil {
-		return x.InterfaceAdminDownAsUnhealthy
+		return x.InfoMetrics
 	}
 	return false
 }
 
-type IFOEMetricConfig struct {
+// IFOEHealthCheckConfig reports the IFOE network ports as unhealthy when a
+// threshold is reached, the station link and port operational states are
+// always evaluated
+type IFOEHealthCheckConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// list of all IFOEMetricField to be exported
-	Fields []string `protobuf:"bytes,1,rep,name=Fields,proto3" json:"Fields,omitempty"`
-	// list of labels to be exported
-	Labels []string `protobuf:"bytes,2,rep,name=Labels,proto3" json:"Labels,omitempty"`
-	// User Defined Custom Labels and values
-	CustomLabels map[string]string `protobuf:"bytes,3,rep,name=CustomLabels,proto3" json:"CustomLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	// Map of extra pod labels to be exported (prometheus metric name as Key, pod label as value)
-	ExtraPodLabels map[string]string `protobuf:"bytes,4,rep,name=ExtraPodLabels,proto3" json:"ExtraPodLabels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-}
-
-func (x *IFOEMetricConfig) Reset() {
-	*x = IFOEMetricConfig{}
+	// bit error rate of the port in errors per 10^12 bits, 0 disables the check
+	BitErrorRateThreshold uint64 `protobuf:"varint,1,opt,name=BitErrorRateThreshold,proto3" json:"BitErrorRateThreshold,omitempty"`
+	// codewords with at least FECSymbolErrorBin symbol errors received by the
+	// port between two health polls, 0 disables the check
+	FECSymbolErrorThreshold uint64 `protobuf:"varint,2,opt,name=FECSymbolErrorThreshold,proto3" json:"FECSymbolErrorThreshold,omitempty"`
+	// minimum symbol errors of a codeword counted by FECSymbolErrorThreshold
+	// (1-15), default 8
+	FECSymbolErrorBin uint32 `protobuf:"varint,3,opt,name=FECSymbolErrorBin,proto3" json:"FECSymbolErrorBin,omitempty"`
+	// streams of the port failed over to another port, 0 disables the check
+	FailedoverStreamsThreshold uint32 `protobuf:"varint,4,opt,name=FailedoverStreamsThreshold,proto3" json:"FailedoverStreamsThreshold,omitempty"`
+	// streams of the port paused on retransmission timeout, 0 disables the
+	// check
+	PausedStreamsThreshold uint32 `protobuf:"varint,5,opt,name=PausedStreamsThreshold,proto3" json:"PausedStreamsThreshold,omitempty"`
+}
+
+func (x *IFOEHealthCheckConfig) Reset() {
+	*x = IFOEHealthCheckConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[8]
+		mi := &file_exporterconfig_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IFOEMetricConfig) String() string {
+func (x *IFOEHealthCheckConfig) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IFOEMetricConfig) ProtoMessage() {}
+func (*IFOEHealthCheckConfig) ProtoMessage() {}
 
-func (x *IFOEMetricConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[8]
+func (x *IFOEHealthCheckConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_exporterconfig_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2693,37 +3678,44 @@ func (x *IFOEMetricConfig) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IFOEMetricConfig.ProtoReflect.Descriptor instead.
-func (*IFOEMetricConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use IFOEHealthCheckConfig.ProtoReflect.Descriptor instead.
+func (*IFOEHealthCheckConfig) Descriptor() ([]byte, []int) {
+	return file_exporterconfig_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *IFOEMetricConfig) GetFields() []string {
+func (x *IFOEHealthCheckConfig) GetBitErrorRateThreshold() uint64 {
 	if x != nil {
-		return x.Fields
+		return x.BitErrorRateThreshold
 	}
-	return nil
+	return 0
 }
 
-func (x *IFOEMetricConfig) GetLabels() []string {
+func (x *IFOEHealthCheckConfig) GetFECSymbolErrorThreshold() uint64 {
 	if x != nil {
-		return x.Labels
+		return x.FECSymbolErrorThreshold
 	}
-	return nil
+	return 0
 }
 
-func (x *IFOEMetricConfig) GetCustomLabels() map[string]string {
+func (x *IFOEHealthCheckConfig) GetFECSymbolErrorBin() uint32 {
 	if x != nil {
-		return x.CustomLabels
+		return x.FECSymbolErrorBin
 	}
-	return nil
+	return 0
 }
 
-func (x *IFOEMetricConfig) GetExtraPodLabels() map[string]string {
+func (x *IFOEHealthCheckConfig) GetFailedoverStreamsThreshold() uint32 {
 	if x != nil {
-		return x.ExtraPodLabels
+		return x.FailedoverStreamsThreshold
 	}
-	return nil
+	return 0
+}
+
+func (x *IFOEHealthCheckConfig) GetPausedStreamsThreshold() uint32 {
+	if x != nil {
+		return x.PausedStreamsThreshold
+	}
+	return 0
 }
 
 type MetricConfig struct {
@@ -2746,7 +3738,7 @@ type MetricConfig struct {
 func (x *MetricConfig) Reset() {
 	*x = MetricConfig{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exporterconfig_proto_msgTypes[9]
+		mi := &file_exporterconfig_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2759,7 +3751,7 @@ func (x *MetricConfig) String() string {
 func (*MetricConfig) ProtoMessage() {}
 
 func (x *MetricConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_exporterconfig_proto_msgTypes[9]
+	mi := &file_exporterconfig_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2772,7 +3764,7 @@ func (x *MetricConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MetricConfig.ProtoReflect.Descriptor instead.
 func (*MetricConfig) Descriptor() ([]byte, []int) {
-	return file_exporterconfig_proto_rawDescGZIP(), []int{9}
+	return file_exporterconfig_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MetricConfig) GetServerPort() uint32 {
@@ -2881,7 +3873,7 @@ var file_exporterconfig_proto_rawDesc = []byte{
 	0x43, 0x43, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x4d, 0x50, 0x49, 0x4f, 0x12,
 	0x27, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x43, 0x50, 0x45, 0x52, 0x5f, 0x4d, 0x41, 0x58, 0x5f,
 	0x41, 0x47, 0x45, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x47, 0x50, 0x55, 0x43, 0x50,
-	0x45, 0x52, 0x4d, 0x41, 0x58, 0x41, 0x47, 0x45, 0x22, 0xd7, 0x05, 0x0a, 0x0f, 0x47, 0x50, 0x55,
+	0x45, 0x52, 0x4d, 0x41, 0x58, 0x41, 0x47, 0x45, 0x22, 0xcd, 0x0c, 0x0a, 0x0f, 0x47, 0x50, 0x55,
 	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08,
 	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
 	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c,
@@ -2914,1153 +3906,1503 @@ var file_exporterconfig_proto_rawDesc = []byte{
 	0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x70, 0x6f,
 	0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x66,
 	0x69, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0e, 0x50, 0x72, 0x6f, 0x66,
-	0x69, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75,
-	0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x45,
-	0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42,
-	0x0a, 0x14, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x22, 0x58, 0x0a, 0x0e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67,
-	0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
-	0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
-	0x12, 0x1a, 0x0a, 0x08, 0x50, 0x74, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x08, 0x50, 0x74, 0x6c, 0x44, 0x65, 0x6c, 0x61, 0x79, 0x22, 0x4f, 0x0a, 0x13,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x50,
-	0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x22, 0xbb, 0x01,
-	0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x14, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x4d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65,
-	0x53, 0x69, 0x7a, 0x65, 0x4d, 0x42, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x4d, 0x61,
-	0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x42, 0x12, 0x1e, 0x0a, 0x0a, 0x4d,
-	0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0a, 0x4d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4d,
-	0x61, 0x78, 0x41, 0x67, 0x65, 0x44, 0x61, 0x79, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0a, 0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x44, 0x61, 0x79, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x4c,
-	0x6f, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x0c,
-	0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x12,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x66,
-	0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12, 0x4a, 0x0a, 0x0d,
-	0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74,
-	0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x38, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x67,
-	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x67,
-	0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x4c, 0x6f, 0x67, 0x67, 0x69,
-	0x6e, 0x67, 0x22, 0xd0, 0x03, 0x0a, 0x0f, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x56, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d,
-	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e,
-	0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43,
-	0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x53,
-	0x0a, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x48,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x5c, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49,
-	0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78,
-	0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
-	0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61,
+	0x69, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x6b, 0x0a, 0x13, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x57,
+	0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61,
+	0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x50, 0x72, 0x6f, 0x63, 0x65,
+	0x73, 0x73, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x54, 0x6f, 0x70, 0x4e, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x12, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x73, 0x54, 0x6f, 0x70, 0x4e, 0x12, 0x6b, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x50, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6e, 0x0a, 0x14, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x0c, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x14,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x5c, 0x0a, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6e,
+	0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65,
+	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47,
+	0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f,
+	0x77, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x53, 0x79, 0x73, 0x66, 0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x53, 0x79, 0x73, 0x66,
+	0x73, 0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e,
+	0x66, 0x6f, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x36, 0x0a, 0x16,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x50, 0x61,
+	0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61,
 	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x5c, 0x0a, 0x14, 0x4e, 0x49, 0x43, 0x48, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44, 0x0a,
-	0x1d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44,
-	0x6f, 0x77, 0x6e, 0x41, 0x73, 0x55, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x1d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x55, 0x6e, 0x68, 0x65, 0x61, 0x6c,
-	0x74, 0x68, 0x79, 0x22, 0xfe, 0x02, 0x0a, 0x10, 0x49, 0x46, 0x4f, 0x45, 0x4d, 0x65, 0x74, 0x72,
-	0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c,
-	0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
-	0x52, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x57, 0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74,
-	0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
-	0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
-	0x2e, 0x49, 0x46, 0x4f, 0x45, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c,
-	0x73, 0x12, 0x5d, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62,
-	0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x65, 0x78, 0x70, 0x6f,
-	0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x49, 0x46, 0x4f, 0x45,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74,
-	0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x1a, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f,
+	0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x42, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x72, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x4c, 0x61, 0x62,
 	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
 	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x3a, 0x02, 0x38, 0x01, 0x22, 0xb4, 0x02, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50,
-	0x6f, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x3e, 0x0a, 0x09, 0x47, 0x50, 0x55, 0x43, 0x6f, 0x6e, 0x66,
-	0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72,
-	0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65,
-	0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x47, 0x50, 0x55, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78,
-	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x09, 0x4e, 0x49, 0x43, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4b, 0x69,
+	0x6e, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xe2, 0x02, 0x0a, 0x0e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x53,
+	0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x69, 0x6e, 0x67, 0x49,
+	0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x74, 0x6c, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x50, 0x74, 0x6c, 0x44, 0x65,
+	0x6c, 0x61, 0x79, 0x12, 0x4b, 0x0a, 0x0d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x0d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
+	0x12, 0x34, 0x0a, 0x15, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x15, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x46, 0x0a, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x65, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x09, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75, 0x6c, 0x65, 0x72, 0x12, 0x3d,
+	0x0a, 0x06, 0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73,
+	0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x22, 0x7e, 0x0a,
+	0x14, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x26, 0x0a, 0x0e, 0x50, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x50,
+	0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x53,
+	0x6c, 0x75, 0x72, 0x6d, 0x4a, 0x6f, 0x62, 0x45, 0x6e, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0b, 0x53, 0x6c, 0x75, 0x72, 0x6d, 0x4a, 0x6f, 0x62, 0x45, 0x6e, 0x76, 0x22, 0xcf, 0x01,
+	0x0a, 0x17, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x53, 0x63, 0x68, 0x65, 0x64, 0x75,
+	0x6c, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x44, 0x75, 0x74,
+	0x79, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x44, 0x75,
+	0x74, 0x79, 0x43, 0x79, 0x63, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x6b, 0x69, 0x70, 0x49,
+	0x64, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x53, 0x6b, 0x69, 0x70, 0x49,
+	0x64, 0x6c, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x4f, 0x70, 0x74, 0x4f, 0x75, 0x74, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x4f,
+	0x70, 0x74, 0x4f, 0x75, 0x74, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1e, 0x0a, 0x0a, 0x4d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x4d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x22,
+	0x42, 0x0a, 0x14, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x72, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x65, 0x72, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46, 0x69, 0x65,
+	0x6c, 0x64, 0x73, 0x22, 0x4f, 0x0a, 0x13, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x45, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x45, 0x6e, 0x61, 0x62,
+	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x6f, 0x6c, 0x6c, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x65, 0x22, 0xbb, 0x01, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x24, 0x0a, 0x0d,
+	0x4d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x4d, 0x42, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0d, 0x4d, 0x61, 0x78, 0x46, 0x69, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x4d, 0x42, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x4d, 0x61, 0x78, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x44, 0x61, 0x79, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x4d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x44, 0x61,
+	0x79, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x44, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
+	0x4c, 0x6f, 0x67, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x73, 0x61, 0x62,
+	0x6c, 0x65, 0x22, 0xc4, 0x01, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x2e, 0x0a, 0x12, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x12, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x50, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x12, 0x4a, 0x0a, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x65, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x0d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x38, 0x0a, 0x07, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x07, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x22, 0x8a, 0x09, 0x0a, 0x0f, 0x4e, 0x49,
+	0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16, 0x0a,
+	0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x56, 0x0a,
+	0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x53, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x5c, 0x0a, 0x0e, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x34, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62,
+	0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50,
+	0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x6b, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x50, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x39, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64,
+	0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6e, 0x0a, 0x14, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x14, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x5c, 0x0a, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4b, 0x69,
+	0x6e, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x32, 0x0a, 0x14, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x14, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x53, 0x0a, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x20, 0x0a, 0x0b,
+	0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x1a, 0x3f,
+	0x0a, 0x11, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a,
+	0x41, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x41, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x45, 0x78,
+	0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65,
+	0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x99, 0x01, 0x0a, 0x14, 0x4e, 0x49, 0x43, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x28, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x52, 0x65, 0x66, 0x72, 0x65, 0x73,
+	0x68, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x57, 0x0a, 0x11, 0x57, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x52,
+	0x11, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x73, 0x22, 0xf0, 0x01, 0x0a, 0x18, 0x4e, 0x49, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x73, 0x12,
+	0x28, 0x0a, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x69,
+	0x67, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x48, 0x69, 0x67, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x56, 0x6f, 0x6c,
+	0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x56,
+	0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x77, 0x12, 0x20, 0x0a, 0x0b, 0x56, 0x6f, 0x6c,
+	0x74, 0x61, 0x67, 0x65, 0x48, 0x69, 0x67, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b,
+	0x56, 0x6f, 0x6c, 0x74, 0x61, 0x67, 0x65, 0x48, 0x69, 0x67, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x54,
+	0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x77, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x54, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x77, 0x12, 0x1e, 0x0a, 0x0a, 0x52,
+	0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0a, 0x52, 0x78, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x4c, 0x6f, 0x77, 0x12, 0x28, 0x0a, 0x0f, 0x42,
+	0x69, 0x61, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x67, 0x68, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0f, 0x42, 0x69, 0x61, 0x73, 0x43, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x48, 0x69, 0x67, 0x68, 0x22, 0xa3, 0x04, 0x0a, 0x14, 0x4e, 0x49, 0x43, 0x48, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x44,
+	0x0a, 0x1d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x41, 0x64, 0x6d, 0x69, 0x6e,
+	0x44, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x55, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1d, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x44, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x55, 0x6e, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x79, 0x12, 0x30, 0x0a, 0x13, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x6f, 0x77, 0x6e,
+	0x41, 0x73, 0x55, 0x6e, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x13, 0x4c, 0x69, 0x6e, 0x6b, 0x44, 0x6f, 0x77, 0x6e, 0x41, 0x73, 0x55, 0x6e, 0x68,
+	0x65, 0x61, 0x6c, 0x74, 0x68, 0x79, 0x12, 0x41, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x46, 0x6c,
+	0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52,
+	0x08, 0x4c, 0x69, 0x6e, 0x6b, 0x46, 0x6c, 0x61, 0x70, 0x12, 0x55, 0x0a, 0x12, 0x52, 0x53, 0x46,
+	0x45, 0x43, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x12, 0x52, 0x53,
+	0x46, 0x45, 0x43, 0x55, 0x6e, 0x63, 0x6f, 0x72, 0x72, 0x65, 0x63, 0x74, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x53, 0x0a, 0x11, 0x52, 0x44, 0x4d, 0x41, 0x52, 0x65, 0x74, 0x72, 0x79, 0x45, 0x78, 0x63,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78,
 	0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49,
-	0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x4e,
-	0x49, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x0a, 0x49, 0x46, 0x4f, 0x45,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65,
-	0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x49,
-	0x46, 0x4f, 0x45, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
-	0x0a, 0x49, 0x46, 0x4f, 0x45, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2a, 0xfb, 0x01, 0x0a, 0x0b,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x0c, 0x43,
-	0x4c, 0x55, 0x53, 0x54, 0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a,
-	0x08, 0x48, 0x4f, 0x53, 0x54, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53,
-	0x45, 0x52, 0x49, 0x41, 0x4c, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x07,
-	0x0a, 0x03, 0x50, 0x4f, 0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x41, 0x4d, 0x45, 0x53,
-	0x50, 0x41, 0x43, 0x45, 0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49,
-	0x4e, 0x45, 0x52, 0x10, 0x05, 0x12, 0x0a, 0x0a, 0x06, 0x4a, 0x4f, 0x42, 0x5f, 0x49, 0x44, 0x10,
-	0x06, 0x12, 0x0c, 0x0a, 0x08, 0x4a, 0x4f, 0x42, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x07, 0x12,
-	0x11, 0x0a, 0x0d, 0x4a, 0x4f, 0x42, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e,
-	0x10, 0x08, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45,
-	0x53, 0x10, 0x09, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45,
-	0x4c, 0x10, 0x0a, 0x12, 0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x56, 0x45, 0x4e, 0x44,
-	0x4f, 0x52, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x56,
-	0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x42, 0x49, 0x4f,
-	0x53, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0d, 0x12, 0x0c, 0x0a, 0x08, 0x50,
-	0x4f, 0x44, 0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x0e, 0x2a, 0xe0, 0x2e, 0x0a, 0x0e, 0x47, 0x50,
-	0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x13, 0x0a, 0x0f,
-	0x47, 0x50, 0x55, 0x5f, 0x4e, 0x4f, 0x44, 0x45, 0x53, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10,
-	0x00, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45,
-	0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f,
-	0x41, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f,
-	0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45,
-	0x44, 0x47, 0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10,
-	0x03, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x4a, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x04, 0x12,
-	0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x45,
-	0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x47,
-	0x50, 0x55, 0x5f, 0x48, 0x42, 0x4d, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55,
-	0x52, 0x45, 0x10, 0x06, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f,
-	0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50,
-	0x55, 0x5f, 0x55, 0x4d, 0x43, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x08,
-	0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x4d, 0x41, 0x5f, 0x41, 0x43, 0x54, 0x49,
-	0x56, 0x49, 0x54, 0x59, 0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x43,
-	0x4e, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11,
-	0x47, 0x50, 0x55, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54,
-	0x59, 0x10, 0x0b, 0x12, 0x0f, 0x0a, 0x0b, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41,
-	0x47, 0x45, 0x10, 0x0c, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f,
-	0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10, 0x0d, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55,
-	0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10,
-	0x0e, 0x12, 0x0e, 0x0a, 0x0a, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10,
-	0x0f, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x53, 0x50,
-	0x45, 0x45, 0x44, 0x10, 0x10, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x42, 0x41,
-	0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x11, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55,
-	0x5f, 0x45, 0x4e, 0x45, 0x52, 0x47, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x45, 0x44,
-	0x10, 0x12, 0x12, 0x15, 0x0a, 0x11, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41,
-	0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x13, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x43, 0x49,
-	0x45, 0x5f, 0x52, 0x45, 0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54,
-	0x10, 0x14, 0x12, 0x1e, 0x0a, 0x1a, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41,
-	0x59, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54,
-	0x10, 0x15, 0x12, 0x18, 0x0a, 0x14, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x4e, 0x41, 0x43, 0x4b, 0x5f,
-	0x53, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x16, 0x12, 0x1c, 0x0a, 0x18,
-	0x50, 0x43, 0x49, 0x45, 0x5f, 0x4e, 0x41, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56,
-	0x45, 0x44, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x17, 0x12, 0x0d, 0x0a, 0x09, 0x47, 0x50,
-	0x55, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x18, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x55, 0x53, 0x41, 0x47, 0x45, 0x10, 0x19, 0x12, 0x12,
-	0x0a, 0x0e, 0x47, 0x50, 0x55, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56, 0x52, 0x41, 0x4d,
-	0x10, 0x1a, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f,
-	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x1b, 0x12, 0x1b, 0x0a,
-	0x17, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45,
-	0x43, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x1c, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50,
-	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x44,
-	0x4d, 0x41, 0x10, 0x1d, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
-	0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x44, 0x4d, 0x41, 0x10, 0x1e,
-	0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52,
-	0x45, 0x43, 0x54, 0x5f, 0x47, 0x46, 0x58, 0x10, 0x1f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55,
-	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x47,
-	0x46, 0x58, 0x10, 0x20, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
-	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x4d, 0x48, 0x55, 0x42, 0x10, 0x21, 0x12,
-	0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52,
-	0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x4d, 0x48, 0x55, 0x42, 0x10, 0x22, 0x12, 0x19, 0x0a, 0x15,
-	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
-	0x41, 0x54, 0x48, 0x55, 0x42, 0x10, 0x23, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x45,
-	0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x48,
-	0x55, 0x42, 0x10, 0x24, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
-	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x42, 0x49, 0x46, 0x10, 0x25, 0x12, 0x19, 0x0a,
-	0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45,
-	0x43, 0x54, 0x5f, 0x42, 0x49, 0x46, 0x10, 0x26, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f,
-	0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x48, 0x44, 0x50, 0x10,
-	0x27, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43,
-	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x48, 0x44, 0x50, 0x10, 0x28, 0x12, 0x1d, 0x0a, 0x19,
+	0x43, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x11, 0x52, 0x44, 0x4d, 0x41, 0x52, 0x65, 0x74, 0x72, 0x79, 0x45, 0x78, 0x63,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x12, 0x57, 0x0a, 0x13, 0x52, 0x44, 0x4d, 0x41, 0x4c, 0x6f, 0x63,
+	0x61, 0x6c, 0x41, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x13, 0x52, 0x44, 0x4d, 0x41, 0x4c,
+	0x6f, 0x63, 0x61, 0x6c, 0x41, 0x63, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x4b,
+	0x0a, 0x0d, 0x50, 0x46, 0x43, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72,
+	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x48, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x0d, 0x50, 0x46,
+	0x43, 0x50, 0x61, 0x75, 0x73, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x6d, 0x22, 0x4c, 0x0a, 0x14, 0x4e,
+	0x49, 0x43, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x52,
+	0x75, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0xb4, 0x08, 0x0a, 0x10, 0x49, 0x46,
+	0x4f, 0x45, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x57,
+	0x0a, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x49, 0x46, 0x4f, 0x45, 0x4d, 0x65, 0x74, 0x72, 0x69,
+	0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0c, 0x43, 0x75, 0x73, 0x74, 0x6f,
+	0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x5d, 0x0a, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61,
+	0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x35, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x49, 0x46, 0x4f, 0x45, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64,
+	0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x6c, 0x0a, 0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50,
+	0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x49, 0x46, 0x4f, 0x45, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x41,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x13, 0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x6f, 0x0a, 0x14, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x3b, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x2e, 0x49, 0x46, 0x4f, 0x45, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x14, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x73, 0x12, 0x5d, 0x0a, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4b, 0x69,
+	0x6e, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
+	0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e,
+	0x49, 0x46, 0x4f, 0x45, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x0e, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x4c,
+	0x61, 0x62, 0x65, 0x6c, 0x12, 0x54, 0x0a, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68,
+	0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x73, 0x2e, 0x49, 0x46, 0x4f, 0x45, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43, 0x68, 0x65, 0x63,
+	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x11, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2a, 0x0a, 0x10, 0x4c, 0x65,
+	0x67, 0x61, 0x63, 0x79, 0x46, 0x45, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x46, 0x45, 0x43, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x49, 0x6e, 0x66, 0x6f, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x49, 0x6e, 0x66,
+	0x6f, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x1a, 0x3f, 0x0a, 0x11, 0x43, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a, 0x13, 0x45, 0x78, 0x74,
+	0x72, 0x61, 0x50, 0x6f, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x46, 0x0a, 0x18,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x50, 0x6f, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x1a, 0x47, 0x0a, 0x19, 0x45, 0x78, 0x74, 0x72, 0x61, 0x4e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x41, 0x0a,
+	0x13, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4b, 0x69, 0x6e, 0x64, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0xad, 0x02, 0x0a, 0x15, 0x49, 0x46, 0x4f, 0x45, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x34, 0x0a, 0x15, 0x42, 0x69,
+	0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68,
+	0x6f, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x42, 0x69, 0x74, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x52, 0x61, 0x74, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x12, 0x38, 0x0a, 0x17, 0x46, 0x45, 0x43, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x17, 0x46, 0x45, 0x43, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x45, 0x72, 0x72, 0x6f,
+	0x72, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x2c, 0x0a, 0x11, 0x46, 0x45,
+	0x43, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x46, 0x45, 0x43, 0x53, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x42, 0x69, 0x6e, 0x12, 0x3e, 0x0a, 0x1a, 0x46, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x54, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1a, 0x46, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x6f, 0x76, 0x65, 0x72, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x54,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x36, 0x0a, 0x16, 0x50, 0x61, 0x75, 0x73,
+	0x65, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f,
+	0x6c, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x50, 0x61, 0x75, 0x73, 0x65, 0x64,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x73, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64,
+	0x22, 0xb4, 0x02, 0x0a, 0x0c, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x50, 0x6f, 0x72,
+	0x74, 0x12, 0x3e, 0x0a, 0x09, 0x47, 0x50, 0x55, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x47, 0x50, 0x55, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x41, 0x0a, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0c, 0x43, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x3e, 0x0a, 0x09, 0x4e, 0x49, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x4e, 0x49, 0x43, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x41, 0x0a, 0x0a, 0x49, 0x46, 0x4f, 0x45, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x65, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x2e, 0x49, 0x46, 0x4f, 0x45, 0x4d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x49, 0x46, 0x4f,
+	0x45, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2a, 0xfb, 0x01, 0x0a, 0x0b, 0x4d, 0x65, 0x74, 0x72,
+	0x69, 0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x10, 0x0a, 0x0c, 0x43, 0x4c, 0x55, 0x53, 0x54,
+	0x45, 0x52, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x48, 0x4f, 0x53,
+	0x54, 0x4e, 0x41, 0x4d, 0x45, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d, 0x53, 0x45, 0x52, 0x49, 0x41,
+	0x4c, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x10, 0x02, 0x12, 0x07, 0x0a, 0x03, 0x50, 0x4f,
+	0x44, 0x10, 0x03, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x41, 0x4d, 0x45, 0x53, 0x50, 0x41, 0x43, 0x45,
+	0x10, 0x04, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x10,
+	0x05, 0x12, 0x0a, 0x0a, 0x06, 0x4a, 0x4f, 0x42, 0x5f, 0x49, 0x44, 0x10, 0x06, 0x12, 0x0c, 0x0a,
+	0x08, 0x4a, 0x4f, 0x42, 0x5f, 0x55, 0x53, 0x45, 0x52, 0x10, 0x07, 0x12, 0x11, 0x0a, 0x0d, 0x4a,
+	0x4f, 0x42, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x0f,
+	0x0a, 0x0b, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x53, 0x45, 0x52, 0x49, 0x45, 0x53, 0x10, 0x09, 0x12,
+	0x0e, 0x0a, 0x0a, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x4c, 0x10, 0x0a, 0x12,
+	0x0f, 0x0a, 0x0b, 0x43, 0x41, 0x52, 0x44, 0x5f, 0x56, 0x45, 0x4e, 0x44, 0x4f, 0x52, 0x10, 0x0b,
+	0x12, 0x12, 0x0a, 0x0e, 0x44, 0x52, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49,
+	0x4f, 0x4e, 0x10, 0x0c, 0x12, 0x11, 0x0a, 0x0d, 0x56, 0x42, 0x49, 0x4f, 0x53, 0x5f, 0x56, 0x45,
+	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x0d, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x4f, 0x44, 0x5f, 0x55,
+	0x55, 0x49, 0x44, 0x10, 0x0e, 0x2a, 0xde, 0x32, 0x0a, 0x0e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f,
+	0x4e, 0x4f, 0x44, 0x45, 0x53, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x4f, 0x57,
+	0x45, 0x52, 0x10, 0x01, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x41, 0x56, 0x45, 0x52,
+	0x41, 0x47, 0x45, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41, 0x47, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45,
+	0x52, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x44, 0x47, 0x45, 0x5f,
+	0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x03, 0x12, 0x1c, 0x0a,
+	0x18, 0x47, 0x50, 0x55, 0x5f, 0x4a, 0x55, 0x4e, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x45,
+	0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x04, 0x12, 0x1a, 0x0a, 0x16, 0x47,
+	0x50, 0x55, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52,
+	0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x48,
+	0x42, 0x4d, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x06,
+	0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x41, 0x43, 0x54, 0x49,
+	0x56, 0x49, 0x54, 0x59, 0x10, 0x07, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x4d,
+	0x43, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x08, 0x12, 0x14, 0x0a, 0x10,
+	0x47, 0x50, 0x55, 0x5f, 0x4d, 0x4d, 0x41, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59,
+	0x10, 0x09, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x43, 0x4e, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x0a, 0x12, 0x15, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f,
+	0x4a, 0x50, 0x45, 0x47, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x0b, 0x12,
+	0x0f, 0x0a, 0x0b, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10, 0x0c,
+	0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x56, 0x4f, 0x4c, 0x54,
+	0x41, 0x47, 0x45, 0x10, 0x0d, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x45, 0x4d,
+	0x4f, 0x52, 0x59, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45, 0x10, 0x0e, 0x12, 0x0e, 0x0a,
+	0x0a, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x12, 0x0a,
+	0x0e, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10,
+	0x10, 0x12, 0x12, 0x0a, 0x0e, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49,
+	0x44, 0x54, 0x48, 0x10, 0x11, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x4e, 0x45,
+	0x52, 0x47, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x55, 0x4d, 0x45, 0x44, 0x10, 0x12, 0x12, 0x15,
+	0x0a, 0x11, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x43, 0x4f,
+	0x55, 0x4e, 0x54, 0x10, 0x13, 0x12, 0x17, 0x0a, 0x13, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x45,
+	0x43, 0x4f, 0x56, 0x45, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x14, 0x12, 0x1e,
+	0x0a, 0x1a, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x41, 0x59, 0x5f, 0x52, 0x4f,
+	0x4c, 0x4c, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x15, 0x12, 0x18,
+	0x0a, 0x14, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x4e, 0x41, 0x43, 0x4b, 0x5f, 0x53, 0x45, 0x4e, 0x54,
+	0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0x16, 0x12, 0x1c, 0x0a, 0x18, 0x50, 0x43, 0x49, 0x45,
+	0x5f, 0x4e, 0x41, 0x43, 0x4b, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x5f, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x10, 0x17, 0x12, 0x0d, 0x0a, 0x09, 0x47, 0x50, 0x55, 0x5f, 0x43, 0x4c,
+	0x4f, 0x43, 0x4b, 0x10, 0x18, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x4f, 0x57,
+	0x45, 0x52, 0x5f, 0x55, 0x53, 0x41, 0x47, 0x45, 0x10, 0x19, 0x12, 0x12, 0x0a, 0x0e, 0x47, 0x50,
+	0x55, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x1a, 0x12, 0x19,
+	0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
+	0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x1b, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55,
+	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x54,
+	0x4f, 0x54, 0x41, 0x4c, 0x10, 0x1c, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
+	0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x44, 0x4d, 0x41, 0x10, 0x1d,
+	0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f,
+	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x44, 0x4d, 0x41, 0x10, 0x1e, 0x12, 0x17, 0x0a, 0x13,
 	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
-	0x58, 0x47, 0x4d, 0x49, 0x5f, 0x57, 0x41, 0x46, 0x4c, 0x10, 0x29, 0x12, 0x1f, 0x0a, 0x1b, 0x47,
+	0x47, 0x46, 0x58, 0x10, 0x1f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
+	0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x47, 0x46, 0x58, 0x10, 0x20,
+	0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52,
+	0x45, 0x43, 0x54, 0x5f, 0x4d, 0x4d, 0x48, 0x55, 0x42, 0x10, 0x21, 0x12, 0x1b, 0x0a, 0x17, 0x47,
 	0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
-	0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x57, 0x41, 0x46, 0x4c, 0x10, 0x2a, 0x12, 0x16, 0x0a, 0x12,
-	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f,
-	0x44, 0x46, 0x10, 0x2b, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
-	0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x46, 0x10, 0x2c, 0x12, 0x17,
+	0x5f, 0x4d, 0x4d, 0x48, 0x55, 0x42, 0x10, 0x22, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f,
+	0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x48, 0x55,
+	0x42, 0x10, 0x23, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55,
+	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x41, 0x54, 0x48, 0x55, 0x42, 0x10, 0x24,
+	0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52,
+	0x45, 0x43, 0x54, 0x5f, 0x42, 0x49, 0x46, 0x10, 0x25, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55,
+	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x42,
+	0x49, 0x46, 0x10, 0x26, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
+	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x48, 0x44, 0x50, 0x10, 0x27, 0x12, 0x19, 0x0a,
+	0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45,
+	0x43, 0x54, 0x5f, 0x48, 0x44, 0x50, 0x10, 0x28, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f,
+	0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x58, 0x47, 0x4d, 0x49,
+	0x5f, 0x57, 0x41, 0x46, 0x4c, 0x10, 0x29, 0x12, 0x1f, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x45,
+	0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x58, 0x47, 0x4d,
+	0x49, 0x5f, 0x57, 0x41, 0x46, 0x4c, 0x10, 0x2a, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f,
+	0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x46, 0x10, 0x2b,
+	0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f,
+	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x44, 0x46, 0x10, 0x2c, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50,
+	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x4d,
+	0x4e, 0x10, 0x2d, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55,
+	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x4d, 0x4e, 0x10, 0x2e, 0x12, 0x17,
 	0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
-	0x54, 0x5f, 0x53, 0x4d, 0x4e, 0x10, 0x2d, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45,
-	0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x4d, 0x4e,
-	0x10, 0x2e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f,
-	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x45, 0x4d, 0x10, 0x2f, 0x12, 0x19, 0x0a, 0x15, 0x47,
+	0x54, 0x5f, 0x53, 0x45, 0x4d, 0x10, 0x2f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45,
+	0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x53, 0x45, 0x4d,
+	0x10, 0x30, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f,
+	0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x30, 0x10, 0x31, 0x12, 0x19, 0x0a, 0x15, 0x47,
 	0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
-	0x5f, 0x53, 0x45, 0x4d, 0x10, 0x30, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
-	0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x30, 0x10, 0x31, 0x12,
+	0x5f, 0x4d, 0x50, 0x30, 0x10, 0x32, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
+	0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x31, 0x10, 0x33, 0x12,
 	0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52,
-	0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x30, 0x10, 0x32, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50,
-	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50,
-	0x31, 0x10, 0x33, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55,
-	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x31, 0x10, 0x34, 0x12, 0x18,
-	0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43,
-	0x54, 0x5f, 0x46, 0x55, 0x53, 0x45, 0x10, 0x35, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f,
-	0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x55,
-	0x53, 0x45, 0x10, 0x36, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
-	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x55, 0x4d, 0x43, 0x10, 0x37, 0x12, 0x19, 0x0a,
-	0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45,
-	0x43, 0x54, 0x5f, 0x55, 0x4d, 0x43, 0x10, 0x38, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f,
-	0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x4e, 0x4f, 0x50, 0x5f, 0x54,
-	0x58, 0x10, 0x39, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f,
-	0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x10, 0x3a, 0x12, 0x1a,
-	0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30,
-	0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x3b, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50,
-	0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x42, 0x45, 0x41,
-	0x54, 0x53, 0x5f, 0x54, 0x58, 0x10, 0x3c, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58,
-	0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x4e, 0x4f, 0x50, 0x5f, 0x54, 0x58,
-	0x10, 0x3d, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e,
-	0x42, 0x52, 0x5f, 0x31, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x10, 0x3e, 0x12, 0x1a, 0x0a,
-	0x16, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f,
-	0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x3f, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55,
-	0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x42, 0x45, 0x41, 0x54,
-	0x53, 0x5f, 0x54, 0x58, 0x10, 0x40, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47,
-	0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50,
-	0x55, 0x54, 0x10, 0x41, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49,
-	0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54,
-	0x10, 0x42, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e,
-	0x42, 0x52, 0x5f, 0x32, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x43,
+	0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x31, 0x10, 0x34, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50,
+	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x55,
+	0x53, 0x45, 0x10, 0x35, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
+	0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x46, 0x55, 0x53, 0x45, 0x10, 0x36,
+	0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52,
+	0x45, 0x43, 0x54, 0x5f, 0x55, 0x4d, 0x43, 0x10, 0x37, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55,
+	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x55,
+	0x4d, 0x43, 0x10, 0x38, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49,
+	0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x4e, 0x4f, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x39, 0x12,
+	0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f,
+	0x30, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x10, 0x3a, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50,
+	0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x52, 0x45, 0x53,
+	0x50, 0x5f, 0x54, 0x58, 0x10, 0x3b, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47,
+	0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x30, 0x5f, 0x42, 0x45, 0x41, 0x54, 0x53, 0x5f, 0x54,
+	0x58, 0x10, 0x3c, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f,
+	0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x4e, 0x4f, 0x50, 0x5f, 0x54, 0x58, 0x10, 0x3d, 0x12, 0x19,
+	0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31,
+	0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x10, 0x3e, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55,
+	0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x52, 0x45, 0x53, 0x50,
+	0x5f, 0x54, 0x58, 0x10, 0x3f, 0x12, 0x1b, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d,
+	0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x31, 0x5f, 0x42, 0x45, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58,
+	0x10, 0x40, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e,
+	0x42, 0x52, 0x5f, 0x30, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x41,
 	0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52,
-	0x5f, 0x33, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x44, 0x12, 0x1c,
-	0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x34,
-	0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x45, 0x12, 0x1c, 0x0a, 0x18,
-	0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x35, 0x5f, 0x54,
-	0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x46, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50,
-	0x55, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x47, 0x12, 0x11, 0x0a,
-	0x0d, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x48,
-	0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56, 0x49,
-	0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x49, 0x12, 0x19, 0x0a, 0x15,
-	0x47, 0x50, 0x55, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45,
-	0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x4a, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x46,
-	0x52, 0x45, 0x45, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d,
-	0x10, 0x4b, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f,
-	0x47, 0x54, 0x54, 0x10, 0x4c, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53, 0x45,
-	0x44, 0x5f, 0x47, 0x54, 0x54, 0x10, 0x4d, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x50, 0x55, 0x5f, 0x46,
-	0x52, 0x45, 0x45, 0x5f, 0x47, 0x54, 0x54, 0x10, 0x4e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55,
-	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x43, 0x41,
-	0x10, 0x4f, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e,
-	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x43, 0x41, 0x10, 0x50, 0x12, 0x17, 0x0a,
-	0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
-	0x5f, 0x56, 0x43, 0x4e, 0x10, 0x51, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
-	0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x56, 0x43, 0x4e, 0x10,
-	0x52, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52,
-	0x52, 0x45, 0x43, 0x54, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x53, 0x12, 0x1a, 0x0a, 0x16, 0x47,
+	0x5f, 0x31, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x42, 0x12, 0x1c,
+	0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x32,
+	0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x43, 0x12, 0x1c, 0x0a, 0x18,
+	0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x33, 0x5f, 0x54,
+	0x58, 0x5f, 0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x44, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50,
+	0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x34, 0x5f, 0x54, 0x58, 0x5f,
+	0x54, 0x48, 0x52, 0x50, 0x55, 0x54, 0x10, 0x45, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f,
+	0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4e, 0x42, 0x52, 0x5f, 0x35, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x48,
+	0x52, 0x50, 0x55, 0x54, 0x10, 0x46, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53,
+	0x45, 0x44, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x47, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55,
+	0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x48, 0x12, 0x1a, 0x0a, 0x16,
+	0x47, 0x50, 0x55, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c,
+	0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x49, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f,
+	0x55, 0x53, 0x45, 0x44, 0x5f, 0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52, 0x41,
+	0x4d, 0x10, 0x4a, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f,
+	0x56, 0x49, 0x53, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x4b, 0x12, 0x11,
+	0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x47, 0x54, 0x54, 0x10,
+	0x4c, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x47, 0x54,
+	0x54, 0x10, 0x4d, 0x12, 0x10, 0x0a, 0x0c, 0x47, 0x50, 0x55, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5f,
+	0x47, 0x54, 0x54, 0x10, 0x4e, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
+	0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x43, 0x41, 0x10, 0x4f, 0x12, 0x19,
+	0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52,
+	0x45, 0x43, 0x54, 0x5f, 0x4d, 0x43, 0x41, 0x10, 0x50, 0x12, 0x17, 0x0a, 0x13, 0x47, 0x50, 0x55,
+	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x56, 0x43, 0x4e,
+	0x10, 0x51, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e,
+	0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x56, 0x43, 0x4e, 0x10, 0x52, 0x12, 0x18, 0x0a,
+	0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
+	0x5f, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x53, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45,
+	0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4a, 0x50, 0x45,
+	0x47, 0x10, 0x54, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43,
+	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x48, 0x10, 0x55, 0x12, 0x18, 0x0a, 0x14, 0x47,
 	0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
-	0x5f, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x54, 0x12, 0x16, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x45,
-	0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x48, 0x10, 0x55, 0x12,
-	0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52,
-	0x52, 0x45, 0x43, 0x54, 0x5f, 0x49, 0x48, 0x10, 0x56, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55,
-	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x49,
-	0x4f, 0x10, 0x57, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55,
-	0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x49, 0x4f, 0x10, 0x58, 0x12,
-	0x0e, 0x0a, 0x0a, 0x47, 0x50, 0x55, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x10, 0x59, 0x12,
-	0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b,
-	0x5f, 0x52, 0x58, 0x10, 0x5a, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d,
-	0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x54, 0x58, 0x10, 0x5b, 0x12, 0x2d, 0x0a, 0x29, 0x47,
-	0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x52,
-	0x52, 0x45, 0x4e, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44,
-	0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x5c, 0x12, 0x35, 0x0a, 0x31, 0x47, 0x50,
-	0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x43,
-	0x45, 0x53, 0x53, 0x4f, 0x52, 0x5f, 0x48, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45,
-	0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10,
-	0x5d, 0x12, 0x2b, 0x0a, 0x27, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x50, 0x50, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59,
-	0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x5e, 0x12, 0x36,
-	0x0a, 0x32, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
-	0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52,
+	0x5f, 0x49, 0x48, 0x10, 0x56, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
+	0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x49, 0x4f, 0x10, 0x57, 0x12,
+	0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52,
+	0x52, 0x45, 0x43, 0x54, 0x5f, 0x4d, 0x50, 0x49, 0x4f, 0x10, 0x58, 0x12, 0x0e, 0x0a, 0x0a, 0x47,
+	0x50, 0x55, 0x5f, 0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x10, 0x59, 0x12, 0x14, 0x0a, 0x10, 0x47,
+	0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x52, 0x58, 0x10,
+	0x5a, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x4c, 0x49,
+	0x4e, 0x4b, 0x5f, 0x54, 0x58, 0x10, 0x5b, 0x12, 0x2d, 0x0a, 0x29, 0x47, 0x50, 0x55, 0x5f, 0x56,
+	0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e, 0x54,
+	0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x5f, 0x43, 0x4f, 0x55,
+	0x4e, 0x54, 0x45, 0x52, 0x10, 0x5c, 0x12, 0x35, 0x0a, 0x31, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49,
+	0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x4f,
+	0x52, 0x5f, 0x48, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f,
+	0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x5d, 0x12, 0x2b, 0x0a,
+	0x27, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50,
+	0x50, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43,
+	0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x5e, 0x12, 0x36, 0x0a, 0x32, 0x47, 0x50,
+	0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x43, 0x4b,
+	0x45, 0x54, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44,
+	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44,
+	0x10, 0x5f, 0x12, 0x32, 0x0a, 0x2e, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52,
 	0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c,
-	0x41, 0x54, 0x45, 0x44, 0x10, 0x5f, 0x12, 0x32, 0x0a, 0x2e, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49,
-	0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d,
-	0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43, 0x43,
-	0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x60, 0x12, 0x33, 0x0a, 0x2f, 0x47, 0x50,
-	0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x42, 0x4d, 0x5f,
-	0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43,
-	0x59, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x61, 0x12,
-	0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f,
-	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x62, 0x12,
-	0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x43, 0x4e, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f,
-	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x63, 0x12,
-	0x1f, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x5f, 0x42, 0x55, 0x53, 0x59,
-	0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x64,
-	0x12, 0x0b, 0x0a, 0x07, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x58, 0x10, 0x65, 0x12, 0x0b, 0x0a,
-	0x07, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x54, 0x58, 0x10, 0x66, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x43,
-	0x49, 0x45, 0x5f, 0x42, 0x49, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c,
-	0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x67, 0x12, 0x13, 0x0a, 0x0f,
-	0x47, 0x50, 0x55, 0x5f, 0x41, 0x46, 0x49, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10,
-	0x68, 0x12, 0x34, 0x0a, 0x30, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x4f, 0x52, 0x5f, 0x48, 0x4f, 0x54,
-	0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45,
-	0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x69, 0x12, 0x2a, 0x0a, 0x26, 0x47, 0x50, 0x55, 0x5f, 0x56,
-	0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x50, 0x54, 0x5f, 0x52, 0x45, 0x53,
+	0x41, 0x54, 0x45, 0x44, 0x10, 0x60, 0x12, 0x33, 0x0a, 0x2f, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49,
+	0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x42, 0x4d, 0x5f, 0x54, 0x48, 0x45, 0x52,
+	0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x41, 0x43,
+	0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x61, 0x12, 0x1e, 0x0a, 0x1a, 0x47,
+	0x50, 0x55, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54,
+	0x41, 0x4e, 0x54, 0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x62, 0x12, 0x1e, 0x0a, 0x1a, 0x47,
+	0x50, 0x55, 0x5f, 0x56, 0x43, 0x4e, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x49, 0x4e, 0x53, 0x54,
+	0x41, 0x4e, 0x54, 0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x63, 0x12, 0x1f, 0x0a, 0x1b, 0x47,
+	0x50, 0x55, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x49, 0x4e, 0x53,
+	0x54, 0x41, 0x4e, 0x54, 0x41, 0x4e, 0x45, 0x4f, 0x55, 0x53, 0x10, 0x64, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x43, 0x49, 0x45, 0x5f, 0x52, 0x58, 0x10, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x43, 0x49,
+	0x45, 0x5f, 0x54, 0x58, 0x10, 0x66, 0x12, 0x20, 0x0a, 0x1c, 0x50, 0x43, 0x49, 0x45, 0x5f, 0x42,
+	0x49, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x41, 0x4c, 0x5f, 0x42, 0x41, 0x4e,
+	0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x67, 0x12, 0x13, 0x0a, 0x0f, 0x47, 0x50, 0x55, 0x5f,
+	0x41, 0x46, 0x49, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10, 0x68, 0x12, 0x34, 0x0a,
+	0x30, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50,
+	0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x4f, 0x52, 0x5f, 0x48, 0x4f, 0x54, 0x5f, 0x52, 0x45, 0x53,
 	0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47,
-	0x45, 0x10, 0x6a, 0x12, 0x35, 0x0a, 0x31, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x48, 0x45, 0x52,
-	0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45,
-	0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x6b, 0x12, 0x31, 0x0a, 0x2d, 0x47, 0x50,
-	0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x52, 0x5f, 0x54,
+	0x45, 0x10, 0x69, 0x12, 0x2a, 0x0a, 0x26, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x50, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e,
+	0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x6a, 0x12,
+	0x35, 0x0a, 0x31, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x4f, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f,
+	0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e,
+	0x54, 0x41, 0x47, 0x45, 0x10, 0x6b, 0x12, 0x31, 0x0a, 0x2d, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49,
+	0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x56, 0x52, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d,
+	0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52,
+	0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x6c, 0x12, 0x32, 0x0a, 0x2e, 0x47, 0x50, 0x55,
+	0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48, 0x42, 0x4d, 0x5f, 0x54,
 	0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44, 0x45, 0x4e, 0x43, 0x59,
-	0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x6c, 0x12, 0x32, 0x0a,
-	0x2e, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x48,
-	0x42, 0x4d, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x53, 0x49, 0x44,
-	0x45, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10,
-	0x6d, 0x12, 0x3e, 0x0a, 0x3a, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45, 0x4c,
-	0x4f, 0x57, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x4f,
-	0x57, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10,
-	0x6e, 0x12, 0x40, 0x0a, 0x3c, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45, 0x4c,
-	0x4f, 0x57, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x48,
-	0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45,
-	0x44, 0x10, 0x6f, 0x12, 0x2d, 0x0a, 0x29, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0x70, 0x12, 0x3e, 0x0a, 0x3a, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45,
-	0x4c, 0x4f, 0x57, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x54,
-	0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44,
-	0x10, 0x71, 0x12, 0x3d, 0x0a, 0x39, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45,
-	0x4c, 0x4f, 0x57, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x50,
-	0x4f, 0x57, 0x45, 0x52, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10,
-	0x72, 0x12, 0x3f, 0x0a, 0x3b, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45, 0x4c,
-	0x4f, 0x57, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x48,
-	0x45, 0x52, 0x4d, 0x41, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45,
-	0x10, 0x73, 0x12, 0x2c, 0x0a, 0x28, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54,
-	0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x74,
-	0x12, 0x3d, 0x0a, 0x39, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f,
-	0x4e, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45, 0x4c, 0x4f,
-	0x57, 0x5f, 0x48, 0x4f, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x4f, 0x54,
-	0x41, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x75, 0x12,
-	0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b,
-	0x10, 0x76, 0x12, 0x11, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x49, 0x4e, 0x5f, 0x43, 0x4c,
-	0x4f, 0x43, 0x4b, 0x10, 0x77, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x43, 0x45, 0x53, 0x53, 0x5f, 0x43, 0x55, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43,
-	0x59, 0x10, 0x78, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x5f,
-	0x4d, 0x41, 0x58, 0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x79, 0x12,
-	0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52,
-	0x52, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x7a, 0x12, 0x19, 0x0a, 0x15, 0x47,
+	0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x6d, 0x12, 0x3e, 0x0a,
+	0x3a, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x47,
+	0x46, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x57, 0x5f, 0x48,
+	0x4f, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f,
+	0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x6e, 0x12, 0x40, 0x0a,
+	0x3c, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x47,
+	0x46, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x57, 0x5f, 0x48,
+	0x4f, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41,
+	0x4c, 0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x6f, 0x12,
+	0x2d, 0x0a, 0x29, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4c, 0x4f, 0x57, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x70, 0x12, 0x3e,
+	0x0a, 0x3a, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x47, 0x46, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x57, 0x5f,
+	0x48, 0x4f, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c,
+	0x5f, 0x41, 0x43, 0x43, 0x55, 0x4d, 0x55, 0x4c, 0x41, 0x54, 0x45, 0x44, 0x10, 0x71, 0x12, 0x3d,
+	0x0a, 0x39, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x47, 0x46, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x57, 0x5f,
+	0x48, 0x4f, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52,
+	0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x72, 0x12, 0x3f, 0x0a,
+	0x3b, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x47,
+	0x46, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x57, 0x5f, 0x48,
+	0x4f, 0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x48, 0x45, 0x52, 0x4d, 0x41,
+	0x4c, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x73, 0x12, 0x2c,
+	0x0a, 0x28, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x4c, 0x4f, 0x57, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x74, 0x12, 0x3d, 0x0a, 0x39,
+	0x47, 0x50, 0x55, 0x5f, 0x56, 0x49, 0x4f, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x47, 0x46,
+	0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x42, 0x45, 0x4c, 0x4f, 0x57, 0x5f, 0x48, 0x4f,
+	0x53, 0x54, 0x5f, 0x4c, 0x49, 0x4d, 0x49, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x50,
+	0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x41, 0x47, 0x45, 0x10, 0x75, 0x12, 0x11, 0x0a, 0x0d, 0x47,
+	0x50, 0x55, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x10, 0x76, 0x12, 0x11,
+	0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x49, 0x4e, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x10,
+	0x77, 0x12, 0x1c, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53,
+	0x5f, 0x43, 0x55, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x10, 0x78, 0x12,
+	0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x5f, 0x4d, 0x41, 0x58, 0x5f,
+	0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x79, 0x12, 0x1a, 0x0a, 0x16, 0x47,
 	0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f,
-	0x53, 0x44, 0x4d, 0x41, 0x10, 0x7b, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
-	0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x47, 0x46, 0x58, 0x10, 0x7c,
-	0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45,
-	0x52, 0x52, 0x45, 0x44, 0x5f, 0x4d, 0x4d, 0x48, 0x55, 0x42, 0x10, 0x7d, 0x12, 0x1a, 0x0a, 0x16,
+	0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x7a, 0x12, 0x19, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45,
+	0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x53, 0x44, 0x4d, 0x41,
+	0x10, 0x7b, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45,
+	0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x47, 0x46, 0x58, 0x10, 0x7c, 0x12, 0x1a, 0x0a, 0x16,
 	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44,
-	0x5f, 0x41, 0x54, 0x48, 0x55, 0x42, 0x10, 0x7e, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f,
-	0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x42, 0x49, 0x46,
-	0x10, 0x7f, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45,
-	0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x48, 0x44, 0x50, 0x10, 0x80, 0x01, 0x12, 0x1f, 0x0a,
-	0x1a, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45,
-	0x44, 0x5f, 0x58, 0x47, 0x4d, 0x49, 0x5f, 0x57, 0x41, 0x46, 0x4c, 0x10, 0x81, 0x01, 0x12, 0x18,
-	0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52,
-	0x45, 0x44, 0x5f, 0x44, 0x46, 0x10, 0x82, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f,
-	0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x53, 0x4d, 0x4e,
-	0x10, 0x83, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44,
-	0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x4d, 0x10, 0x84, 0x01, 0x12, 0x19,
+	0x5f, 0x4d, 0x4d, 0x48, 0x55, 0x42, 0x10, 0x7d, 0x12, 0x1a, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f,
+	0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x41, 0x54, 0x48,
+	0x55, 0x42, 0x10, 0x7e, 0x12, 0x18, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
+	0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x42, 0x49, 0x46, 0x10, 0x7f, 0x12, 0x19,
 	0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52,
-	0x45, 0x44, 0x5f, 0x4d, 0x50, 0x30, 0x10, 0x85, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55,
-	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x4d, 0x50,
-	0x31, 0x10, 0x86, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
-	0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x46, 0x55, 0x53, 0x45, 0x10, 0x87, 0x01,
-	0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45,
-	0x52, 0x52, 0x45, 0x44, 0x5f, 0x55, 0x4d, 0x43, 0x10, 0x88, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x47,
-	0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f,
-	0x4d, 0x43, 0x41, 0x10, 0x89, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43,
-	0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x56, 0x43, 0x4e, 0x10, 0x8a,
-	0x01, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46,
-	0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x8b, 0x01, 0x12, 0x18, 0x0a,
-	0x13, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45,
-	0x44, 0x5f, 0x49, 0x48, 0x10, 0x8c, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45,
-	0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x4d, 0x50, 0x49, 0x4f,
-	0x10, 0x8d, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
-	0x47, 0x52, 0x42, 0x4d, 0x5f, 0x47, 0x55, 0x49, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10,
-	0xa1, 0x06, 0x12, 0x16, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x53,
-	0x51, 0x5f, 0x57, 0x41, 0x56, 0x45, 0x53, 0x10, 0xa2, 0x06, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50,
-	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47, 0x52, 0x42, 0x4d, 0x5f, 0x43, 0x4f, 0x55, 0x4e,
-	0x54, 0x10, 0xa3, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x42, 0x55,
-	0x53, 0x59, 0x10, 0xa4, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x49,
-	0x44, 0x4c, 0x45, 0x10, 0xa5, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
-	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f,
-	0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xa6, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f,
-	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x54, 0x43, 0x49,
-	0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa7, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x54, 0x43,
-	0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xa8, 0x06, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50,
+	0x45, 0x44, 0x5f, 0x48, 0x44, 0x50, 0x10, 0x80, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55,
+	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x58, 0x47,
+	0x4d, 0x49, 0x5f, 0x57, 0x41, 0x46, 0x4c, 0x10, 0x81, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50,
+	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x44,
+	0x46, 0x10, 0x82, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f,
+	0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x53, 0x4d, 0x4e, 0x10, 0x83, 0x01, 0x12,
+	0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52,
+	0x52, 0x45, 0x44, 0x5f, 0x53, 0x45, 0x4d, 0x10, 0x84, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50,
+	0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x4d,
+	0x50, 0x30, 0x10, 0x85, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43,
+	0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x4d, 0x50, 0x31, 0x10, 0x86, 0x01,
+	0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45,
+	0x52, 0x52, 0x45, 0x44, 0x5f, 0x46, 0x55, 0x53, 0x45, 0x10, 0x87, 0x01, 0x12, 0x19, 0x0a, 0x14,
+	0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44,
+	0x5f, 0x55, 0x4d, 0x43, 0x10, 0x88, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45,
+	0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x4d, 0x43, 0x41, 0x10,
+	0x89, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45,
+	0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x56, 0x43, 0x4e, 0x10, 0x8a, 0x01, 0x12, 0x1a, 0x0a,
+	0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45,
+	0x44, 0x5f, 0x4a, 0x50, 0x45, 0x47, 0x10, 0x8b, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55,
+	0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44, 0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x49, 0x48,
+	0x10, 0x8c, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x45, 0x43, 0x43, 0x5f, 0x44,
+	0x45, 0x46, 0x45, 0x52, 0x52, 0x45, 0x44, 0x5f, 0x4d, 0x50, 0x49, 0x4f, 0x10, 0x8d, 0x01, 0x12,
+	0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x55,
+	0x53, 0x45, 0x44, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x8e, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x47,
+	0x50, 0x55, 0x5f, 0x46, 0x49, 0x52, 0x4d, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x49, 0x4e, 0x46, 0x4f,
+	0x10, 0x8f, 0x01, 0x12, 0x12, 0x0a, 0x0d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52,
+	0x5f, 0x43, 0x41, 0x50, 0x10, 0x90, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x50,
+	0x4f, 0x57, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x50, 0x5f, 0x4d, 0x49, 0x4e, 0x10, 0x91, 0x01, 0x12,
+	0x16, 0x0a, 0x11, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x5f, 0x43, 0x41, 0x50,
+	0x5f, 0x4d, 0x41, 0x58, 0x10, 0x92, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x50,
+	0x45, 0x52, 0x46, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x93,
+	0x01, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52,
+	0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d, 0x49, 0x4e, 0x10, 0x94, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x47,
+	0x50, 0x55, 0x5f, 0x43, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x52, 0x41, 0x4e, 0x47, 0x45, 0x5f, 0x4d,
+	0x41, 0x58, 0x10, 0x95, 0x01, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x41, 0x52,
+	0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x55, 0x50, 0x5f, 0x55, 0x53,
+	0x45, 0x44, 0x5f, 0x56, 0x52, 0x41, 0x4d, 0x10, 0x96, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x4c,
+	0x55, 0x50, 0x5f, 0x47, 0x46, 0x58, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f,
+	0x41, 0x56, 0x47, 0x10, 0x97, 0x01, 0x12, 0x2a, 0x0a, 0x25, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x41,
+	0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x55, 0x50, 0x5f, 0x47,
+	0x46, 0x58, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x49, 0x54, 0x59, 0x5f, 0x4d, 0x41, 0x58, 0x10,
+	0x98, 0x01, 0x12, 0x27, 0x0a, 0x22, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x4f, 0x4c, 0x4c, 0x55, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x41,
+	0x47, 0x45, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0x99, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x47,
+	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47, 0x52, 0x42, 0x4d, 0x5f, 0x47, 0x55, 0x49,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0xa1, 0x06, 0x12, 0x16, 0x0a, 0x11, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x53, 0x51, 0x5f, 0x57, 0x41, 0x56, 0x45, 0x53, 0x10,
+	0xa2, 0x06, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47,
+	0x52, 0x42, 0x4d, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xa3, 0x06, 0x12, 0x1f, 0x0a, 0x1a,
+	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa4, 0x06, 0x12, 0x1f, 0x0a,
+	0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50,
+	0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xa5, 0x06, 0x12, 0x20,
+	0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43,
+	0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xa6, 0x06,
+	0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43,
+	0x5f, 0x43, 0x50, 0x43, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa7,
+	0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
+	0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10,
+	0xa8, 0x06, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43,
+	0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x42,
+	0x55, 0x53, 0x59, 0x10, 0xa9, 0x06, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
+	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32,
+	0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xaa, 0x06, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50,
 	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55,
-	0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xa9, 0x06, 0x12, 0x22,
-	0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43,
-	0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10,
-	0xaa, 0x06, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43,
-	0x50, 0x43, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x53,
-	0x54, 0x41, 0x4c, 0x4c, 0x10, 0xab, 0x06, 0x12, 0x2c, 0x0a, 0x27, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4d, 0x45, 0x31, 0x5f, 0x42, 0x55, 0x53, 0x59,
-	0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x44, 0x45, 0x43, 0x4f,
-	0x44, 0x45, 0x10, 0xac, 0x06, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4d, 0x45, 0x31, 0x5f, 0x44, 0x43, 0x30, 0x5f, 0x53, 0x50,
-	0x49, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xad, 0x06, 0x12, 0x2c, 0x0a, 0x27, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x31, 0x5f,
-	0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0xae, 0x06, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x5f, 0x43,
-	0x4f, 0x55, 0x4e, 0x54, 0x10, 0xaf, 0x06, 0x12, 0x2b, 0x0a, 0x26, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x44, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x49,
-	0x44, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x5f, 0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x56, 0x41, 0x49,
-	0x4c, 0x10, 0xb0, 0x06, 0x12, 0x29, 0x0a, 0x24, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x44, 0x43, 0x5f, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43,
-	0x48, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x5f, 0x44, 0x4f, 0x4e, 0x45, 0x10, 0xb1, 0x06, 0x12,
-	0x25, 0x0a, 0x20, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f,
+	0x54, 0x43, 0x4c, 0x32, 0x49, 0x55, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xab, 0x06, 0x12,
+	0x2c, 0x0a, 0x27, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f,
+	0x4d, 0x45, 0x31, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x5f, 0x46, 0x4f, 0x52, 0x5f, 0x50, 0x41, 0x43,
+	0x4b, 0x45, 0x54, 0x5f, 0x44, 0x45, 0x43, 0x4f, 0x44, 0x45, 0x10, 0xac, 0x06, 0x12, 0x22, 0x0a,
+	0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4d, 0x45,
+	0x31, 0x5f, 0x44, 0x43, 0x30, 0x5f, 0x53, 0x50, 0x49, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xad,
+	0x06, 0x12, 0x2c, 0x0a, 0x27, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
+	0x43, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x31, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x4e,
+	0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xae, 0x06, 0x12,
+	0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f,
+	0x41, 0x4c, 0x57, 0x41, 0x59, 0x53, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x10, 0xaf, 0x06, 0x12,
+	0x2b, 0x0a, 0x26, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f,
 	0x41, 0x44, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x5f,
-	0x45, 0x4e, 0x44, 0x10, 0xb2, 0x06, 0x12, 0x26, 0x0a, 0x21, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
+	0x4e, 0x4f, 0x54, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x10, 0xb0, 0x06, 0x12, 0x29, 0x0a, 0x24,
+	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x44, 0x43,
+	0x5f, 0x44, 0x49, 0x53, 0x50, 0x41, 0x54, 0x43, 0x48, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x43, 0x5f,
+	0x44, 0x4f, 0x4e, 0x45, 0x10, 0xb1, 0x06, 0x12, 0x25, 0x0a, 0x20, 0x47, 0x50, 0x55, 0x5f, 0x50,
+	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x41, 0x44, 0x43, 0x5f, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x5f, 0x45, 0x4e, 0x44, 0x10, 0xb2, 0x06, 0x12, 0x26,
+	0x0a, 0x21, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53,
+	0x59, 0x4e, 0x43, 0x5f, 0x46, 0x49, 0x46, 0x4f, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x4c, 0x45,
+	0x56, 0x45, 0x4c, 0x10, 0xb3, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
 	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x46, 0x49, 0x46, 0x4f,
-	0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x5f, 0x4c, 0x45, 0x56, 0x45, 0x4c, 0x10, 0xb3, 0x06, 0x12, 0x20,
-	0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53,
-	0x59, 0x4e, 0x43, 0x5f, 0x46, 0x49, 0x46, 0x4f, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0xb4, 0x06,
-	0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43,
-	0x5f, 0x47, 0x44, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xb5, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47,
-	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x54, 0x47, 0x5f, 0x53,
-	0x45, 0x4e, 0x44, 0x10, 0xb6, 0x06, 0x12, 0x21, 0x0a, 0x1c, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
-	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x57, 0x41, 0x4c, 0x4b, 0x5f, 0x4e, 0x45, 0x58, 0x54,
-	0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x10, 0xb7, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45,
-	0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x30, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xb8, 0x06, 0x12,
-	0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f,
-	0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x31, 0x5f, 0x53,
-	0x50, 0x49, 0x10, 0xb9, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59,
-	0x5f, 0x53, 0x45, 0x32, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xba, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47,
-	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c,
-	0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x33, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xbb,
-	0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
-	0x43, 0x5f, 0x4c, 0x54, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0xbc, 0x06, 0x12, 0x26, 0x0a, 0x21,
-	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x59, 0x4e,
-	0x43, 0x5f, 0x57, 0x52, 0x52, 0x45, 0x51, 0x5f, 0x46, 0x49, 0x46, 0x4f, 0x5f, 0x42, 0x55, 0x53,
-	0x59, 0x10, 0xbd, 0x06, 0x12, 0x1b, 0x0a, 0x16, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x41, 0x4e, 0x45, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xbe,
-	0x06, 0x12, 0x1c, 0x0a, 0x17, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
-	0x43, 0x5f, 0x43, 0x41, 0x4e, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xbf, 0x06, 0x12,
-	0x30, 0x0a, 0x2b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f,
-	0x43, 0x4d, 0x50, 0x5f, 0x55, 0x54, 0x43, 0x4c, 0x31, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f,
-	0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc0,
-	0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
-	0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10,
-	0xc1, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43,
-	0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x49, 0x44, 0x4c, 0x45,
-	0x10, 0xc2, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
-	0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x4c, 0x4c, 0x10, 0xc3, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x42,
-	0x55, 0x53, 0x59, 0x10, 0xc4, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52,
-	0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f,
-	0x49, 0x44, 0x4c, 0x45, 0x10, 0xc5, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x54, 0x43, 0x49, 0x55,
-	0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xc6, 0x06, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x46, 0x45, 0x54, 0x43, 0x48, 0x5f, 0x53, 0x49, 0x5a, 0x45,
-	0x10, 0xe8, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
-	0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x10, 0xe9, 0x07, 0x12, 0x1a, 0x0a,
+	0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0xb4, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f,
+	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x47, 0x44, 0x5f, 0x42, 0x55, 0x53, 0x59,
+	0x10, 0xb5, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
+	0x43, 0x50, 0x43, 0x5f, 0x54, 0x47, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x10, 0xb6, 0x06, 0x12, 0x21,
+	0x0a, 0x1c, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x57,
+	0x41, 0x4c, 0x4b, 0x5f, 0x4e, 0x45, 0x58, 0x54, 0x5f, 0x43, 0x48, 0x55, 0x4e, 0x4b, 0x10, 0xb7,
+	0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50,
+	0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x30,
+	0x5f, 0x53, 0x50, 0x49, 0x10, 0xb8, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50,
+	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f,
+	0x42, 0x59, 0x5f, 0x53, 0x45, 0x31, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xb9, 0x06, 0x12, 0x24, 0x0a,
+	0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x54,
+	0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53, 0x45, 0x32, 0x5f, 0x53, 0x50, 0x49,
+	0x10, 0xba, 0x06, 0x12, 0x24, 0x0a, 0x1f, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
+	0x43, 0x50, 0x43, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x5f, 0x42, 0x59, 0x5f, 0x53,
+	0x45, 0x33, 0x5f, 0x53, 0x50, 0x49, 0x10, 0xbb, 0x06, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x4c, 0x54, 0x45, 0x5f, 0x41, 0x4c,
+	0x4c, 0x10, 0xbc, 0x06, 0x12, 0x26, 0x0a, 0x21, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
+	0x5f, 0x43, 0x50, 0x43, 0x5f, 0x53, 0x59, 0x4e, 0x43, 0x5f, 0x57, 0x52, 0x52, 0x45, 0x51, 0x5f,
+	0x46, 0x49, 0x46, 0x4f, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xbd, 0x06, 0x12, 0x1b, 0x0a, 0x16,
+	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x41, 0x4e,
+	0x45, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xbe, 0x06, 0x12, 0x1c, 0x0a, 0x17, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x43, 0x5f, 0x43, 0x41, 0x4e, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x4c, 0x4c, 0x10, 0xbf, 0x06, 0x12, 0x30, 0x0a, 0x2b, 0x47, 0x50, 0x55, 0x5f, 0x50,
+	0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x4d, 0x50, 0x5f, 0x55, 0x54, 0x43, 0x4c,
+	0x31, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x5f, 0x4f, 0x4e, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x4c, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xc0, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xc1, 0x06, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xc2, 0x06, 0x12, 0x20, 0x0a, 0x1b, 0x47,
+	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xc3, 0x06, 0x12, 0x1f, 0x0a,
+	0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43, 0x50,
+	0x46, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x42, 0x55, 0x53, 0x59, 0x10, 0xc4, 0x06, 0x12, 0x1f,
+	0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f, 0x43,
+	0x50, 0x46, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x49, 0x44, 0x4c, 0x45, 0x10, 0xc5, 0x06, 0x12,
+	0x20, 0x0a, 0x1b, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x43, 0x50, 0x46, 0x5f,
+	0x43, 0x50, 0x46, 0x5f, 0x54, 0x43, 0x49, 0x55, 0x5f, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x10, 0xc6,
+	0x06, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x46, 0x45,
+	0x54, 0x43, 0x48, 0x5f, 0x53, 0x49, 0x5a, 0x45, 0x10, 0xe8, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x47,
+	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x57, 0x52, 0x49, 0x54, 0x45, 0x5f, 0x53, 0x49,
+	0x5a, 0x45, 0x10, 0xe9, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
+	0x46, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x31, 0x36, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xea,
+	0x07, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x4f,
+	0x54, 0x41, 0x4c, 0x5f, 0x33, 0x32, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xeb, 0x07, 0x12, 0x1a, 0x0a,
 	0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f,
-	0x31, 0x36, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xea, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x33, 0x32, 0x5f, 0x4f,
-	0x50, 0x53, 0x10, 0xeb, 0x07, 0x12, 0x1a, 0x0a, 0x15, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x36, 0x34, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xec,
-	0x07, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47, 0x55,
-	0x49, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0xed,
-	0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43,
-	0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10,
-	0xee, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54,
-	0x45, 0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x50, 0x45, 0x52,
-	0x43, 0x45, 0x4e, 0x54, 0x10, 0xef, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50,
-	0x52, 0x4f, 0x46, 0x5f, 0x56, 0x41, 0x4c, 0x55, 0x5f, 0x50, 0x49, 0x50, 0x45, 0x5f, 0x49, 0x53,
-	0x53, 0x55, 0x45, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x10, 0xf0, 0x07, 0x12, 0x17, 0x0a, 0x12, 0x47,
-	0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x53, 0x4d, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56,
-	0x45, 0x10, 0xf1, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
-	0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x45, 0x4c, 0x41, 0x50, 0x53,
-	0x45, 0x44, 0x10, 0xf2, 0x07, 0x12, 0x25, 0x0a, 0x20, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f,
-	0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x5f,
-	0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x43, 0x55, 0x10, 0xf3, 0x07, 0x12, 0x1e, 0x0a, 0x19,
+	0x36, 0x34, 0x5f, 0x4f, 0x50, 0x53, 0x10, 0xec, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x47, 0x55, 0x49, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x5f, 0x50,
+	0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0xed, 0x07, 0x12, 0x1f, 0x0a, 0x1a, 0x47, 0x50, 0x55,
+	0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f,
+	0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0xee, 0x07, 0x12, 0x23, 0x0a, 0x1e, 0x47, 0x50,
+	0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x54, 0x45, 0x4e, 0x53, 0x4f, 0x52, 0x5f, 0x41, 0x43,
+	0x54, 0x49, 0x56, 0x45, 0x5f, 0x50, 0x45, 0x52, 0x43, 0x45, 0x4e, 0x54, 0x10, 0xef, 0x07, 0x12,
+	0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x56, 0x41, 0x4c, 0x55,
+	0x5f, 0x50, 0x49, 0x50, 0x45, 0x5f, 0x49, 0x53, 0x53, 0x55, 0x45, 0x5f, 0x55, 0x54, 0x49, 0x4c,
+	0x10, 0xf0, 0x07, 0x12, 0x17, 0x0a, 0x12, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f,
+	0x53, 0x4d, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0xf1, 0x07, 0x12, 0x1f, 0x0a, 0x1a,
 	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e,
-	0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x43, 0x55, 0x10, 0xf4, 0x07, 0x12, 0x1e, 0x0a, 0x19,
-	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x53, 0x49, 0x4d, 0x44, 0x5f, 0x55, 0x54,
-	0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0xf5, 0x07, 0x2a, 0xa8, 0x01, 0x0a,
-	0x0e, 0x47, 0x50, 0x55, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12,
-	0x0c, 0x0a, 0x08, 0x47, 0x50, 0x55, 0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a,
-	0x06, 0x47, 0x50, 0x55, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55,
-	0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x44, 0x10, 0x02, 0x12,
-	0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x55, 0x54, 0x45, 0x5f, 0x50,
-	0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x03, 0x12,
-	0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x41,
-	0x52, 0x54, 0x49, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x04, 0x12, 0x12,
-	0x0a, 0x0e, 0x4b, 0x46, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x49, 0x44,
-	0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f, 0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54,
-	0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10, 0x06, 0x2a, 0x3f, 0x0a, 0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65,
-	0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42, 0x55, 0x47, 0x10, 0x00, 0x12, 0x08,
-	0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x57, 0x41, 0x52, 0x4e,
-	0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x09, 0x0a,
-	0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x04, 0x2a, 0xe7, 0x45, 0x0a, 0x0e, 0x4e, 0x49, 0x43,
-	0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x0d, 0x0a, 0x09, 0x4e,
-	0x49, 0x43, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x49,
-	0x43, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x53, 0x50, 0x45, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a,
-	0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
-	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x02, 0x12, 0x20,
-	0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x03,
-	0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44,
-	0x5f, 0x46, 0x43, 0x53, 0x10, 0x04, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
+	0x43, 0x59, 0x5f, 0x45, 0x4c, 0x41, 0x50, 0x53, 0x45, 0x44, 0x10, 0xf2, 0x07, 0x12, 0x25, 0x0a,
+	0x20, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41,
+	0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x5f, 0x43,
+	0x55, 0x10, 0xf3, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
+	0x5f, 0x4f, 0x43, 0x43, 0x55, 0x50, 0x41, 0x4e, 0x43, 0x59, 0x5f, 0x50, 0x45, 0x52, 0x5f, 0x43,
+	0x55, 0x10, 0xf4, 0x07, 0x12, 0x1e, 0x0a, 0x19, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
+	0x5f, 0x53, 0x49, 0x4d, 0x44, 0x5f, 0x55, 0x54, 0x49, 0x4c, 0x49, 0x5a, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x10, 0xf5, 0x07, 0x12, 0x22, 0x0a, 0x1d, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46,
+	0x5f, 0x41, 0x52, 0x49, 0x54, 0x48, 0x4d, 0x45, 0x54, 0x49, 0x43, 0x5f, 0x49, 0x4e, 0x54, 0x45,
+	0x4e, 0x53, 0x49, 0x54, 0x59, 0x10, 0xf6, 0x07, 0x12, 0x1d, 0x0a, 0x18, 0x47, 0x50, 0x55, 0x5f,
+	0x50, 0x52, 0x4f, 0x46, 0x5f, 0x41, 0x43, 0x48, 0x49, 0x45, 0x56, 0x45, 0x44, 0x5f, 0x54, 0x46,
+	0x4c, 0x4f, 0x50, 0x53, 0x10, 0xf7, 0x07, 0x12, 0x19, 0x0a, 0x14, 0x47, 0x50, 0x55, 0x5f, 0x50,
+	0x52, 0x4f, 0x46, 0x5f, 0x4c, 0x32, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10,
+	0xf8, 0x07, 0x12, 0x18, 0x0a, 0x13, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x53,
+	0x41, 0x4d, 0x50, 0x4c, 0x45, 0x5f, 0x41, 0x47, 0x45, 0x10, 0xf9, 0x07, 0x12, 0x18, 0x0a, 0x13,
+	0x47, 0x50, 0x55, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x52, 0x45, 0x53,
+	0x53, 0x45, 0x44, 0x10, 0xfa, 0x07, 0x2a, 0xe4, 0x01, 0x0a, 0x0e, 0x47, 0x50, 0x55, 0x4d, 0x65,
+	0x74, 0x72, 0x69, 0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0c, 0x0a, 0x08, 0x47, 0x50, 0x55,
+	0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x50, 0x55, 0x5f, 0x49,
+	0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x47, 0x50, 0x55, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x49, 0x44, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x47, 0x50, 0x55,
+	0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x55, 0x54, 0x45, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x03, 0x12, 0x1d, 0x0a, 0x19, 0x47, 0x50, 0x55,
+	0x5f, 0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x5f, 0x50, 0x41, 0x52, 0x54, 0x49, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x10, 0x04, 0x12, 0x12, 0x0a, 0x0e, 0x4b, 0x46, 0x44, 0x5f,
+	0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x5f, 0x49, 0x44, 0x10, 0x05, 0x12, 0x13, 0x0a, 0x0f,
+	0x44, 0x45, 0x50, 0x4c, 0x4f, 0x59, 0x4d, 0x45, 0x4e, 0x54, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x10,
+	0x06, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x54, 0x41, 0x49, 0x4e, 0x45, 0x52, 0x5f, 0x49,
+	0x4d, 0x41, 0x47, 0x45, 0x10, 0x07, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x53,
+	0x45, 0x5f, 0x50, 0x52, 0x4f, 0x4a, 0x45, 0x43, 0x54, 0x10, 0x08, 0x12, 0x10, 0x0a, 0x0c, 0x41,
+	0x46, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x4e, 0x49, 0x43, 0x10, 0x09, 0x2a, 0x3f, 0x0a,
+	0x08, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x09, 0x0a, 0x05, 0x44, 0x45, 0x42,
+	0x55, 0x47, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0x01, 0x12, 0x08,
+	0x0a, 0x04, 0x57, 0x41, 0x52, 0x4e, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x46, 0x41, 0x54, 0x41, 0x4c, 0x10, 0x04, 0x2a, 0xcb,
+	0x49, 0x0a, 0x0e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46, 0x69, 0x65, 0x6c,
+	0x64, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x49, 0x43, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0x00,
+	0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x49, 0x43, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x53, 0x50, 0x45, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f,
+	0x4f, 0x4b, 0x10, 0x02, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x03, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
 	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x05, 0x12, 0x22, 0x0a, 0x1e,
+	0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x46, 0x43, 0x53, 0x10, 0x04, 0x12, 0x24, 0x0a, 0x20,
 	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x06,
-	0x12, 0x27, 0x0a, 0x23, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44,
-	0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x07, 0x12, 0x27, 0x0a, 0x23, 0x4e, 0x49, 0x43,
-	0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e, 0x44, 0x45, 0x52, 0x53, 0x49, 0x5a, 0x45, 0x44,
-	0x10, 0x08, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f,
-	0x56, 0x45, 0x52, 0x53, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x09, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49,
-	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x46, 0x52, 0x41, 0x47, 0x4d, 0x45, 0x4e, 0x54, 0x53,
-	0x10, 0x0a, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4a,
-	0x41, 0x42, 0x42, 0x45, 0x52, 0x10, 0x0b, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x49, 0x43, 0x5f, 0x50,
-	0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53,
-	0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x0c, 0x12, 0x28,
-	0x0a, 0x24, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x53, 0x54, 0x4f, 0x4d, 0x50,
-	0x45, 0x44, 0x5f, 0x43, 0x52, 0x43, 0x10, 0x0d, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x49, 0x43, 0x5f,
-	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
-	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c, 0x4f, 0x4e, 0x47, 0x10, 0x0e, 0x12,
-	0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50,
-	0x50, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52,
-	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54,
-	0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x10, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x41, 0x4c, 0x4c,
+	0x10, 0x05, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x10, 0x06, 0x12, 0x27, 0x0a, 0x23, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
 	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x54, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x11, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f,
+	0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x4c, 0x45, 0x4e, 0x47, 0x54, 0x48, 0x10, 0x07, 0x12,
+	0x27, 0x0a, 0x23, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e, 0x44, 0x45,
+	0x52, 0x53, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x08, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f,
 	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
-	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x10, 0x12, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49,
-	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x13, 0x12, 0x25,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x09,
+	0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x46, 0x52, 0x41,
+	0x47, 0x4d, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x0a, 0x12, 0x23, 0x0a, 0x1f, 0x4e, 0x49, 0x43, 0x5f,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4a, 0x41, 0x42, 0x42, 0x45, 0x52, 0x10, 0x0b, 0x12, 0x25, 0x0a,
+	0x21, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
+	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x10, 0x0c, 0x12, 0x28, 0x0a, 0x24, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x53, 0x54, 0x4f, 0x4d, 0x50, 0x45, 0x44, 0x5f, 0x43, 0x52, 0x43, 0x10, 0x0d, 0x12, 0x25,
 	0x0a, 0x21, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x50, 0x41,
-	0x55, 0x53, 0x45, 0x10, 0x14, 0x12, 0x2a, 0x0a, 0x26, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52,
-	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54,
-	0x58, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41, 0x4e, 0x5f, 0x36, 0x34, 0x42, 0x10,
-	0x15, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x52,
-	0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x16, 0x12, 0x29, 0x0a, 0x25, 0x4e, 0x49, 0x43,
-	0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x53, 0x46, 0x45,
-	0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x57, 0x4f,
-	0x52, 0x44, 0x10, 0x17, 0x12, 0x2a, 0x0a, 0x26, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x53, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x48, 0x5f,
-	0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x5f, 0x43, 0x4e, 0x54, 0x10, 0x18,
-	0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e, 0x49,
-	0x43, 0x41, 0x53, 0x54, 0x10, 0x19, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
-	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x52, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x1a, 0x12, 0x26,
-	0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44,
-	0x43, 0x41, 0x53, 0x54, 0x10, 0x1b, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
-	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x30, 0x10, 0x1c, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49,
-	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x31, 0x10, 0x1d, 0x12, 0x22,
-	0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x32,
-	0x10, 0x1e, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50,
-	0x52, 0x49, 0x5f, 0x33, 0x10, 0x1f, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
-	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x34, 0x10, 0x20, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49,
-	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x35, 0x10, 0x21, 0x12, 0x22,
-	0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x36,
-	0x10, 0x22, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50,
-	0x52, 0x49, 0x5f, 0x37, 0x10, 0x23, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
-	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x54, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x24, 0x12, 0x26, 0x0a, 0x22,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x54, 0x4f, 0x4f, 0x5f, 0x4c,
+	0x4f, 0x4e, 0x47, 0x10, 0x0e, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52,
+	0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x0f, 0x12, 0x1f, 0x0a, 0x1b, 0x4e,
+	0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52,
+	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x10, 0x12, 0x20, 0x0a, 0x1c,
 	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41,
-	0x53, 0x54, 0x10, 0x25, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x11, 0x12, 0x20,
+	0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x10, 0x12,
+	0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x41, 0x55,
+	0x53, 0x45, 0x10, 0x13, 0x12, 0x25, 0x0a, 0x21, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
 	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58,
-	0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x26, 0x12, 0x22, 0x0a, 0x1e,
+	0x5f, 0x50, 0x52, 0x49, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0x14, 0x12, 0x2a, 0x0a, 0x26, 0x4e,
+	0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52,
+	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4c, 0x45, 0x53, 0x53, 0x5f, 0x54, 0x48, 0x41,
+	0x4e, 0x5f, 0x36, 0x34, 0x42, 0x10, 0x15, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50,
+	0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53,
+	0x5f, 0x54, 0x58, 0x5f, 0x54, 0x52, 0x55, 0x4e, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x16, 0x12,
+	0x29, 0x0a, 0x25, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x53, 0x5f, 0x52, 0x53, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x41,
+	0x42, 0x4c, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x17, 0x12, 0x2a, 0x0a, 0x26, 0x4e, 0x49,
+	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x53, 0x46,
+	0x45, 0x43, 0x5f, 0x43, 0x48, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52,
+	0x5f, 0x43, 0x4e, 0x54, 0x10, 0x18, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
+	0x52, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x19, 0x12, 0x26, 0x0a, 0x22,
+	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41,
+	0x53, 0x54, 0x10, 0x1a, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0x1b, 0x12, 0x22, 0x0a, 0x1e,
 	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x30, 0x10, 0x27,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x30, 0x10, 0x1c,
 	0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49,
-	0x5f, 0x31, 0x10, 0x28, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58,
-	0x5f, 0x50, 0x52, 0x49, 0x5f, 0x32, 0x10, 0x29, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f,
+	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49,
+	0x5f, 0x31, 0x10, 0x1d, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x50, 0x52, 0x49, 0x5f, 0x32, 0x10, 0x1e, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f,
 	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
-	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x33, 0x10, 0x2a, 0x12, 0x22, 0x0a, 0x1e,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x33, 0x10, 0x1f, 0x12, 0x22, 0x0a, 0x1e,
 	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x34, 0x10, 0x2b,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x34, 0x10, 0x20,
 	0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49,
-	0x5f, 0x35, 0x10, 0x2c, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58,
-	0x5f, 0x50, 0x52, 0x49, 0x5f, 0x36, 0x10, 0x2d, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f,
+	0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49,
+	0x5f, 0x35, 0x10, 0x21, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x50, 0x52, 0x49, 0x5f, 0x36, 0x10, 0x22, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f,
 	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
-	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x37, 0x10, 0x2e, 0x12, 0x1f, 0x0a, 0x1b,
-	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f,
-	0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x2f, 0x12, 0x20, 0x0a,
-	0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
-	0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x30, 0x12,
-	0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x53, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x31,
-	0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x53, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x41, 0x4c, 0x4c,
-	0x10, 0x32, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x50, 0x53, 0x10, 0x33, 0x12, 0x19, 0x0a,
-	0x15, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f,
-	0x54, 0x58, 0x5f, 0x42, 0x50, 0x53, 0x10, 0x34, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x49, 0x43, 0x5f,
-	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x50,
-	0x53, 0x10, 0x35, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f,
-	0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x50, 0x53, 0x10, 0x36, 0x12, 0x2b,
-	0x0a, 0x27, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
-	0x5f, 0x52, 0x53, 0x46, 0x45, 0x43, 0x5f, 0x55, 0x4e, 0x43, 0x4f, 0x52, 0x52, 0x45, 0x43, 0x54,
-	0x41, 0x42, 0x4c, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x37, 0x12, 0x24, 0x0a, 0x20, 0x4e,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x37, 0x10, 0x23, 0x12, 0x24, 0x0a, 0x20,
+	0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54,
+	0x10, 0x24, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4d,
+	0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0x25, 0x12, 0x26, 0x0a, 0x22, 0x4e, 0x49,
+	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54,
+	0x10, 0x26, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50,
+	0x52, 0x49, 0x5f, 0x30, 0x10, 0x27, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
+	0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x31, 0x10, 0x28, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49,
+	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x32, 0x10, 0x29, 0x12, 0x22,
+	0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x33,
+	0x10, 0x2a, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50,
+	0x52, 0x49, 0x5f, 0x34, 0x10, 0x2b, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
+	0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x35, 0x10, 0x2c, 0x12, 0x22, 0x0a, 0x1e, 0x4e, 0x49,
+	0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x36, 0x10, 0x2d, 0x12, 0x22,
+	0x0a, 0x1e, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x37,
+	0x10, 0x2e, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f,
+	0x4b, 0x10, 0x2f, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f,
+	0x41, 0x4c, 0x4c, 0x10, 0x30, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x54,
+	0x58, 0x5f, 0x4f, 0x4b, 0x10, 0x31, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f,
+	0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f,
+	0x54, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x32, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x49, 0x43, 0x5f,
+	0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x50,
+	0x53, 0x10, 0x33, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x50, 0x53, 0x10, 0x34, 0x12, 0x19,
+	0x0a, 0x15, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
+	0x5f, 0x52, 0x58, 0x5f, 0x50, 0x50, 0x53, 0x10, 0x35, 0x12, 0x19, 0x0a, 0x15, 0x4e, 0x49, 0x43,
+	0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42,
+	0x50, 0x53, 0x10, 0x36, 0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x53, 0x46, 0x45, 0x43, 0x5f, 0x55, 0x4e, 0x43,
+	0x4f, 0x52, 0x52, 0x45, 0x43, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x57, 0x4f, 0x52, 0x44, 0x10,
+	0x37, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x52, 0x41, 0x54,
+	0x45, 0x10, 0x38, 0x12, 0x20, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x5f, 0x52,
+	0x41, 0x54, 0x45, 0x10, 0x39, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53,
+	0x54, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x64, 0x12, 0x29, 0x0a, 0x25, 0x4e,
 	0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f,
-	0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10,
-	0x64, 0x12, 0x29, 0x0a, 0x25, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52,
-	0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x65, 0x12, 0x2b, 0x0a, 0x27,
-	0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58,
-	0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f,
-	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x66, 0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43,
-	0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x52,
-	0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43,
-	0x4b, 0x45, 0x54, 0x53, 0x10, 0x67, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49,
-	0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x4d, 0x41, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x53, 0x10, 0x68, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x4c,
+	0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43,
+	0x4b, 0x45, 0x54, 0x53, 0x10, 0x65, 0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49,
+	0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49,
+	0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54,
+	0x53, 0x10, 0x66, 0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53,
+	0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x67,
+	0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x4d, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10,
+	0x68, 0x12, 0x24, 0x0a, 0x20, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x41,
+	0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x69, 0x12, 0x29, 0x0a, 0x25, 0x4e, 0x49, 0x43, 0x5f, 0x4c,
 	0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43,
-	0x41, 0x53, 0x54, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x69, 0x12, 0x29, 0x0a,
-	0x25, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54,
-	0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50,
-	0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x6a, 0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43, 0x5f,
-	0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x55, 0x4c,
-	0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b,
-	0x45, 0x54, 0x53, 0x10, 0x6b, 0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46,
-	0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43,
 	0x41, 0x53, 0x54, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53,
-	0x10, 0x6c, 0x12, 0x1f, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x44, 0x4d, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
-	0x53, 0x10, 0x6d, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x54, 0x58, 0x5f, 0x55,
-	0x43, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xc8, 0x01, 0x12, 0x15, 0x0a, 0x10,
-	0x52, 0x44, 0x4d, 0x41, 0x5f, 0x54, 0x58, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x4b, 0x54, 0x53,
-	0x10, 0xc9, 0x01, 0x12, 0x17, 0x0a, 0x12, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f, 0x55,
-	0x43, 0x41, 0x53, 0x54, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xca, 0x01, 0x12, 0x15, 0x0a, 0x10,
-	0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x4b, 0x54, 0x53,
-	0x10, 0xcb, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f, 0x45,
-	0x43, 0x4e, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xcc, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44,
-	0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x4b, 0x54, 0x5f, 0x53, 0x45,
-	0x51, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xcd, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41,
-	0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f, 0x52, 0x45, 0x54, 0x52,
-	0x59, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xce, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44, 0x4d, 0x41,
-	0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x5f,
-	0x45, 0x52, 0x52, 0x10, 0xcf, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52,
-	0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xd0, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51,
-	0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd1, 0x01, 0x12,
-	0x21, 0x0a, 0x1c, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x49,
-	0x4d, 0x50, 0x4c, 0x5f, 0x4e, 0x41, 0x4b, 0x5f, 0x53, 0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10,
-	0xd2, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52,
-	0x58, 0x5f, 0x43, 0x51, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd3, 0x01, 0x12, 0x1a, 0x0a, 0x15,
+	0x10, 0x6a, 0x12, 0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x6b, 0x12,
+	0x2b, 0x0a, 0x27, 0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53,
+	0x5f, 0x54, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x44, 0x52,
+	0x4f, 0x50, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0x6c, 0x12, 0x1f, 0x0a, 0x1b,
+	0x4e, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x46, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x53, 0x5f, 0x54, 0x58,
+	0x5f, 0x44, 0x4d, 0x41, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x10, 0x6d, 0x12, 0x17, 0x0a,
+	0x12, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x54, 0x58, 0x5f, 0x55, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x50,
+	0x4b, 0x54, 0x53, 0x10, 0xc8, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x54,
+	0x58, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xc9, 0x01, 0x12, 0x17, 0x0a,
+	0x12, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x43, 0x41, 0x53, 0x54, 0x5f, 0x50,
+	0x4b, 0x54, 0x53, 0x10, 0xca, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52,
+	0x58, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xcb, 0x01, 0x12, 0x15, 0x0a,
+	0x10, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f, 0x45, 0x43, 0x4e, 0x5f, 0x50, 0x4b, 0x54,
+	0x53, 0x10, 0xcc, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51,
+	0x5f, 0x52, 0x58, 0x5f, 0x50, 0x4b, 0x54, 0x5f, 0x53, 0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xcd, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52,
+	0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xce, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52,
+	0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xcf, 0x01,
+	0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f,
+	0x52, 0x4d, 0x54, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd0, 0x01, 0x12, 0x19,
+	0x0a, 0x14, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x50,
+	0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd1, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x52, 0x44, 0x4d,
+	0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x49, 0x4d, 0x50, 0x4c, 0x5f, 0x4e, 0x41,
+	0x4b, 0x5f, 0x53, 0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd2, 0x01, 0x12, 0x18, 0x0a, 0x13,
 	0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x43, 0x51, 0x45, 0x5f,
-	0x46, 0x4c, 0x55, 0x53, 0x48, 0x10, 0xd4, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x44, 0x4d, 0x41,
-	0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x55, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x50,
-	0x10, 0xd5, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f,
-	0x52, 0x58, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10,
-	0xd6, 0x01, 0x12, 0x18, 0x0a, 0x13, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54,
-	0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd7, 0x01, 0x12, 0x1d, 0x0a, 0x18,
+	0x45, 0x52, 0x52, 0x10, 0xd3, 0x01, 0x12, 0x1a, 0x0a, 0x15, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52,
+	0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x43, 0x51, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x10,
+	0xd4, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52,
+	0x58, 0x5f, 0x44, 0x55, 0x50, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x10, 0xd5, 0x01, 0x12, 0x1d, 0x0a,
+	0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x49, 0x4e, 0x56,
+	0x41, 0x4c, 0x49, 0x44, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x10, 0xd6, 0x01, 0x12, 0x18, 0x0a, 0x13,
 	0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f,
-	0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd8, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52,
-	0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x45, 0x4d, 0x5f, 0x4d,
-	0x47, 0x4d, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xd9, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x52, 0x44,
-	0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f,
-	0x45, 0x58, 0x43, 0x44, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xda, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x52,
-	0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x53,
-	0x47, 0x4c, 0x5f, 0x49, 0x4e, 0x56, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xdb, 0x01, 0x12, 0x1d, 0x0a,
-	0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x55,
-	0x50, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0xdc, 0x01, 0x12, 0x1b, 0x0a, 0x16,
-	0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x55, 0x54,
-	0x4f, 0x46, 0x5f, 0x42, 0x55, 0x46, 0x10, 0xdd, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44, 0x4d,
-	0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x55, 0x54, 0x4f, 0x55, 0x46,
-	0x5f, 0x53, 0x45, 0x51, 0x10, 0xde, 0x01, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x44, 0x4d, 0x41, 0x5f,
-	0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x43, 0x51, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10,
-	0xdf, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f,
-	0x52, 0x58, 0x5f, 0x43, 0x51, 0x45, 0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x10, 0xe0, 0x01, 0x12,
-	0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f,
-	0x4c, 0x4f, 0x43, 0x5f, 0x4c, 0x45, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe1, 0x01, 0x12, 0x21,
-	0x0a, 0x1c, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x49,
-	0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0xe2,
-	0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52,
-	0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe3,
-	0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52,
-	0x58, 0x5f, 0x4f, 0x55, 0x54, 0x4f, 0x46, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43, 0x10, 0xe4,
-	0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54,
-	0x58, 0x5f, 0x50, 0x4b, 0x54, 0x5f, 0x53, 0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe5, 0x01,
-	0x12, 0x23, 0x0a, 0x1e, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58,
-	0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x45,
-	0x52, 0x52, 0x10, 0xe6, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45,
-	0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xe7, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53,
-	0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45, 0x52,
-	0x52, 0x10, 0xe8, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53,
-	0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x45,
-	0x52, 0x52, 0x10, 0xe9, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45,
-	0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x53, 0x47, 0x4c, 0x5f, 0x49, 0x4e,
-	0x56, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xea, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41,
-	0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x53, 0x30, 0x5f, 0x54, 0x41, 0x42, 0x4c,
-	0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xeb, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x51, 0x50, 0x5f, 0x53,
-	0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43,
-	0x4b, 0x45, 0x54, 0x10, 0xac, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f,
-	0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f,
-	0x4d, 0x53, 0x47, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x4b, 0x45, 0x10, 0xad, 0x02,
-	0x12, 0x28, 0x0a, 0x23, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58,
-	0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x54,
-	0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x53, 0x10, 0xae, 0x02, 0x12, 0x1d, 0x0a, 0x18, 0x51, 0x50,
-	0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f, 0x54,
-	0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0xaf, 0x02, 0x12, 0x22, 0x0a, 0x1d, 0x51, 0x50, 0x5f,
-	0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x5f,
-	0x53, 0x51, 0x5f, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x45, 0x44, 0x10, 0xb0, 0x02, 0x12, 0x1e, 0x0a,
-	0x19, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55,
-	0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0xb1, 0x02, 0x12, 0x1c, 0x0a,
-	0x17, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55,
-	0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xb2, 0x02, 0x12, 0x2b, 0x0a, 0x26, 0x51,
-	0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
-	0x50, 0x4b, 0x54, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x43, 0x4e, 0x5f, 0x4d, 0x41,
-	0x52, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0xb3, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x53,
-	0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f,
-	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0xb4, 0x02, 0x12, 0x27, 0x0a, 0x22, 0x51, 0x50,
-	0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x42, 0x59, 0x54, 0x45,
-	0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44,
-	0x10, 0xb5, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e,
-	0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52,
-	0x45, 0x44, 0x10, 0xb6, 0x02, 0x12, 0x26, 0x0a, 0x21, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51,
-	0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x5f, 0x54, 0x49, 0x4d,
-	0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xb7, 0x02, 0x12, 0x1b, 0x0a,
-	0x16, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43,
-	0x4e, 0x50, 0x5f, 0x52, 0x43, 0x56, 0x44, 0x10, 0xb8, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50,
-	0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f,
-	0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0xb9, 0x02, 0x12, 0x1c, 0x0a, 0x17,
-	0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d,
-	0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xba, 0x02, 0x12, 0x1b, 0x0a, 0x16, 0x51, 0x50,
-	0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x10, 0xbb, 0x02, 0x12, 0x24, 0x0a, 0x1f, 0x51, 0x50, 0x5f, 0x52, 0x51,
-	0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x45, 0x51, 0x55,
-	0x45, 0x4e, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xbc, 0x02, 0x12, 0x27, 0x0a,
-	0x22, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55,
-	0x4d, 0x5f, 0x52, 0x50, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x53, 0x5f,
-	0x48, 0x49, 0x54, 0x10, 0xbd, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f,
-	0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x50, 0x5f, 0x4d, 0x41,
-	0x58, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x49, 0x54, 0x10, 0xbe, 0x02, 0x12, 0x1c, 0x0a,
-	0x17, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55,
-	0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xbf, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x51,
-	0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
+	0x45, 0x52, 0x52, 0x10, 0xd7, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52,
+	0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xd8, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45,
+	0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x45, 0x4d, 0x5f, 0x4d, 0x47, 0x4d, 0x54, 0x5f, 0x45, 0x52,
+	0x52, 0x10, 0xd9, 0x01, 0x12, 0x1f, 0x0a, 0x1a, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x51,
+	0x5f, 0x54, 0x58, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x45, 0x58, 0x43, 0x44, 0x5f, 0x45,
+	0x52, 0x52, 0x10, 0xda, 0x01, 0x12, 0x20, 0x0a, 0x1b, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45,
+	0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x53, 0x47, 0x4c, 0x5f, 0x49, 0x4e, 0x56,
+	0x5f, 0x45, 0x52, 0x52, 0x10, 0xdb, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f,
+	0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x55, 0x50, 0x5f, 0x52, 0x45, 0x51, 0x55,
+	0x45, 0x53, 0x54, 0x10, 0xdc, 0x01, 0x12, 0x1b, 0x0a, 0x16, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52,
+	0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x55, 0x54, 0x4f, 0x46, 0x5f, 0x42, 0x55, 0x46,
+	0x10, 0xdd, 0x01, 0x12, 0x1c, 0x0a, 0x17, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50,
+	0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x55, 0x54, 0x4f, 0x55, 0x46, 0x5f, 0x53, 0x45, 0x51, 0x10, 0xde,
+	0x01, 0x12, 0x19, 0x0a, 0x14, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52,
+	0x58, 0x5f, 0x43, 0x51, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xdf, 0x01, 0x12, 0x1b, 0x0a, 0x16,
+	0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x43, 0x51, 0x45,
+	0x5f, 0x46, 0x4c, 0x55, 0x53, 0x48, 0x10, 0xe0, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d,
+	0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f, 0x4c, 0x45,
+	0x4e, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe1, 0x01, 0x12, 0x21, 0x0a, 0x1c, 0x52, 0x44, 0x4d, 0x41,
+	0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44,
+	0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0xe2, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52,
+	0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4c, 0x4f, 0x43, 0x5f,
+	0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe3, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52,
+	0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x55, 0x54, 0x4f,
+	0x46, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43, 0x10, 0xe4, 0x01, 0x12, 0x1d, 0x0a, 0x18, 0x52,
+	0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x4b, 0x54, 0x5f,
+	0x53, 0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe5, 0x01, 0x12, 0x23, 0x0a, 0x1e, 0x52, 0x44,
+	0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4d, 0x54, 0x5f, 0x49,
+	0x4e, 0x56, 0x41, 0x4c, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe6, 0x01, 0x12,
+	0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f,
+	0x52, 0x4d, 0x54, 0x5f, 0x41, 0x43, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe7, 0x01, 0x12, 0x1e,
+	0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52,
+	0x4d, 0x54, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe8, 0x01, 0x12, 0x1f,
+	0x0a, 0x1a, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52,
+	0x4e, 0x52, 0x5f, 0x52, 0x45, 0x54, 0x52, 0x59, 0x5f, 0x45, 0x52, 0x52, 0x10, 0xe9, 0x01, 0x12,
+	0x21, 0x0a, 0x1c, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f,
+	0x4c, 0x4f, 0x43, 0x5f, 0x53, 0x47, 0x4c, 0x5f, 0x49, 0x4e, 0x56, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xea, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x45, 0x53, 0x50, 0x5f,
+	0x52, 0x58, 0x5f, 0x53, 0x30, 0x5f, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x10,
+	0xeb, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f, 0x43, 0x4e,
+	0x50, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0xec, 0x01, 0x12, 0x15, 0x0a, 0x10, 0x52, 0x44, 0x4d,
+	0x41, 0x5f, 0x54, 0x58, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0xed, 0x01,
+	0x12, 0x15, 0x0a, 0x10, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x52, 0x58, 0x5f, 0x45, 0x43, 0x4e, 0x5f,
+	0x52, 0x41, 0x54, 0x45, 0x10, 0xee, 0x01, 0x12, 0x1e, 0x0a, 0x19, 0x52, 0x44, 0x4d, 0x41, 0x5f,
+	0x52, 0x45, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x4d, 0x49, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x52,
+	0x41, 0x54, 0x49, 0x4f, 0x10, 0xef, 0x01, 0x12, 0x16, 0x0a, 0x11, 0x52, 0x44, 0x4d, 0x41, 0x5f,
+	0x52, 0x4e, 0x52, 0x5f, 0x4e, 0x41, 0x4b, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0xf0, 0x01, 0x12,
+	0x1d, 0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x56, 0x45, 0x4e, 0x44, 0x4f, 0x52, 0x5f, 0x4d,
+	0x4c, 0x58, 0x35, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0xf1, 0x01, 0x12, 0x1d,
+	0x0a, 0x18, 0x52, 0x44, 0x4d, 0x41, 0x5f, 0x56, 0x45, 0x4e, 0x44, 0x4f, 0x52, 0x5f, 0x42, 0x4e,
+	0x58, 0x54, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0xf2, 0x01, 0x12, 0x1c, 0x0a,
+	0x17, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55,
+	0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xac, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x51,
+	0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
 	0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4d, 0x53, 0x47, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52,
-	0x4b, 0x45, 0x10, 0xc0, 0x02, 0x12, 0x2b, 0x0a, 0x26, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52,
-	0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x5f, 0x57,
-	0x49, 0x54, 0x48, 0x5f, 0x45, 0x43, 0x4e, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x10,
-	0xc1, 0x02, 0x12, 0x23, 0x0a, 0x1e, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f,
-	0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x45,
-	0x49, 0x56, 0x45, 0x44, 0x10, 0xc2, 0x02, 0x12, 0x2a, 0x0a, 0x25, 0x51, 0x50, 0x5f, 0x52, 0x51,
-	0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x52, 0x45, 0x43, 0x49,
-	0x52, 0x43, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x5f, 0x44, 0x52, 0x4f, 0x50,
-	0x10, 0xc3, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50,
-	0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x4d, 0x45, 0x4d, 0x5f, 0x57, 0x49, 0x4e, 0x44,
-	0x4f, 0x57, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10, 0xc4, 0x02, 0x12, 0x2b, 0x0a,
-	0x26, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55,
-	0x4d, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x57, 0x52, 0x5f, 0x53,
-	0x45, 0x4e, 0x44, 0x5f, 0x4f, 0x50, 0x43, 0x10, 0xc5, 0x02, 0x12, 0x29, 0x0a, 0x24, 0x51, 0x50,
+	0x4b, 0x45, 0x10, 0xad, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52,
+	0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f,
+	0x41, 0x43, 0x4b, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x53, 0x10, 0xae, 0x02, 0x12,
+	0x1d, 0x0a, 0x18, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f,
+	0x52, 0x4e, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x10, 0xaf, 0x02, 0x12, 0x22,
+	0x0a, 0x1d, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x54,
+	0x49, 0x4d, 0x45, 0x53, 0x5f, 0x53, 0x51, 0x5f, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x45, 0x44, 0x10,
+	0xb0, 0x02, 0x12, 0x1e, 0x0a, 0x19, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f,
+	0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10,
+	0xb1, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f,
+	0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xb2, 0x02,
+	0x12, 0x2b, 0x0a, 0x26, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58,
+	0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45,
+	0x43, 0x4e, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x10, 0xb3, 0x02, 0x12, 0x20, 0x0a,
+	0x1b, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x5f,
+	0x42, 0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0xb4, 0x02, 0x12,
+	0x27, 0x0a, 0x22, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d,
+	0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x58,
+	0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xb5, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x53,
+	0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f,
+	0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xb6, 0x02, 0x12, 0x26, 0x0a, 0x21, 0x51, 0x50,
+	0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x41, 0x4c, 0x50, 0x48,
+	0x41, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0xb7, 0x02, 0x12, 0x1b, 0x0a, 0x16, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f,
+	0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x52, 0x43, 0x56, 0x44, 0x10, 0xb8, 0x02, 0x12,
+	0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d,
+	0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0xb9,
+	0x02, 0x12, 0x1c, 0x0a, 0x17, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54,
+	0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xba, 0x02, 0x12,
+	0x1b, 0x0a, 0x16, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f,
+	0x52, 0x4e, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0xbb, 0x02, 0x12, 0x24, 0x0a, 0x1f,
+	0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d,
+	0x5f, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10,
+	0xbc, 0x02, 0x12, 0x27, 0x0a, 0x22, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f,
+	0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x50, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x54,
+	0x48, 0x52, 0x45, 0x53, 0x5f, 0x48, 0x49, 0x54, 0x10, 0xbd, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x51,
+	0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
+	0x52, 0x50, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x49, 0x54, 0x10,
+	0xbe, 0x02, 0x12, 0x1c, 0x0a, 0x17, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f,
+	0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x10, 0xbf, 0x02,
+	0x12, 0x28, 0x0a, 0x23, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58,
+	0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4d, 0x53, 0x47, 0x53, 0x5f, 0x57,
+	0x49, 0x54, 0x48, 0x5f, 0x52, 0x4b, 0x45, 0x10, 0xc0, 0x02, 0x12, 0x2b, 0x0a, 0x26, 0x51, 0x50,
+	0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50,
+	0x4b, 0x54, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x43, 0x4e, 0x5f, 0x4d, 0x41, 0x52,
+	0x4b, 0x49, 0x4e, 0x47, 0x10, 0xc1, 0x02, 0x12, 0x23, 0x0a, 0x1e, 0x51, 0x50, 0x5f, 0x52, 0x51,
+	0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x53,
+	0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0xc2, 0x02, 0x12, 0x2a, 0x0a, 0x25,
+	0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4d, 0x41, 0x58,
+	0x5f, 0x52, 0x45, 0x43, 0x49, 0x52, 0x43, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10, 0xc3, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x51, 0x50, 0x5f, 0x52,
+	0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x4d, 0x45, 0x4d,
+	0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x10,
+	0xc4, 0x02, 0x12, 0x2b, 0x0a, 0x26, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f,
+	0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x5f, 0x57, 0x49, 0x54, 0x48,
+	0x5f, 0x57, 0x52, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4f, 0x50, 0x43, 0x10, 0xc5, 0x02, 0x12,
+	0x29, 0x0a, 0x24, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f,
+	0x4e, 0x55, 0x4d, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x42, 0x41,
+	0x43, 0x4b, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x10, 0xc6, 0x02, 0x12, 0x2b, 0x0a, 0x26, 0x51, 0x50,
 	0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x44,
-	0x55, 0x50, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x54, 0x52, 0x41,
-	0x43, 0x4b, 0x10, 0xc6, 0x02, 0x12, 0x2b, 0x0a, 0x26, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52,
-	0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x5f, 0x52,
-	0x45, 0x41, 0x44, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x10,
-	0xc7, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f,
-	0x43, 0x55, 0x52, 0x52, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45,
-	0x52, 0x10, 0xc8, 0x02, 0x12, 0x27, 0x0a, 0x22, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43,
-	0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54,
-	0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xc9, 0x02, 0x12, 0x20, 0x0a,
-	0x1b, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x54,
-	0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xca, 0x02, 0x12,
-	0x26, 0x0a, 0x21, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d,
-	0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50,
-	0x49, 0x52, 0x45, 0x44, 0x10, 0xcb, 0x02, 0x12, 0x1b, 0x0a, 0x16, 0x51, 0x50, 0x5f, 0x52, 0x51,
-	0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x52, 0x43, 0x56,
-	0x44, 0x10, 0xcc, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43,
-	0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53,
-	0x53, 0x45, 0x44, 0x10, 0xcd, 0x02, 0x12, 0x26, 0x0a, 0x21, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50,
-	0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50,
-	0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xce, 0x02, 0x12, 0x32,
-	0x0a, 0x2d, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f,
-	0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4d, 0x53, 0x47, 0x53,
-	0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x4b, 0x45, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10,
-	0xcf, 0x02, 0x12, 0x32, 0x0a, 0x2d, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f,
-	0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c,
-	0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x53, 0x5f, 0x54, 0x4f,
-	0x54, 0x41, 0x4c, 0x10, 0xd0, 0x02, 0x12, 0x27, 0x0a, 0x22, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50,
-	0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f, 0x54,
-	0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xd1, 0x02, 0x12,
-	0x2c, 0x0a, 0x27, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51,
-	0x5f, 0x54, 0x58, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x5f, 0x53, 0x51, 0x5f, 0x44, 0x52, 0x41,
-	0x49, 0x4e, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xd2, 0x02, 0x12, 0x28, 0x0a,
-	0x23, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54,
-	0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x53, 0x45, 0x4e, 0x54, 0x5f, 0x54,
-	0x4f, 0x54, 0x41, 0x4c, 0x10, 0xd3, 0x02, 0x12, 0x26, 0x0a, 0x21, 0x4c, 0x49, 0x46, 0x5f, 0x51,
-	0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
-	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xd4, 0x02, 0x12,
-	0x35, 0x0a, 0x30, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51,
-	0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x5f, 0x57, 0x49, 0x54,
-	0x48, 0x5f, 0x45, 0x43, 0x4e, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f,
-	0x54, 0x41, 0x4c, 0x10, 0xd5, 0x02, 0x12, 0x2a, 0x0a, 0x25, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50,
-	0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x5f, 0x42, 0x59, 0x54,
-	0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10,
-	0xd6, 0x02, 0x12, 0x31, 0x0a, 0x2c, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f,
-	0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55,
-	0x4e, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54,
-	0x41, 0x4c, 0x10, 0xd7, 0x02, 0x12, 0x2a, 0x0a, 0x25, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f,
-	0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52,
-	0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xd8,
-	0x02, 0x12, 0x30, 0x0a, 0x2b, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51,
-	0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x5f, 0x54, 0x49, 0x4d,
-	0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c,
-	0x10, 0xd9, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51,
-	0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x52, 0x43, 0x56,
-	0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xda, 0x02, 0x12, 0x2a, 0x0a, 0x25, 0x4c, 0x49,
+	0x55, 0x50, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43, 0x5f,
+	0x44, 0x52, 0x4f, 0x50, 0x10, 0xc7, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x52, 0x51,
+	0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x43,
+	0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x10, 0xc8, 0x02, 0x12, 0x27, 0x0a, 0x22, 0x51, 0x50, 0x5f,
+	0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f,
+	0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10,
+	0xc9, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f,
+	0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x10, 0xca, 0x02, 0x12, 0x26, 0x0a, 0x21, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43,
+	0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x5f, 0x54, 0x49, 0x4d, 0x45,
+	0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x10, 0xcb, 0x02, 0x12, 0x1b, 0x0a, 0x16,
+	0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e,
+	0x50, 0x5f, 0x52, 0x43, 0x56, 0x44, 0x10, 0xcc, 0x02, 0x12, 0x20, 0x0a, 0x1b, 0x51, 0x50, 0x5f,
+	0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50,
+	0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10, 0xcd, 0x02, 0x12, 0x26, 0x0a, 0x21, 0x4c,
+	0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f,
+	0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c,
+	0x10, 0xce, 0x02, 0x12, 0x32, 0x0a, 0x2d, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51,
+	0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x45, 0x4e, 0x44,
+	0x5f, 0x4d, 0x53, 0x47, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x4b, 0x45, 0x5f, 0x54,
+	0x4f, 0x54, 0x41, 0x4c, 0x10, 0xcf, 0x02, 0x12, 0x32, 0x0a, 0x2d, 0x4c, 0x49, 0x46, 0x5f, 0x51,
+	0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
+	0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x5f, 0x41, 0x43, 0x4b, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55,
+	0x54, 0x53, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xd0, 0x02, 0x12, 0x27, 0x0a, 0x22, 0x4c,
+	0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f,
+	0x52, 0x4e, 0x52, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x4f, 0x55, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41,
+	0x4c, 0x10, 0xd1, 0x02, 0x12, 0x2c, 0x0a, 0x27, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53,
+	0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x53, 0x5f, 0x53,
+	0x51, 0x5f, 0x44, 0x52, 0x41, 0x49, 0x4e, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10,
+	0xd2, 0x02, 0x12, 0x28, 0x0a, 0x23, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f,
+	0x52, 0x45, 0x51, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x53,
+	0x45, 0x4e, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xd3, 0x02, 0x12, 0x26, 0x0a, 0x21,
+	0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58,
+	0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41,
+	0x4c, 0x10, 0xd4, 0x02, 0x12, 0x35, 0x0a, 0x30, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53,
+	0x51, 0x5f, 0x52, 0x45, 0x51, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x4b, 0x54,
+	0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x43, 0x4e, 0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x49,
+	0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xd5, 0x02, 0x12, 0x2a, 0x0a, 0x25, 0x4c,
+	0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x43, 0x55, 0x52,
+	0x52, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x54,
+	0x4f, 0x54, 0x41, 0x4c, 0x10, 0xd6, 0x02, 0x12, 0x31, 0x0a, 0x2c, 0x4c, 0x49, 0x46, 0x5f, 0x51,
+	0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x42, 0x59, 0x54,
+	0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xd7, 0x02, 0x12, 0x2a, 0x0a, 0x25, 0x4c, 0x49,
 	0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
-	0x43, 0x4e, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x5f, 0x54, 0x4f,
-	0x54, 0x41, 0x4c, 0x10, 0xdb, 0x02, 0x12, 0x26, 0x0a, 0x21, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50,
-	0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50,
-	0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xdc, 0x02, 0x12, 0x25,
-	0x0a, 0x20, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f,
-	0x54, 0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x54, 0x4f, 0x54,
-	0x41, 0x4c, 0x10, 0xdd, 0x02, 0x12, 0x2e, 0x0a, 0x29, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f,
-	0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x45,
-	0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x54, 0x4f, 0x54,
-	0x41, 0x4c, 0x10, 0xde, 0x02, 0x12, 0x31, 0x0a, 0x2c, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f,
-	0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x50,
-	0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x53, 0x5f, 0x48, 0x49, 0x54, 0x5f,
-	0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xdf, 0x02, 0x12, 0x2f, 0x0a, 0x2a, 0x4c, 0x49, 0x46, 0x5f,
-	0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e, 0x55, 0x4d,
-	0x5f, 0x52, 0x50, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x48, 0x49, 0x54,
-	0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe0, 0x02, 0x12, 0x26, 0x0a, 0x21, 0x4c, 0x49, 0x46,
-	0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55,
-	0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe1,
-	0x02, 0x12, 0x32, 0x0a, 0x2d, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52,
-	0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4d,
-	0x53, 0x47, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x4b, 0x45, 0x5f, 0x54, 0x4f, 0x54,
-	0x41, 0x4c, 0x10, 0xe2, 0x02, 0x12, 0x35, 0x0a, 0x30, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f,
-	0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x4b,
-	0x54, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x43, 0x4e, 0x5f, 0x4d, 0x41, 0x52, 0x4b,
-	0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe3, 0x02, 0x12, 0x2d, 0x0a, 0x28,
-	0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58,
-	0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x53, 0x5f, 0x52, 0x45, 0x43, 0x45, 0x49, 0x56,
-	0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe4, 0x02, 0x12, 0x34, 0x0a, 0x2f, 0x4c,
-	0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f,
-	0x4d, 0x41, 0x58, 0x5f, 0x52, 0x45, 0x43, 0x49, 0x52, 0x43, 0x5f, 0x45, 0x58, 0x43, 0x45, 0x45,
-	0x44, 0x45, 0x44, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe5,
-	0x02, 0x12, 0x32, 0x0a, 0x2d, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52,
-	0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x4d, 0x45, 0x4d, 0x5f, 0x57, 0x49,
-	0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x4f, 0x54,
-	0x41, 0x4c, 0x10, 0xe6, 0x02, 0x12, 0x35, 0x0a, 0x30, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f,
-	0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x44, 0x55,
-	0x50, 0x4c, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x57, 0x52, 0x5f, 0x53, 0x45, 0x4e, 0x44, 0x5f,
-	0x4f, 0x50, 0x43, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe7, 0x02, 0x12, 0x33, 0x0a, 0x2e,
-	0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58,
-	0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x42,
-	0x41, 0x43, 0x4b, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe8,
-	0x02, 0x12, 0x35, 0x0a, 0x30, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52,
+	0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x4f,
+	0x54, 0x41, 0x4c, 0x10, 0xd8, 0x02, 0x12, 0x30, 0x0a, 0x2b, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50,
+	0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x41, 0x4c, 0x50, 0x48,
+	0x41, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x5f,
+	0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xd9, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x4c, 0x49, 0x46, 0x5f,
+	0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e,
+	0x50, 0x5f, 0x52, 0x43, 0x56, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xda, 0x02, 0x12,
+	0x2a, 0x0a, 0x25, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x53, 0x51, 0x5f, 0x51, 0x43, 0x4e,
+	0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53,
+	0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xdb, 0x02, 0x12, 0x26, 0x0a, 0x21, 0x4c,
+	0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f,
+	0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c,
+	0x10, 0xdc, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51,
+	0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x52, 0x4e, 0x52, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xdd, 0x02, 0x12, 0x2e, 0x0a, 0x29, 0x4c, 0x49,
+	0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e,
+	0x55, 0x4d, 0x5f, 0x53, 0x45, 0x51, 0x55, 0x45, 0x4e, 0x43, 0x45, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xde, 0x02, 0x12, 0x31, 0x0a, 0x2c, 0x4c, 0x49,
+	0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54, 0x58, 0x5f, 0x4e,
+	0x55, 0x4d, 0x5f, 0x52, 0x50, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x53,
+	0x5f, 0x48, 0x49, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xdf, 0x02, 0x12, 0x2f, 0x0a,
+	0x2a, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x54,
+	0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x52, 0x50, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x52, 0x41, 0x54,
+	0x45, 0x5f, 0x48, 0x49, 0x54, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe0, 0x02, 0x12, 0x26,
+	0x0a, 0x21, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f,
+	0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x5f, 0x54, 0x4f,
+	0x54, 0x41, 0x4c, 0x10, 0xe1, 0x02, 0x12, 0x32, 0x0a, 0x2d, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50,
+	0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x53,
+	0x45, 0x4e, 0x44, 0x5f, 0x4d, 0x53, 0x47, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x52, 0x4b,
+	0x45, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe2, 0x02, 0x12, 0x35, 0x0a, 0x30, 0x4c, 0x49,
+	0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e,
+	0x55, 0x4d, 0x5f, 0x50, 0x4b, 0x54, 0x53, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x45, 0x43, 0x4e,
+	0x5f, 0x4d, 0x41, 0x52, 0x4b, 0x49, 0x4e, 0x47, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe3,
+	0x02, 0x12, 0x2d, 0x0a, 0x28, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52,
+	0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x53, 0x5f, 0x52,
+	0x45, 0x43, 0x45, 0x49, 0x56, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe4, 0x02,
+	0x12, 0x34, 0x0a, 0x2f, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53,
+	0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4d, 0x41, 0x58, 0x5f, 0x52, 0x45, 0x43, 0x49, 0x52, 0x43, 0x5f,
+	0x45, 0x58, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x54, 0x4f,
+	0x54, 0x41, 0x4c, 0x10, 0xe5, 0x02, 0x12, 0x32, 0x0a, 0x2d, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50,
+	0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x4d,
+	0x45, 0x4d, 0x5f, 0x57, 0x49, 0x4e, 0x44, 0x4f, 0x57, 0x5f, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49,
+	0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe6, 0x02, 0x12, 0x35, 0x0a, 0x30, 0x4c, 0x49,
+	0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e,
+	0x55, 0x4d, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x5f, 0x57, 0x49, 0x54, 0x48, 0x5f, 0x57, 0x52, 0x5f,
+	0x53, 0x45, 0x4e, 0x44, 0x5f, 0x4f, 0x50, 0x43, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe7,
+	0x02, 0x12, 0x33, 0x0a, 0x2e, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x52,
 	0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x44, 0x55, 0x50, 0x4c, 0x5f, 0x52,
-	0x45, 0x41, 0x44, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x5f,
-	0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe9, 0x02, 0x12, 0x2a, 0x0a, 0x25, 0x4c, 0x49, 0x46, 0x5f,
-	0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x5f, 0x42,
-	0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x54, 0x4f, 0x54, 0x41,
-	0x4c, 0x10, 0xea, 0x02, 0x12, 0x31, 0x0a, 0x2c, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52,
-	0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x43,
-	0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x5f, 0x54,
-	0x4f, 0x54, 0x41, 0x4c, 0x10, 0xeb, 0x02, 0x12, 0x2a, 0x0a, 0x25, 0x4c, 0x49, 0x46, 0x5f, 0x51,
-	0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x54, 0x49, 0x4d,
-	0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c,
-	0x10, 0xec, 0x02, 0x12, 0x30, 0x0a, 0x2b, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51,
-	0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x41, 0x4c, 0x50, 0x48, 0x41, 0x5f, 0x54,
-	0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54,
-	0x41, 0x4c, 0x10, 0xed, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f,
-	0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x52,
-	0x43, 0x56, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xee, 0x02, 0x12, 0x2a, 0x0a, 0x25,
+	0x45, 0x41, 0x44, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x54, 0x52, 0x41, 0x43, 0x4b, 0x5f, 0x54, 0x4f,
+	0x54, 0x41, 0x4c, 0x10, 0xe8, 0x02, 0x12, 0x35, 0x0a, 0x30, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50,
+	0x5f, 0x52, 0x51, 0x5f, 0x52, 0x53, 0x50, 0x5f, 0x52, 0x58, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x44,
+	0x55, 0x50, 0x4c, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x5f, 0x41, 0x54, 0x4f, 0x4d, 0x49, 0x43, 0x5f,
+	0x44, 0x52, 0x4f, 0x50, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xe9, 0x02, 0x12, 0x2a, 0x0a,
+	0x25, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x43,
+	0x55, 0x52, 0x52, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52,
+	0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xea, 0x02, 0x12, 0x31, 0x0a, 0x2c, 0x4c, 0x49, 0x46,
+	0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x42,
+	0x59, 0x54, 0x45, 0x5f, 0x43, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49,
+	0x52, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xeb, 0x02, 0x12, 0x2a, 0x0a, 0x25,
 	0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55,
-	0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x5f,
-	0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xef, 0x02, 0x12, 0x13, 0x0a, 0x0e, 0x45, 0x54, 0x48, 0x5f,
-	0x54, 0x58, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0xf4, 0x03, 0x12, 0x11, 0x0a,
-	0x0c, 0x45, 0x54, 0x48, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0xf5, 0x03,
-	0x12, 0x13, 0x0a, 0x0e, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45,
-	0x54, 0x53, 0x10, 0xf6, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f,
-	0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0xf7, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f,
-	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43,
-	0x41, 0x53, 0x54, 0x10, 0xf8, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52,
-	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53,
-	0x54, 0x10, 0xf9, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10,
-	0xfa, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53,
-	0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0xfb, 0x03,
-	0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52,
-	0x58, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0xfc, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54,
-	0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x41, 0x55, 0x53,
-	0x45, 0x10, 0xfd, 0x03, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x36, 0x34, 0x42, 0x10, 0xfe, 0x03, 0x12, 0x1b, 0x0a, 0x16,
-	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x36, 0x35,
-	0x42, 0x5f, 0x31, 0x32, 0x37, 0x42, 0x10, 0xff, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x32, 0x38, 0x42, 0x5f,
-	0x32, 0x35, 0x35, 0x42, 0x10, 0x80, 0x04, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x32, 0x35, 0x36, 0x42, 0x5f, 0x35, 0x31,
-	0x31, 0x42, 0x10, 0x81, 0x04, 0x12, 0x1d, 0x0a, 0x18, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x35, 0x31, 0x32, 0x42, 0x5f, 0x31, 0x30, 0x32, 0x33,
-	0x42, 0x10, 0x82, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x30, 0x32, 0x34, 0x42, 0x5f, 0x31, 0x35, 0x31, 0x38,
-	0x42, 0x10, 0x83, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x35, 0x31, 0x39, 0x42, 0x5f, 0x32, 0x30, 0x34, 0x37,
-	0x42, 0x10, 0x84, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x32, 0x30, 0x34, 0x38, 0x42, 0x5f, 0x34, 0x30, 0x39, 0x35,
-	0x42, 0x10, 0x85, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x34, 0x30, 0x39, 0x36, 0x42, 0x5f, 0x38, 0x31, 0x39, 0x31,
-	0x42, 0x10, 0x86, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x46, 0x43, 0x53, 0x10, 0x87, 0x04,
-	0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52,
-	0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x30, 0x10, 0x88, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54,
-	0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f,
-	0x31, 0x10, 0x89, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x32, 0x10, 0x8a, 0x04, 0x12, 0x18,
-	0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f,
-	0x50, 0x52, 0x49, 0x5f, 0x33, 0x10, 0x8b, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f,
-	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x34, 0x10,
-	0x8c, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53,
-	0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x35, 0x10, 0x8d, 0x04, 0x12, 0x18, 0x0a, 0x13,
-	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52,
-	0x49, 0x5f, 0x36, 0x10, 0x8e, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52,
-	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x37, 0x10, 0x8f, 0x04,
-	0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54,
-	0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x30, 0x10, 0x90, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54,
-	0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f,
-	0x31, 0x10, 0x91, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x32, 0x10, 0x92, 0x04, 0x12, 0x18,
-	0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f,
-	0x50, 0x52, 0x49, 0x5f, 0x33, 0x10, 0x93, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f,
-	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x34, 0x10,
-	0x94, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53,
-	0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x35, 0x10, 0x95, 0x04, 0x12, 0x18, 0x0a, 0x13,
-	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52,
-	0x49, 0x5f, 0x36, 0x10, 0x96, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52,
-	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x37, 0x10, 0x97, 0x04,
-	0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52,
-	0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x98, 0x04, 0x12, 0x16, 0x0a, 0x11,
-	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x41, 0x4c,
-	0x4c, 0x10, 0x99, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x9a, 0x04,
-	0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54,
-	0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x9b, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f,
-	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x10, 0x9c, 0x04,
-	0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x48, 0x57, 0x5f, 0x54, 0x58, 0x5f, 0x44, 0x52,
-	0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x9d, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f,
-	0x48, 0x57, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x9e, 0x04,
-	0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x30, 0x5f, 0x44, 0x52, 0x4f,
-	0x50, 0x50, 0x45, 0x44, 0x10, 0x9f, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52,
-	0x58, 0x5f, 0x31, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa0, 0x04, 0x12, 0x15,
-	0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x32, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50,
-	0x45, 0x44, 0x10, 0xa1, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f,
-	0x33, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa2, 0x04, 0x12, 0x15, 0x0a, 0x10,
-	0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x34, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44,
-	0x10, 0xa3, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x35, 0x5f,
-	0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa4, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54,
-	0x48, 0x5f, 0x52, 0x58, 0x5f, 0x36, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa5,
-	0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x37, 0x5f, 0x44, 0x52,
-	0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa6, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f,
-	0x52, 0x58, 0x5f, 0x38, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa7, 0x04, 0x12,
-	0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x39, 0x5f, 0x44, 0x52, 0x4f, 0x50,
-	0x50, 0x45, 0x44, 0x10, 0xa8, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58,
-	0x5f, 0x31, 0x30, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa9, 0x04, 0x12, 0x16,
-	0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x31, 0x5f, 0x44, 0x52, 0x4f, 0x50,
-	0x50, 0x45, 0x44, 0x10, 0xaa, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58,
-	0x5f, 0x31, 0x32, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xab, 0x04, 0x12, 0x16,
-	0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x33, 0x5f, 0x44, 0x52, 0x4f, 0x50,
-	0x50, 0x45, 0x44, 0x10, 0xac, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58,
-	0x5f, 0x31, 0x34, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xad, 0x04, 0x12, 0x16,
-	0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x35, 0x5f, 0x44, 0x52, 0x4f, 0x50,
-	0x50, 0x45, 0x44, 0x10, 0xae, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52,
-	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0xaf, 0x04, 0x12, 0x15, 0x0a,
-	0x10, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4f,
-	0x4b, 0x10, 0xb0, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x4f, 0x43, 0x54, 0x45,
-	0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0xb1, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45,
-	0x54, 0x48, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4f, 0x4b, 0x10,
-	0xb2, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53,
-	0x5f, 0x54, 0x58, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xb3, 0x04, 0x12, 0x1a, 0x0a, 0x15,
-	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x55, 0x4e,
-	0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0xb4, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48, 0x5f,
-	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53,
-	0x54, 0x10, 0xb5, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x38, 0x31, 0x39, 0x32, 0x42, 0x5f, 0x39, 0x32, 0x31, 0x35,
-	0x42, 0x10, 0xb6, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x38, 0x31, 0x39, 0x32, 0x42, 0x5f, 0x39, 0x32, 0x31, 0x35,
-	0x42, 0x10, 0xb7, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x36, 0x34, 0x42, 0x10, 0xb8, 0x04, 0x12, 0x1b, 0x0a, 0x16,
-	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x36, 0x35,
-	0x42, 0x5f, 0x31, 0x32, 0x37, 0x42, 0x10, 0xb9, 0x04, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x31, 0x32, 0x38, 0x42, 0x5f,
-	0x32, 0x35, 0x35, 0x42, 0x10, 0xba, 0x04, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x32, 0x35, 0x36, 0x42, 0x5f, 0x35, 0x31,
-	0x31, 0x42, 0x10, 0xbb, 0x04, 0x12, 0x1d, 0x0a, 0x18, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x35, 0x31, 0x32, 0x42, 0x5f, 0x31, 0x30, 0x32, 0x33,
-	0x42, 0x10, 0xbc, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x31, 0x30, 0x32, 0x34, 0x42, 0x5f, 0x31, 0x35, 0x31, 0x38,
-	0x42, 0x10, 0xbd, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x31, 0x35, 0x31, 0x39, 0x42, 0x5f, 0x32, 0x30, 0x34, 0x37,
-	0x42, 0x10, 0xbe, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x32, 0x30, 0x34, 0x38, 0x42, 0x5f, 0x34, 0x30, 0x39, 0x35,
-	0x42, 0x10, 0xbf, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x34, 0x30, 0x39, 0x36, 0x42, 0x5f, 0x38, 0x31, 0x39, 0x31,
-	0x42, 0x10, 0xc0, 0x04, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0xc1,
-	0x04, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0xc2, 0x04, 0x12, 0x1b,
-	0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f,
-	0x50, 0x52, 0x49, 0x30, 0x10, 0x88, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45,
+	0x4d, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45, 0x44, 0x5f,
+	0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xec, 0x02, 0x12, 0x30, 0x0a, 0x2b, 0x4c, 0x49, 0x46, 0x5f,
+	0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x41, 0x4c,
+	0x50, 0x48, 0x41, 0x5f, 0x54, 0x49, 0x4d, 0x45, 0x52, 0x5f, 0x45, 0x58, 0x50, 0x49, 0x52, 0x45,
+	0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xed, 0x02, 0x12, 0x25, 0x0a, 0x20, 0x4c, 0x49,
+	0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51, 0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f,
+	0x43, 0x4e, 0x50, 0x5f, 0x52, 0x43, 0x56, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xee,
+	0x02, 0x12, 0x2a, 0x0a, 0x25, 0x4c, 0x49, 0x46, 0x5f, 0x51, 0x50, 0x5f, 0x52, 0x51, 0x5f, 0x51,
+	0x43, 0x4e, 0x5f, 0x4e, 0x55, 0x4d, 0x5f, 0x43, 0x4e, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45,
+	0x53, 0x53, 0x45, 0x44, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xef, 0x02, 0x12, 0x13, 0x0a,
+	0x0e, 0x45, 0x54, 0x48, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10,
+	0xf4, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x45, 0x54, 0x48, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x59, 0x54,
+	0x45, 0x53, 0x10, 0xf5, 0x03, 0x12, 0x13, 0x0a, 0x0e, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f,
+	0x50, 0x41, 0x43, 0x4b, 0x45, 0x54, 0x53, 0x10, 0xf6, 0x03, 0x12, 0x11, 0x0a, 0x0c, 0x45, 0x54,
+	0x48, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x59, 0x54, 0x45, 0x53, 0x10, 0xf7, 0x03, 0x12, 0x1c, 0x0a,
+	0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42,
+	0x52, 0x4f, 0x41, 0x44, 0x43, 0x41, 0x53, 0x54, 0x10, 0xf8, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45,
+	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4d, 0x55, 0x4c,
+	0x54, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0xf9, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42, 0x52, 0x4f, 0x41, 0x44,
+	0x43, 0x41, 0x53, 0x54, 0x10, 0xfa, 0x03, 0x12, 0x1c, 0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x4d, 0x55, 0x4c, 0x54, 0x49, 0x43, 0x41,
+	0x53, 0x54, 0x10, 0xfb, 0x03, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0xfc, 0x03, 0x12,
+	0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58,
+	0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x10, 0xfd, 0x03, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x36, 0x34, 0x42, 0x10, 0xfe,
+	0x03, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
+	0x52, 0x58, 0x5f, 0x36, 0x35, 0x42, 0x5f, 0x31, 0x32, 0x37, 0x42, 0x10, 0xff, 0x03, 0x12, 0x1c,
+	0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f,
+	0x31, 0x32, 0x38, 0x42, 0x5f, 0x32, 0x35, 0x35, 0x42, 0x10, 0x80, 0x04, 0x12, 0x1c, 0x0a, 0x17,
+	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x32, 0x35,
+	0x36, 0x42, 0x5f, 0x35, 0x31, 0x31, 0x42, 0x10, 0x81, 0x04, 0x12, 0x1d, 0x0a, 0x18, 0x45, 0x54,
+	0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x35, 0x31, 0x32, 0x42,
+	0x5f, 0x31, 0x30, 0x32, 0x33, 0x42, 0x10, 0x82, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x30, 0x32, 0x34, 0x42,
+	0x5f, 0x31, 0x35, 0x31, 0x38, 0x42, 0x10, 0x83, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x35, 0x31, 0x39, 0x42,
+	0x5f, 0x32, 0x30, 0x34, 0x37, 0x42, 0x10, 0x84, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x32, 0x30, 0x34, 0x38, 0x42,
+	0x5f, 0x34, 0x30, 0x39, 0x35, 0x42, 0x10, 0x85, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x34, 0x30, 0x39, 0x36, 0x42,
+	0x5f, 0x38, 0x31, 0x39, 0x31, 0x42, 0x10, 0x86, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x46,
+	0x43, 0x53, 0x10, 0x87, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x30, 0x10, 0x88, 0x04, 0x12,
+	0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
+	0x5f, 0x50, 0x52, 0x49, 0x5f, 0x31, 0x10, 0x89, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x32,
+	0x10, 0x8a, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x33, 0x10, 0x8b, 0x04, 0x12, 0x18, 0x0a,
+	0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50,
+	0x52, 0x49, 0x5f, 0x34, 0x10, 0x8c, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x35, 0x10, 0x8d,
+	0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
+	0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x36, 0x10, 0x8e, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45,
 	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49,
-	0x31, 0x10, 0x89, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f,
-	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x32, 0x10, 0x8a,
+	0x5f, 0x37, 0x10, 0x8f, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x30, 0x10, 0x90, 0x04, 0x12,
+	0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58,
+	0x5f, 0x50, 0x52, 0x49, 0x5f, 0x31, 0x10, 0x91, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x32,
+	0x10, 0x92, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
+	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x33, 0x10, 0x93, 0x04, 0x12, 0x18, 0x0a,
+	0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50,
+	0x52, 0x49, 0x5f, 0x34, 0x10, 0x94, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x35, 0x10, 0x95,
+	0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
+	0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x5f, 0x36, 0x10, 0x96, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45,
+	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49,
+	0x5f, 0x37, 0x10, 0x97, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x98,
+	0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
+	0x52, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x99, 0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x42, 0x41, 0x44, 0x5f, 0x41,
+	0x4c, 0x4c, 0x10, 0x9a, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
+	0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x41, 0x4c, 0x4c, 0x10, 0x9b, 0x04, 0x12, 0x16, 0x0a,
+	0x11, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x42,
+	0x41, 0x44, 0x10, 0x9c, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x48, 0x57, 0x5f,
+	0x54, 0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x9d, 0x04, 0x12, 0x16, 0x0a,
+	0x11, 0x45, 0x54, 0x48, 0x5f, 0x48, 0x57, 0x5f, 0x52, 0x58, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50,
+	0x45, 0x44, 0x10, 0x9e, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f,
+	0x30, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x9f, 0x04, 0x12, 0x15, 0x0a, 0x10,
+	0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44,
+	0x10, 0xa0, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x32, 0x5f,
+	0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa1, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54,
+	0x48, 0x5f, 0x52, 0x58, 0x5f, 0x33, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa2,
+	0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x34, 0x5f, 0x44, 0x52,
+	0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa3, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f,
+	0x52, 0x58, 0x5f, 0x35, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa4, 0x04, 0x12,
+	0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x36, 0x5f, 0x44, 0x52, 0x4f, 0x50,
+	0x50, 0x45, 0x44, 0x10, 0xa5, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58,
+	0x5f, 0x37, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa6, 0x04, 0x12, 0x15, 0x0a,
+	0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x38, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45,
+	0x44, 0x10, 0xa7, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x39,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xa8, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45,
+	0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x30, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44,
+	0x10, 0xa9, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x31,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xaa, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45,
+	0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x32, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44,
+	0x10, 0xab, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x33,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xac, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45,
+	0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x34, 0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44,
+	0x10, 0xad, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48, 0x5f, 0x52, 0x58, 0x5f, 0x31, 0x35,
+	0x5f, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0xae, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45,
+	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10,
+	0xaf, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53,
+	0x5f, 0x54, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0xb0, 0x04, 0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48,
+	0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x4f, 0x4b, 0x10, 0xb1, 0x04,
+	0x12, 0x15, 0x0a, 0x10, 0x45, 0x54, 0x48, 0x5f, 0x4f, 0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x54,
+	0x58, 0x5f, 0x4f, 0x4b, 0x10, 0xb2, 0x04, 0x12, 0x18, 0x0a, 0x13, 0x45, 0x54, 0x48, 0x5f, 0x4f,
+	0x43, 0x54, 0x45, 0x54, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x10, 0xb3,
+	0x04, 0x12, 0x1a, 0x0a, 0x15, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
+	0x52, 0x58, 0x5f, 0x55, 0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0xb4, 0x04, 0x12, 0x1a, 0x0a,
+	0x15, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x55,
+	0x4e, 0x49, 0x43, 0x41, 0x53, 0x54, 0x10, 0xb5, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x38, 0x31, 0x39, 0x32, 0x42,
+	0x5f, 0x39, 0x32, 0x31, 0x35, 0x42, 0x10, 0xb6, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x38, 0x31, 0x39, 0x32, 0x42,
+	0x5f, 0x39, 0x32, 0x31, 0x35, 0x42, 0x10, 0xb7, 0x04, 0x12, 0x16, 0x0a, 0x11, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x36, 0x34, 0x42, 0x10, 0xb8,
+	0x04, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
+	0x54, 0x58, 0x5f, 0x36, 0x35, 0x42, 0x5f, 0x31, 0x32, 0x37, 0x42, 0x10, 0xb9, 0x04, 0x12, 0x1c,
+	0x0a, 0x17, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f,
+	0x31, 0x32, 0x38, 0x42, 0x5f, 0x32, 0x35, 0x35, 0x42, 0x10, 0xba, 0x04, 0x12, 0x1c, 0x0a, 0x17,
+	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x32, 0x35,
+	0x36, 0x42, 0x5f, 0x35, 0x31, 0x31, 0x42, 0x10, 0xbb, 0x04, 0x12, 0x1d, 0x0a, 0x18, 0x45, 0x54,
+	0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x35, 0x31, 0x32, 0x42,
+	0x5f, 0x31, 0x30, 0x32, 0x33, 0x42, 0x10, 0xbc, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x31, 0x30, 0x32, 0x34, 0x42,
+	0x5f, 0x31, 0x35, 0x31, 0x38, 0x42, 0x10, 0xbd, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x31, 0x35, 0x31, 0x39, 0x42,
+	0x5f, 0x32, 0x30, 0x34, 0x37, 0x42, 0x10, 0xbe, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x32, 0x30, 0x34, 0x38, 0x42,
+	0x5f, 0x34, 0x30, 0x39, 0x35, 0x42, 0x10, 0xbf, 0x04, 0x12, 0x1e, 0x0a, 0x19, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x34, 0x30, 0x39, 0x36, 0x42,
+	0x5f, 0x38, 0x31, 0x39, 0x31, 0x42, 0x10, 0xc0, 0x04, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x50, 0x41,
+	0x55, 0x53, 0x45, 0x10, 0xc1, 0x04, 0x12, 0x1b, 0x0a, 0x16, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52,
+	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x50, 0x41, 0x55, 0x53, 0x45,
+	0x10, 0xc2, 0x04, 0x12, 0x20, 0x0a, 0x1b, 0x4e, 0x49, 0x43, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55,
+	0x52, 0x45, 0x10, 0xd8, 0x04, 0x12, 0x1c, 0x0a, 0x17, 0x4e, 0x49, 0x43, 0x5f, 0x54, 0x52, 0x41,
+	0x4e, 0x53, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x56, 0x4f, 0x4c, 0x54, 0x41, 0x47, 0x45,
+	0x10, 0xd9, 0x04, 0x12, 0x1d, 0x0a, 0x18, 0x4e, 0x49, 0x43, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53,
+	0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10,
+	0xda, 0x04, 0x12, 0x1d, 0x0a, 0x18, 0x4e, 0x49, 0x43, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x43,
+	0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x4f, 0x57, 0x45, 0x52, 0x10, 0xdb,
+	0x04, 0x12, 0x21, 0x0a, 0x1c, 0x4e, 0x49, 0x43, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x43, 0x45,
+	0x49, 0x56, 0x45, 0x52, 0x5f, 0x42, 0x49, 0x41, 0x53, 0x5f, 0x43, 0x55, 0x52, 0x52, 0x45, 0x4e,
+	0x54, 0x10, 0xdc, 0x04, 0x12, 0x19, 0x0a, 0x14, 0x4e, 0x49, 0x43, 0x5f, 0x54, 0x52, 0x41, 0x4e,
+	0x53, 0x43, 0x45, 0x49, 0x56, 0x45, 0x52, 0x5f, 0x49, 0x4e, 0x46, 0x4f, 0x10, 0xdd, 0x04, 0x12,
+	0x27, 0x0a, 0x22, 0x4e, 0x49, 0x43, 0x5f, 0x54, 0x52, 0x41, 0x4e, 0x53, 0x43, 0x45, 0x49, 0x56,
+	0x45, 0x52, 0x5f, 0x54, 0x48, 0x52, 0x45, 0x53, 0x48, 0x4f, 0x4c, 0x44, 0x5f, 0x45, 0x58, 0x43,
+	0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0xde, 0x04, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f,
+	0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x30, 0x10, 0x88,
 	0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41,
-	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x33, 0x10, 0x8b, 0x04, 0x1a, 0x02,
+	0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x31, 0x10, 0x89, 0x04, 0x1a, 0x02,
 	0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53,
-	0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x34, 0x10, 0x8c, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12,
+	0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x32, 0x10, 0x8a, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12,
 	0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58,
-	0x5f, 0x50, 0x52, 0x49, 0x35, 0x10, 0x8d, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12,
+	0x5f, 0x50, 0x52, 0x49, 0x33, 0x10, 0x8b, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12,
 	0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52,
-	0x49, 0x36, 0x10, 0x8e, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48,
-	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x37, 0x10,
-	0x8f, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52,
-	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x30, 0x10, 0x90, 0x04, 0x1a,
+	0x49, 0x34, 0x10, 0x8c, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48,
+	0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x35, 0x10,
+	0x8d, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52,
+	0x41, 0x4d, 0x45, 0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x36, 0x10, 0x8e, 0x04, 0x1a,
 	0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45,
-	0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x31, 0x10, 0x91, 0x04, 0x1a, 0x02, 0x08, 0x01,
+	0x53, 0x5f, 0x52, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x37, 0x10, 0x8f, 0x04, 0x1a, 0x02, 0x08, 0x01,
 	0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54,
-	0x58, 0x5f, 0x50, 0x52, 0x49, 0x32, 0x10, 0x92, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a,
+	0x58, 0x5f, 0x50, 0x52, 0x49, 0x30, 0x10, 0x90, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a,
 	0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50,
-	0x52, 0x49, 0x33, 0x10, 0x93, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54,
-	0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x34,
-	0x10, 0x94, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46,
-	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x35, 0x10, 0x95, 0x04,
+	0x52, 0x49, 0x31, 0x10, 0x91, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54,
+	0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x32,
+	0x10, 0x92, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46,
+	0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x33, 0x10, 0x93, 0x04,
 	0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d,
-	0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x36, 0x10, 0x96, 0x04, 0x1a, 0x02, 0x08,
+	0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x34, 0x10, 0x94, 0x04, 0x1a, 0x02, 0x08,
 	0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f,
-	0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x37, 0x10, 0x97, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x1a, 0x02,
-	0x10, 0x01, 0x2a, 0x40, 0x0a, 0x0e, 0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c,
-	0x61, 0x62, 0x65, 0x6c, 0x12, 0x0c, 0x0a, 0x08, 0x4e, 0x49, 0x43, 0x5f, 0x55, 0x55, 0x49, 0x44,
-	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x4e, 0x49, 0x43, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x14,
-	0x0a, 0x10, 0x46, 0x49, 0x52, 0x4d, 0x57, 0x41, 0x52, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49,
-	0x4f, 0x4e, 0x10, 0x02, 0x2a, 0x9b, 0x06, 0x0a, 0x0f, 0x49, 0x46, 0x4f, 0x45, 0x4d, 0x65, 0x74,
-	0x72, 0x69, 0x63, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x46, 0x4f, 0x45,
-	0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x53, 0x10, 0x00,
-	0x12, 0x17, 0x0a, 0x13, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x53, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x46, 0x4f,
-	0x45, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x53, 0x10, 0x02, 0x12,
-	0x22, 0x0a, 0x1e, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x46,
-	0x41, 0x49, 0x4c, 0x45, 0x44, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
-	0x53, 0x10, 0x03, 0x12, 0x1e, 0x0a, 0x1a, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42,
-	0x45, 0x52, 0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
-	0x53, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x42, 0x49, 0x54, 0x5f,
-	0x45, 0x52, 0x52, 0x4f, 0x52, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20,
-	0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52,
-	0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x30,
-	0x10, 0x06, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43,
-	0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x53, 0x31, 0x10, 0x07, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45,
+	0x54, 0x58, 0x5f, 0x50, 0x52, 0x49, 0x35, 0x10, 0x95, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b,
+	0x0a, 0x12, 0x45, 0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f,
+	0x50, 0x52, 0x49, 0x36, 0x10, 0x96, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x12, 0x1b, 0x0a, 0x12, 0x45,
+	0x54, 0x48, 0x5f, 0x46, 0x52, 0x41, 0x4d, 0x45, 0x53, 0x5f, 0x54, 0x58, 0x5f, 0x50, 0x52, 0x49,
+	0x37, 0x10, 0x97, 0x04, 0x1a, 0x02, 0x08, 0x01, 0x1a, 0x02, 0x10, 0x01, 0x2a, 0x52, 0x0a, 0x0e,
+	0x4e, 0x49, 0x43, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x0c,
+	0x0a, 0x08, 0x4e, 0x49, 0x43, 0x5f, 0x55, 0x55, 0x49, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x4e, 0x49, 0x43, 0x5f, 0x49, 0x44, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x46, 0x49, 0x52, 0x4d,
+	0x57, 0x41, 0x52, 0x45, 0x5f, 0x56, 0x45, 0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x02, 0x12, 0x10,
+	0x0a, 0x0c, 0x41, 0x46, 0x46, 0x49, 0x4e, 0x49, 0x54, 0x59, 0x5f, 0x47, 0x50, 0x55, 0x10, 0x03,
+	0x2a, 0xfa, 0x08, 0x0a, 0x0f, 0x49, 0x46, 0x4f, 0x45, 0x4d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x46,
+	0x69, 0x65, 0x6c, 0x64, 0x12, 0x16, 0x0a, 0x12, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x54, 0x4f, 0x54,
+	0x41, 0x4c, 0x5f, 0x44, 0x45, 0x56, 0x49, 0x43, 0x45, 0x53, 0x10, 0x00, 0x12, 0x17, 0x0a, 0x13,
+	0x49, 0x46, 0x4f, 0x45, 0x5f, 0x54, 0x4f, 0x54, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49,
+	0x4f, 0x4e, 0x53, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x54, 0x4f,
+	0x54, 0x41, 0x4c, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x53, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x49,
+	0x46, 0x4f, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45,
+	0x44, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x53, 0x10, 0x03, 0x12,
+	0x1e, 0x0a, 0x1a, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x4e, 0x55, 0x4d, 0x42, 0x45, 0x52, 0x5f, 0x50,
+	0x41, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x53, 0x10, 0x04, 0x12,
+	0x17, 0x0a, 0x13, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x42, 0x49, 0x54, 0x5f, 0x45, 0x52, 0x52, 0x4f,
+	0x52, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x05, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45,
 	0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59,
-	0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x32, 0x10, 0x08, 0x12, 0x24,
+	0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x30, 0x10, 0x06, 0x12, 0x24,
 	0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57,
 	0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
-	0x53, 0x33, 0x10, 0x09, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43,
+	0x53, 0x31, 0x10, 0x07, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43,
 	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c,
-	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x34, 0x10, 0x0a, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x32, 0x10, 0x08, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46,
 	0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f,
-	0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x35, 0x10, 0x0b,
+	0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x33, 0x10, 0x09,
 	0x12, 0x24, 0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44,
 	0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52,
-	0x4f, 0x52, 0x53, 0x36, 0x10, 0x0c, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46,
+	0x4f, 0x52, 0x53, 0x34, 0x10, 0x0a, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46,
 	0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42,
-	0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x37, 0x10, 0x0d, 0x12, 0x24, 0x0a, 0x20,
+	0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x35, 0x10, 0x0b, 0x12, 0x24, 0x0a, 0x20,
 	0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52,
-	0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x38,
-	0x10, 0x0e, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43,
+	0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x36,
+	0x10, 0x0c, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43,
 	0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45,
-	0x52, 0x52, 0x4f, 0x52, 0x53, 0x39, 0x10, 0x0f, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x46, 0x4f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x53, 0x37, 0x10, 0x0d, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45,
 	0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59,
-	0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x31, 0x30, 0x10, 0x10, 0x12,
-	0x25, 0x0a, 0x21, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45,
-	0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f,
-	0x52, 0x53, 0x31, 0x31, 0x10, 0x11, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46,
-	0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42,
-	0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x31, 0x32, 0x10, 0x12, 0x12, 0x25, 0x0a,
-	0x21, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f,
-	0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53,
-	0x31, 0x33, 0x10, 0x13, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43,
+	0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x38, 0x10, 0x0e, 0x12, 0x24,
+	0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57,
+	0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x53, 0x39, 0x10, 0x0f, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43,
 	0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c,
-	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x31, 0x34, 0x10, 0x14, 0x12, 0x25, 0x0a, 0x21, 0x49,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x31, 0x30, 0x10, 0x10, 0x12, 0x25, 0x0a, 0x21, 0x49,
 	0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44,
-	0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x31, 0x35,
-	0x10, 0x15, 0x42, 0x15, 0x5a, 0x13, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
-	0x65, 0x72, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x31, 0x31,
+	0x10, 0x11, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43,
+	0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45,
+	0x52, 0x52, 0x4f, 0x52, 0x53, 0x31, 0x32, 0x10, 0x12, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x46, 0x4f,
+	0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53,
+	0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x31, 0x33, 0x10, 0x13,
+	0x12, 0x25, 0x0a, 0x21, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44,
+	0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52,
+	0x4f, 0x52, 0x53, 0x31, 0x34, 0x10, 0x14, 0x12, 0x25, 0x0a, 0x21, 0x49, 0x46, 0x4f, 0x45, 0x5f,
+	0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d,
+	0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x53, 0x31, 0x35, 0x10, 0x15, 0x12, 0x23,
+	0x0a, 0x1f, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57,
+	0x4f, 0x52, 0x44, 0x5f, 0x53, 0x59, 0x4d, 0x42, 0x4f, 0x4c, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52,
+	0x53, 0x10, 0x16, 0x12, 0x19, 0x0a, 0x15, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x50, 0x4f, 0x52, 0x54,
+	0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x17, 0x12, 0x1f,
+	0x0a, 0x1b, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x45, 0x43, 0x5f,
+	0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x18, 0x12,
+	0x27, 0x0a, 0x23, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x46, 0x45, 0x43,
+	0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x45, 0x44, 0x5f, 0x43, 0x4f, 0x44, 0x45, 0x57, 0x4f, 0x52,
+	0x44, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x19, 0x12, 0x1c, 0x0a, 0x18, 0x49, 0x46, 0x4f, 0x45,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x50, 0x45, 0x52, 0x5f, 0x53, 0x54,
+	0x41, 0x54, 0x55, 0x53, 0x10, 0x1a, 0x12, 0x24, 0x0a, 0x20, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45,
+	0x5f, 0x42, 0x41, 0x4e, 0x44, 0x57, 0x49, 0x44, 0x54, 0x48, 0x10, 0x1b, 0x12, 0x1d, 0x0a, 0x19,
+	0x49, 0x46, 0x4f, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x45, 0x5f, 0x50, 0x4f, 0x52, 0x54, 0x53, 0x10, 0x1c, 0x12, 0x23, 0x0a, 0x1f, 0x49,
+	0x46, 0x4f, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x41, 0x49, 0x4c,
+	0x45, 0x44, 0x4f, 0x56, 0x45, 0x52, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x53, 0x10, 0x1d,
+	0x12, 0x1f, 0x0a, 0x1b, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x50, 0x41, 0x55, 0x53, 0x45, 0x44, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x53, 0x10,
+	0x1e, 0x12, 0x2a, 0x0a, 0x26, 0x49, 0x46, 0x4f, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x49, 0x4f,
+	0x4e, 0x5f, 0x46, 0x45, 0x43, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x45, 0x44, 0x5f, 0x43, 0x4f,
+	0x44, 0x45, 0x57, 0x4f, 0x52, 0x44, 0x5f, 0x52, 0x41, 0x54, 0x45, 0x10, 0x1f, 0x42, 0x15, 0x5a,
+	0x13, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4076,55 +5418,93 @@ func file_exporterconfig_proto_rawDescGZIP() []byte {
 }
 
 var file_exporterconfig_proto_enumTypes = make([]protoimpl.EnumInfo, 7)
-var file_exporterconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_exporterconfig_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
 var file_exporterconfig_proto_goTypes = []any{
-	(MetricLabel)(0),             // 0: exportermetrics.MetricLabel
-	(GPUMetricField)(0),          // 1: exportermetrics.GPUMetricField
-	(GPUMetricLabel)(0),          // 2: exportermetrics.GPUMetricLabel
-	(LogLevel)(0),                // 3: exportermetrics.LogLevel
-	(NICMetricField)(0),          // 4: exportermetrics.NICMetricField
-	(NICMetricLabel)(0),          // 5: exportermetrics.NICMetricLabel
-	(IFOEMetricField)(0),         // 6: exportermetrics.IFOEMetricField
-	(*GPUHealthThresholds)(nil),  // 7: exportermetrics.GPUHealthThresholds
-	(*GPUMetricConfig)(nil),      // 8: exportermetrics.GPUMetricConfig
-	(*ProfilerConfig)(nil),       // 9: exportermetrics.ProfilerConfig
-	(*HealthServiceConfig)(nil),  // 10: exportermetrics.HealthServiceConfig
-	(*LoggingConfig)(nil),        // 11: exportermetrics.LoggingConfig
-	(*CommonConfig)(nil),         // 12: exportermetrics.CommonConfig
-	(*NICMetricConfig)(nil),      // 13: exportermetrics.NICMetricConfig
-	(*NICHealthCheckConfig)(nil), // 14: exportermetrics.NICHealthCheckConfig
-	(*IFOEMetricConfig)(nil),     // 15: exportermetrics.IFOEMetricConfig
-	(*MetricConfig)(nil),         // 16: exportermetrics.MetricConfig
-	nil,                          // 17: exportermetrics.GPUMetricConfig.CustomLabelsEntry
-	nil,                          // 18: exportermetrics.GPUMetricConfig.ExtraPodLabelsEntry
-	nil,                          // 19: exportermetrics.GPUMetricConfig.ProfilerMetricsEntry
-	nil,                          // 20: exportermetrics.NICMetricConfig.CustomLabelsEntry
-	nil,                          // 21: exportermetrics.NICMetricConfig.ExtraPodLabelsEntry
-	nil,                          // 22: exportermetrics.IFOEMetricConfig.CustomLabelsEntry
-	nil,                          // 23: exportermetrics.IFOEMetricConfig.ExtraPodLabelsEntry
+	(MetricLabel)(0),                 // 0: exportermetrics.MetricLabel
+	(GPUMetricField)(0),              // 1: exportermetrics.GPUMetricField
+	(GPUMetricLabel)(0),              // 2: exportermetrics.GPUMetricLabel
+	(LogLevel)(0),                    // 3: exportermetrics.LogLevel
+	(NICMetricField)(0),              // 4: exportermetrics.NICMetricField
+	(NICMetricLabel)(0),              // 5: exportermetrics.NICMetricLabel
+	(IFOEMetricField)(0),             // 6: exportermetrics.IFOEMetricField
+	(*GPUHealthThresholds)(nil),      // 7: exportermetrics.GPUHealthThresholds
+	(*GPUMetricConfig)(nil),          // 8: exportermetrics.GPUMetricConfig
+	(*ProfilerConfig)(nil),           // 9: exportermetrics.ProfilerConfig
+	(*ProfilerOptOutConfig)(nil),     // 10: exportermetrics.ProfilerOptOutConfig
+	(*ProfilerSchedulerConfig)(nil),  // 11: exportermetrics.ProfilerSchedulerConfig
+	(*ProfilerCounterGroup)(nil),     // 12: exportermetrics.ProfilerCounterGroup
+	(*HealthServiceConfig)(nil),      // 13: exportermetrics.HealthServiceConfig
+	(*LoggingConfig)(nil),            // 14: exportermetrics.LoggingConfig
+	(*CommonConfig)(nil),             // 15: exportermetrics.CommonConfig
+	(*NICMetricConfig)(nil),          // 16: exportermetrics.NICMetricConfig
+	(*NICTransceiverConfig)(nil),     // 17: exportermetrics.NICTransceiverConfig
+	(*NICTransceiverThresholds)(nil), // 18: exportermetrics.NICTransceiverThresholds
+	(*NICHealthCheckConfig)(nil),     // 19: exportermetrics.NICHealthCheckConfig
+	(*NICHealthCounterRule)(nil),     // 20: exportermetrics.NICHealthCounterRule
+	(*IFOEMetricConfig)(nil),         // 21: exportermetrics.IFOEMetricConfig
+	(*IFOEHealthCheckConfig)(nil),    // 22: exportermetrics.IFOEHealthCheckConfig
+	(*MetricConfig)(nil),             // 23: exportermetrics.MetricConfig
+	nil,                              // 24: exportermetrics.GPUMetricConfig.CustomLabelsEntry
+	nil,                              // 25: exportermetrics.GPUMetricConfig.ExtraPodLabelsEntry
+	nil,                              // 26: exportermetrics.GPUMetricConfig.ProfilerMetricsEntry
+	nil,                              // 27: exportermetrics.GPUMetricConfig.ExtraWorkloadLabelsEntry
+	nil,                              // 28: exportermetrics.GPUMetricConfig.ExtraPodAnnotationsEntry
+	nil,                              // 29: exportermetrics.GPUMetricConfig.ExtraNamespaceLabelsEntry
+	nil,                              // 30: exportermetrics.GPUMetricConfig.OwnerKindLabelEntry
+	nil,                              // 31: exportermetrics.NICMetricConfig.CustomLabelsEntry
+	nil,                              // 32: exportermetrics.NICMetricConfig.ExtraPodLabelsEntry
+	nil,                              // 33: exportermetrics.NICMetricConfig.ExtraPodAnnotationsEntry
+	nil,                              // 34: exportermetrics.NICMetricConfig.ExtraNamespaceLabelsEntry
+	nil,                              // 35: exportermetrics.NICMetricConfig.OwnerKindLabelEntry
+	nil,                              // 36: exportermetrics.IFOEMetricConfig.CustomLabelsEntry
+	nil,                              // 37: exportermetrics.IFOEMetricConfig.ExtraPodLabelsEntry
+	nil,                              // 38: exportermetrics.IFOEMetricConfig.ExtraPodAnnotationsEntry
+	nil,                              // 39: exportermetrics.IFOEMetricConfig.ExtraNamespaceLabelsEntry
+	nil,                              // 40: exportermetrics.IFOEMetricConfig.OwnerKindLabelEntry
 }
 var file_exporterconfig_proto_depIdxs = []int32{
 	7,  // 0: exportermetrics.GPUMetricConfig.HealthThresholds:type_name -> exportermetrics.GPUHealthThresholds
-	17, // 1: exportermetrics.GPUMetricConfig.CustomLabels:type_name -> exportermetrics.GPUMetricConfig.CustomLabelsEntry
-	18, // 2: exportermetrics.GPUMetricConfig.ExtraPodLabels:type_name -> exportermetrics.GPUMetricConfig.ExtraPodLabelsEntry
-	19, // 3: exportermetrics.GPUMetricConfig.ProfilerMetrics:type_name -> exportermetrics.GPUMetricConfig.ProfilerMetricsEntry
+	24, // 1: exportermetrics.GPUMetricConfig.CustomLabels:type_name -> exportermetrics.GPUMetricConfig.CustomLabelsEntry
+	25, // 2: exportermetrics.GPUMetricConfig.ExtraPodLabels:type_name -> exportermetrics.GPUMetricConfig.ExtraPodLabelsEntry
+	26, // 3: exportermetrics.GPUMetricConfig.ProfilerMetrics:type_name -> exportermetrics.GPUMetricConfig.ProfilerMetricsEntry
 	9,  // 4: exportermetrics.GPUMetricConfig.ProfilerConfig:type_name -> exportermetrics.ProfilerConfig
-	10, // 5: exportermetrics.CommonConfig.HealthService:type_name -> exportermetrics.HealthServiceConfig
-	11, // 6: exportermetrics.CommonConfig.Logging:type_name -> exportermetrics.LoggingConfig
-	20, // 7: exportermetrics.NICMetricConfig.CustomLabels:type_name -> exportermetrics.NICMetricConfig.CustomLabelsEntry
-	14, // 8: exportermetrics.NICMetricConfig.HealthCheckConfig:type_name -> exportermetrics.NICHealthCheckConfig
-	21, // 9: exportermetrics.NICMetricConfig.ExtraPodLabels:type_name -> exportermetrics.NICMetricConfig.ExtraPodLabelsEntry
-	22, // 10: exportermetrics.IFOEMetricConfig.CustomLabels:type_name -> exportermetrics.IFOEMetricConfig.CustomLabelsEntry
-	23, // 11: exportermetrics.IFOEMetricConfig.ExtraPodLabels:type_name -> exportermetrics.IFOEMetricConfig.ExtraPodLabelsEntry
-	8,  // 12: exportermetrics.MetricConfig.GPUConfig:type_name -> exportermetrics.GPUMetricConfig
-	12, // 13: exportermetrics.MetricConfig.CommonConfig:type_name -> exportermetrics.CommonConfig
-	13, // 14: exportermetrics.MetricConfig.NICConfig:type_name -> exportermetrics.NICMetricConfig
-	15, // 15: exportermetrics.MetricConfig.IFOEConfig:type_name -> exportermetrics.IFOEMetricConfig
-	16, // [16:16] is the sub-list for method output_type
-	16, // [16:16] is the sub-list for method input_type
-	16, // [16:16] is the sub-list for extension type_name
-	16, // [16:16] is the sub-list for extension extendee
-	0,  // [0:16] is the sub-list for field type_name
+	27, // 5: exportermetrics.GPUMetricConfig.ExtraWorkloadLabels:type_name -> exportermetrics.GPUMetricConfig.ExtraWorkloadLabelsEntry
+	28, // 6: exportermetrics.GPUMetricConfig.ExtraPodAnnotations:type_name -> exportermetrics.GPUMetricConfig.ExtraPodAnnotationsEntry
+	29, // 7: exportermetrics.GPUMetricConfig.ExtraNamespaceLabels:type_name -> exportermetrics.GPUMetricConfig.ExtraNamespaceLabelsEntry
+	30, // 8: exportermetrics.GPUMetricConfig.OwnerKindLabel:type_name -> exportermetrics.GPUMetricConfig.OwnerKindLabelEntry
+	12, // 9: exportermetrics.ProfilerConfig.CounterGroups:type_name -> exportermetrics.ProfilerCounterGroup
+	11, // 10: exportermetrics.ProfilerConfig.Scheduler:type_name -> exportermetrics.ProfilerSchedulerConfig
+	10, // 11: exportermetrics.ProfilerConfig.OptOut:type_name -> exportermetrics.ProfilerOptOutConfig
+	13, // 12: exportermetrics.CommonConfig.HealthService:type_name -> exportermetrics.HealthServiceConfig
+	14, // 13: exportermetrics.CommonConfig.Logging:type_name -> exportermetrics.LoggingConfig
+	31, // 14: exportermetrics.NICMetricConfig.CustomLabels:type_name -> exportermetrics.NICMetricConfig.CustomLabelsEntry
+	19, // 15: exportermetrics.NICMetricConfig.HealthCheckConfig:type_name -> exportermetrics.NICHealthCheckConfig
+	32, // 16: exportermetrics.NICMetricConfig.ExtraPodLabels:type_name -> exportermetrics.NICMetricConfig.ExtraPodLabelsEntry
+	33, // 17: exportermetrics.NICMetricConfig.ExtraPodAnnotations:type_name -> exportermetrics.NICMetricConfig.ExtraPodAnnotationsEntry
+	34, // 18: exportermetrics.NICMetricConfig.ExtraNamespaceLabels:type_name -> exportermetrics.NICMetricConfig.ExtraNamespaceLabelsEntry
+	35, // 19: exportermetrics.NICMetricConfig.OwnerKindLabel:type_name -> exportermetrics.NICMetricConfig.OwnerKindLabelEntry
+	17, // 20: exportermetrics.NICMetricConfig.TransceiverConfig:type_name -> exportermetrics.NICTransceiverConfig
+	18, // 21: exportermetrics.NICTransceiverConfig.WarningThresholds:type_name -> exportermetrics.NICTransceiverThresholds
+	20, // 22: exportermetrics.NICHealthCheckConfig.LinkFlap:type_name -> exportermetrics.NICHealthCounterRule
+	20, // 23: exportermetrics.NICHealthCheckConfig.RSFECUncorrectable:type_name -> exportermetrics.NICHealthCounterRule
+	20, // 24: exportermetrics.NICHealthCheckConfig.RDMARetryExceeded:type_name -> exportermetrics.NICHealthCounterRule
+	20, // 25: exportermetrics.NICHealthCheckConfig.RDMALocalAckTimeout:type_name -> exportermetrics.NICHealthCounterRule
+	20, // 26: exportermetrics.NICHealthCheckConfig.PFCPauseStorm:type_name -> exportermetrics.NICHealthCounterRule
+	36, // 27: exportermetrics.IFOEMetricConfig.CustomLabels:type_name -> exportermetrics.IFOEMetricConfig.CustomLabelsEntry
+	37, // 28: exportermetrics.IFOEMetricConfig.ExtraPodLabels:type_name -> exportermetrics.IFOEMetricConfig.ExtraPodLabelsEntry
+	38, // 29: exportermetrics.IFOEMetricConfig.ExtraPodAnnotations:type_name -> exportermetrics.IFOEMetricConfig.ExtraPodAnnotationsEntry
+	39, // 30: exportermetrics.IFOEMetricConfig.ExtraNamespaceLabels:type_name -> exportermetrics.IFOEMetricConfig.ExtraNamespaceLabelsEntry
+	40, // 31: exportermetrics.IFOEMetricConfig.OwnerKindLabel:type_name -> exportermetrics.IFOEMetricConfig.OwnerKindLabelEntry
+	22, // 32: exportermetrics.IFOEMetricConfig.HealthCheckConfig:type_name -> exportermetrics.IFOEHealthCheckConfig
+	8,  // 33: exportermetrics.MetricConfig.GPUConfig:type_name -> exportermetrics.GPUMetricConfig
+	15, // 34: exportermetrics.MetricConfig.CommonConfig:type_name -> exportermetrics.CommonConfig
+	16, // 35: exportermetrics.MetricConfig.NICConfig:type_name -> exportermetrics.NICMetricConfig
+	21, // 36: exportermetrics.MetricConfig.IFOEConfig:type_name -> exportermetrics.IFOEMetricConfig
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
 }
 
 func init() { file_exporterconfig_proto_init() }
@@ -4170,7 +5550,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[3].Exporter = func(v any, i int) any {
-			switch v := v.(*HealthServiceConfig); i {
+			switch v := v.(*ProfilerOptOutConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4182,7 +5562,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[4].Exporter = func(v any, i int) any {
-			switch v := v.(*LoggingConfig); i {
+			switch v := v.(*ProfilerSchedulerConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4194,7 +5574,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[5].Exporter = func(v any, i int) any {
-			switch v := v.(*CommonConfig); i {
+			switch v := v.(*ProfilerCounterGroup); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4206,7 +5586,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[6].Exporter = func(v any, i int) any {
-			switch v := v.(*NICMetricConfig); i {
+			switch v := v.(*HealthServiceConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4218,7 +5598,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[7].Exporter = func(v any, i int) any {
-			switch v := v.(*NICHealthCheckConfig); i {
+			switch v := v.(*LoggingConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4230,7 +5610,7 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[8].Exporter = func(v any, i int) any {
-			switch v := v.(*IFOEMetricConfig); i {
+			switch v := v.(*CommonConfig); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4242,6 +5622,90 @@ func file_exporterconfig_proto_init() {
 			}
 		}
 		file_exporterconfig_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*NICMetricConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*NICTransceiverConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*NICTransceiverThresholds); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*NICHealthCheckConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*NICHealthCounterRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*IFOEMetricConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*IFOEHealthCheckConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exporterconfig_proto_msgTypes[16].Exporter = func(v any, i int) any {
 			switch v := v.(*MetricConfig); i {
 			case 0:
 				return &v.state
@@ -4260,7 +5724,7 @@ func file_exporterconfig_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_exporterconfig_proto_rawDesc,
 			NumEnums:      7,
-			NumMessages:   17,
+			NumMessages:   34,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
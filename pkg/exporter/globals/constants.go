@@ -34,10 +34,18 @@ const (
 
 	SlurmDir = "/var/run/exporter/"
 
+	// PBSDir - directory where the PBS Pro hook drops per GPU job files
+	PBSDir = "/var/run/exporter/pbs/"
+
+	// WorkloadDir - directory of generic workload descriptors (json/yaml)
+	WorkloadDir = "/var/run/exporter/workloads/"
+
 	MetricsSocketPath = "/var/lib/amd-metrics-exporter/amdgpu_device_metrics_exporter_grpc.socket"
 
 	NICMetricsSocketPath = "/var/lib/amd-metrics-exporter/amdnic_device_metrics_exporter_grpc.socket"
 
+	IFOEMetricsSocketPath = "/var/lib/amd-metrics-exporter/amdifoe_device_metrics_exporter_grpc.socket"
+
 	//PodResourceSocket - k8s pod grpc socket
 	PodResourceSocket = "/var/lib/kubelet/pod-resources/kubelet.sock"
 
@@ -50,6 +58,19 @@ const (
 	// max number of extra pod labels that will be exported in the logs
 	MaxSupportedPodLabels = 20
 
+	// max number of generic workload labels that will be exported in the logs
+	MaxSupportedWorkloadLabels = 10
+
+	// default number of processes per gpu exported in process metrics
+	DefaultProcessMetricsTopN = 16
+
+	// default minimum symbol errors of the codewords counted by the IFOE
+	// FEC symbol error health check
+	DefaultIFOEFECSymbolErrorBin = 8
+
+	// HostProcRoot - host procfs mount point in the exporter container
+	HostProcRoot = "/host/proc"
+
 	// amdgpuhealth tool log file name
 	GPUHealthCheckerLogFile = "amdgpuhealth.log"
 
@@ -83,6 +104,9 @@ const (
 	// NICHealthLabelPrefix - prefix for NIC health labels
 	NICHealthLabelPrefix = "metricsexporter.amd.com.nic"
 
+	// IFOEHealthLabelPrefix - prefix for IFOE health labels
+	IFOEHealthLabelPrefix = "metricsexporter.amd.com.ifoe"
+
 	// Kube RBAC Proxy port name
 	KubeRBACProxyPortName = "exporter-port"
 
@@ -91,6 +115,9 @@ const (
 
 	// AMDGPUInbandRASHandlerPrefix - endpoint to get inband RAS errors in JSON format
 	AMDGPUInbandRASHandlerPrefix = "/inbandraserrors"
+
+	// TopologyHandlerPrefix - endpoint to get the GPU/NIC PCIe and NUMA topology in JSON format
+	TopologyHandlerPrefix = "/topology"
 )
 
 type DeviceType string
@@ -106,6 +133,44 @@ type DebugMode string
 const (
 	DebugModeNone DebugMode = ""
 	DebugModeQP   DebugMode = "qp"  // Export Per-QP debug metrics
-	DebugModeLIF  DebugMode = "lif" // Placeholder for exporting LIF debug metrics
-	DebugModeAll  DebugMode = "all" // Placeholder for exporting ALL debug metrics
+	DebugModeLIF  DebugMode = "lif" // Export all LIF and LIF aggregated QP metrics
+	DebugModeAll  DebugMode = "all" // Export all of the above debug metrics
 )
+
+// IncludesQP returns true if the mode exports the per-QP metrics
+func (m DebugMode) IncludesQP() bool {
+	return m == DebugModeQP || m == DebugModeAll
+}
+
+// IncludesLIF returns true if the mode exports the LIF metrics
+func (m DebugMode) IncludesLIF() bool {
+	return m == DebugModeLIF || m == DebugModeAll
+}
+
+// IsValid returns true for the supported debug modes
+func (m DebugMode) IsValid() bool {
+	switch m {
+	case DebugModeNone, DebugModeQP, DebugModeLIF, DebugModeAll:
+		return true
+	}
+	return false
+}
+
+// SysfsCollectorMode selects when the GPU metrics are read from the amdgpu
+// sysfs and hwmon attributes instead of gpuagent
+type SysfsCollectorMode string
+
+const (
+	SysfsCollectorDisabled SysfsCollectorMode = ""
+	SysfsCollectorFallback SysfsCollectorMode = "fallback" // used while gpuagent is unavailable
+	SysfsCollectorPrimary  SysfsCollectorMode = "primary"  // used instead of gpuagent
+)
+
+// IsValid returns true for the supported sysfs collector modes
+func (m SysfsCollectorMode) IsValid() bool {
+	switch m {
+	case SysfsCollectorDisabled, SysfsCollectorFallback, SysfsCollectorPrimary:
+		return true
+	}
+	return false
+}
@@ -23,15 +23,35 @@ type contextKey string
 
 const debugModeContextKey contextKey = "debugMode"
 
+// DebugOptions narrow down the metrics exported in a debug mode
+type DebugOptions struct {
+	Mode      DebugMode
+	TopK      int    // number of queue pairs exported, 0 exports all
+	SortBy    string // QP field the queue pairs are ranked by for TopK
+	Pod       string // only the queue pairs of the pod
+	Namespace string // only the queue pairs of the pods in the namespace
+}
+
 // WithDebugMode returns a new context with the debug mode value
 func WithDebugMode(ctx context.Context, mode DebugMode) context.Context {
-	return context.WithValue(ctx, debugModeContextKey, mode)
+	return WithDebugOptions(ctx, DebugOptions{Mode: mode})
+}
+
+// WithDebugOptions returns a new context with the debug mode and its options
+func WithDebugOptions(ctx context.Context, opts DebugOptions) context.Context {
+	return context.WithValue(ctx, debugModeContextKey, opts)
 }
 
 // GetDebugMode extracts the debug mode from context, returns DebugModeNone if not found
 func GetDebugMode(ctx context.Context) DebugMode {
-	if mode, ok := ctx.Value(debugModeContextKey).(DebugMode); ok {
-		return mode
+	return GetDebugOptions(ctx).Mode
+}
+
+// GetDebugOptions extracts the debug options from context, the mode is
+// DebugModeNone if not found
+func GetDebugOptions(ctx context.Context) DebugOptions {
+	if opts, ok := ctx.Value(debugModeContextKey).(DebugOptions); ok {
+		return opts
 	}
-	return DebugModeNone
+	return DebugOptions{Mode: DebugModeNone}
 }
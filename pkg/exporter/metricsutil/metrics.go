@@ -33,9 +33,10 @@ import (
 )
 
 type MetricsHandler struct {
-	reg     *prometheus.Registry
-	runConf *config.ConfigHandler
-	clients []MetricsInterface
+	reg        *prometheus.Registry
+	runConf    *config.ConfigHandler
+	clients    []MetricsInterface
+	collectors []prometheus.Collector // registered again on every config init
 }
 
 func NewMetrics(c *config.ConfigHandler) (*MetricsHandler, error) {
@@ -69,11 +70,28 @@ func (mh *MetricsHandler) RegisterMetricsClient(client MetricsInterface) {
 	mh.clients = append(mh.clients, client)
 }
 
+// RegisterCollector adds a collector not owned by a metrics client, it is
+// kept registered across config reloads
+func (mh *MetricsHandler) RegisterCollector(c prometheus.Collector) {
+	mh.collectors = append(mh.collectors, c)
+	if mh.reg == nil {
+		return
+	}
+	if err := mh.RegisterMetric(c); err != nil {
+		logger.Log.Printf("failed to register collector: %v", err)
+	}
+}
+
 func (mh *MetricsHandler) InitConfig(ctx context.Context) {
 	mh.reg = prometheus.NewRegistry()
 	if err := mh.runConf.RefreshConfig(); err != nil {
 		logger.Log.Printf("failed to refresh config: %v", err)
 	}
+	for _, c := range mh.collectors {
+		if err := mh.RegisterMetric(c); err != nil {
+			logger.Log.Printf("failed to register collector: %v", err)
+		}
+	}
 	var wg sync.WaitGroup
 	for _, client := range mh.clients {
 		wg.Add(1)
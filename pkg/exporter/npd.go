@@ -47,13 +47,16 @@ func removeIfExists(path string) {
 	}
 }
 
-func cleanupResources(enableGPUMonitoring, enableNICMonitoring bool) {
+func cleanupResources(enableGPUMonitoring, enableNICMonitoring, enableIFOEMonitoring bool) {
 	if enableGPUMonitoring {
 		removeIfExists(globals.MetricsSocketPath)
 	}
 	if enableNICMonitoring {
 		removeIfExists(globals.NICMetricsSocketPath)
 	}
+	if enableIFOEMonitoring {
+		removeIfExists(globals.IFOEMetricsSocketPath)
+	}
 	if utils.IsKubernetes() {
 		removeIfExists(filepath.Join(globals.AMDGPUHealthHostDirPath, filepath.Base(globals.AMDGPUHealthContainerPath)))
 	}
@@ -0,0 +1,67 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// cacheCollector exports the refresh state of a scheduler workload cache,
+// the values are computed at scrape time
+type cacheCollector struct {
+	cache         WorkloadCache
+	schedulerName string
+	age           *prometheus.Desc
+	refreshErrors *prometheus.Desc
+}
+
+// NewCacheCollector returns a prometheus collector for the workload cache
+// of the scheduler client, nil if the client is not cached
+func NewCacheCollector(cl SchedulerClient, constLabels prometheus.Labels) prometheus.Collector {
+	cache, ok := cl.(WorkloadCache)
+	if !ok {
+		return nil
+	}
+	return &cacheCollector{
+		cache:         cache,
+		schedulerName: strings.ToLower(cl.Type().String()),
+		age: prometheus.NewDesc("scheduler_cache_age_seconds",
+			"Seconds since the last successful refresh of the scheduler workload cache, -1 if never refreshed",
+			[]string{"scheduler"}, constLabels),
+		refreshErrors: prometheus.NewDesc("scheduler_cache_refresh_errors_total",
+			"Number of failed refreshes of the scheduler workload cache",
+			[]string{"scheduler"}, constLabels),
+	}
+}
+
+func (c *cacheCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.age
+	ch <- c.refreshErrors
+}
+
+func (c *cacheCollector) Collect(ch chan<- prometheus.Metric) {
+	stats := c.cache.CacheStats()
+	age := -1.0
+	if !stats.LastRefresh.IsZero() {
+		age = time.Since(stats.LastRefresh).Seconds()
+	}
+	ch <- prometheus.MustNewConstMetric(c.age, prometheus.GaugeValue, age, c.schedulerName)
+	ch <- prometheus.MustNewConstMetric(c.refreshErrors, prometheus.CounterValue, float64(stats.RefreshErrors), c.schedulerName)
+}
@@ -0,0 +1,384 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const (
+	// containerPollInterval is how often the runtime is queried for gpu containers
+	containerPollInterval = 15 * time.Second
+	// containerCmdTimeout bounds each runtime api call
+	containerCmdTimeout = 5 * time.Second
+
+	// compose project label set by docker compose and nerdctl compose
+	composeProjectLabel = "com.docker.compose.project"
+	// containers managed by kubelet are attributed by the k8s scheduler client
+	k8sPodNameLabel = "io.kubernetes.pod.name"
+	// container name annotation set by nerdctl in the runtime spec
+	nerdctlNameAnnotation = "nerdctl/name"
+
+	// containerd task bundles directory below the containerd state dir, and
+	// the namespaces used by the CRI plugin and docker
+	containerdTaskDir = "io.containerd.runtime.v2.task"
+	criNamespace      = "k8s.io"
+	dockerNamespace   = "moby"
+
+	kfdDevice     = "/dev/kfd"
+	driDir        = "/dev/dri"
+	renderPrefix  = "renderD"
+	amdCDIPrefix  = "amd.com/gpu="
+	amdCDIAllGPUs = "all"
+)
+
+// well known runtime sockets, /host prefixed paths are used when the host
+// root is mounted in the exporter container
+var (
+	DockerRuntimeSockets = []string{
+		"/var/run/docker.sock",
+		"/host/var/run/docker.sock",
+	}
+	CRIRuntimeSockets = []string{
+		"/run/containerd/containerd.sock",
+		"/host/run/containerd/containerd.sock",
+		"/run/crio/crio.sock",
+		"/host/run/crio/crio.sock",
+	}
+	ContainerdTaskDirs = []string{
+		"/run/containerd/" + containerdTaskDir,
+		"/host/run/containerd/" + containerdTaskDir,
+	}
+)
+
+var ContainerLabels = map[string]bool{
+	exportermetrics.MetricLabel_CONTAINER.String():          true,
+	exportermetrics.GPUMetricLabel_CONTAINER_IMAGE.String(): true,
+	exportermetrics.GPUMetricLabel_COMPOSE_PROJECT.String(): true,
+}
+
+// gpuContainer is a running container with the devices it was given
+type gpuContainer struct {
+	info       ContainerInfo
+	devices    []string // host device paths
+	cdi        []string // cdi device names
+	gpus       []string // gpu indices requested with --gpus, "all" for every gpu
+	privileged bool     // holds every host device
+}
+
+// containerRuntime lists running containers from a runtime api
+type containerRuntime interface {
+	Name() string
+	ListContainers(ctx context.Context) ([]gpuContainer, error)
+	Close()
+}
+
+type containerClient struct {
+	sync.Mutex
+	GpuContainers map[string]ContainerInfo // render id or gpu index -> containers
+	rt            containerRuntime
+	devRoot       string // root of the /dev tree, replaced in tests
+	selfId        string // exporter container id prefix (hostname)
+	ctx           context.Context
+	cancel        context.CancelFunc
+}
+
+// NewContainerClient creates a scheduler client that attributes gpus to the
+// docker or CRI containers holding the gpu device nodes. socket selects the
+// runtime api socket, when empty the well known sockets are probed.
+func NewContainerClient(ctx context.Context, socket string) (SchedulerClient, error) {
+	rt, err := detectContainerRuntime(ctx, socket)
+	if err != nil {
+		return nil, err
+	}
+	// docker sets the hostname to the short container id, skip our own
+	// container which holds all the gpu devices
+	selfId, _ := os.Hostname()
+	if !isShortContainerId(selfId) {
+		selfId = ""
+	}
+	cl := newContainerClient(ctx, rt, "/", selfId)
+	go cl.pollContainers()
+	logger.Log.Printf("created container scheduler client using %v", rt.Name())
+	return cl, nil
+}
+
+func newContainerClient(ctx context.Context, rt containerRuntime, root, selfId string) *containerClient {
+	ctx, cancel := context.WithCancel(ctx)
+	return &containerClient{
+		GpuContainers: make(map[string]ContainerInfo),
+		rt:            rt,
+		devRoot:       root,
+		selfId:        selfId,
+		ctx:           ctx,
+		cancel:        cancel,
+	}
+}
+
+func isShortContainerId(id string) bool {
+	if len(id) != 12 {
+		return false
+	}
+	for _, c := range id {
+		if !strings.ContainsRune("0123456789abcdef", c) {
+			return false
+		}
+	}
+	return true
+}
+
+// detectContainerRuntime returns the first socket answering the docker or
+// CRI api, combined with the containerd task bundles when present so the
+// containers of the other containerd namespaces are listed as well
+func detectContainerRuntime(ctx context.Context, socket string) (containerRuntime, error) {
+	dockerSockets, criSockets, taskDirs := DockerRuntimeSockets, CRIRuntimeSockets, ContainerdTaskDirs
+	if socket != "" {
+		dockerSockets, criSockets = []string{socket}, []string{socket}
+		taskDirs = []string{path.Join(path.Dir(socket), containerdTaskDir)}
+	}
+	var rt containerRuntime
+	// pods are attributed by the k8s scheduler client
+	skipNamespaces := map[string]bool{criNamespace: true}
+	for _, s := range dockerSockets {
+		if _, err := os.Stat(s); err != nil {
+			continue
+		}
+		drt := newDockerRuntime(s)
+		if err := drt.Ping(ctx); err != nil {
+			logger.Log.Printf("docker api not available on %v, %v", s, err)
+			drt.Close()
+			continue
+		}
+		rt = drt
+		skipNamespaces[dockerNamespace] = true
+		break
+	}
+	for _, s := range criSockets {
+		if rt != nil {
+			break
+		}
+		if _, err := os.Stat(s); err != nil {
+			continue
+		}
+		crt, err := newCRIRuntime(ctx, s)
+		if err != nil {
+			logger.Log.Printf("cri api not available on %v, %v", s, err)
+			continue
+		}
+		rt = crt
+	}
+	for _, dir := range taskDirs {
+		if _, err := os.Stat(dir); err != nil {
+			continue
+		}
+		tasks := newContainerdTaskRuntime(dir, skipNamespaces)
+		if rt == nil {
+			return tasks, nil
+		}
+		return multiRuntime{rt, tasks}, nil
+	}
+	if rt != nil {
+		return rt, nil
+	}
+	if socket != "" {
+		return nil, fmt.Errorf("no docker or cri runtime api on %v", socket)
+	}
+	probed := append(append(append([]string{}, dockerSockets...), criSockets...), taskDirs...)
+	return nil, fmt.Errorf("no container runtime found at %v", probed)
+}
+
+func (cl *containerClient) pollContainers() {
+	ticker := time.NewTicker(containerPollInterval)
+	defer ticker.Stop()
+	for {
+		if err := cl.refresh(); err != nil {
+			logger.Log.Printf("container refresh failed, %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-cl.ctx.Done():
+			logger.Log.Printf("container poller stopped")
+			return
+		}
+	}
+}
+
+// refresh rebuilds the gpu to container map from the running containers
+func (cl *containerClient) refresh() error {
+	ctx, cancel := context.WithTimeout(cl.ctx, containerCmdTimeout)
+	defer cancel()
+	ctrs, err := cl.rt.ListContainers(ctx)
+	if err != nil {
+		return err
+	}
+	sort.Slice(ctrs, func(i, j int) bool {
+		return ctrs[i].info.Name < ctrs[j].info.Name
+	})
+
+	gpuCtrs := make(map[string]ContainerInfo)
+	for _, ctr := range ctrs {
+		if cl.selfId != "" && strings.HasPrefix(ctr.info.Id, cl.selfId) {
+			continue
+		}
+		for _, key := range cl.containerGPUKeys(ctr) {
+			gpuCtrs[key] = mergeContainerInfo(gpuCtrs[key], ctr.info)
+		}
+	}
+
+	cl.Lock()
+	cl.GpuContainers = gpuCtrs
+	cl.Unlock()
+	logger.Debugf("gpu containers %v", gpuCtrs)
+	return nil
+}
+
+// containerGPUKeys returns the render ids (or gpu indices for cdi devices)
+// of the gpus the container can run on, a container needs /dev/kfd along
+// with the render node to use the gpu for compute, privileged containers can
+// use every gpu
+func (cl *containerClient) containerGPUKeys(ctr gpuContainer) []string {
+	if ctr.privileged {
+		return cl.renderIDs()
+	}
+	hasKFD := false
+	keys := []string{}
+	for _, dev := range ctr.devices {
+		dev = path.Clean(dev)
+		switch {
+		case dev == kfdDevice:
+			hasKFD = true
+		case dev == driDir:
+			keys = append(keys, cl.renderIDs()...)
+		case strings.HasPrefix(dev, driDir+"/"+renderPrefix):
+			keys = append(keys, strings.TrimPrefix(path.Base(dev), renderPrefix))
+		}
+	}
+	for _, dev := range ctr.cdi {
+		gpu, ok := strings.CutPrefix(dev, amdCDIPrefix)
+		if !ok {
+			continue
+		}
+		// amd cdi specs include /dev/kfd with every gpu
+		hasKFD = true
+		keys = append(keys, cl.gpuKeys(gpu)...)
+	}
+	for _, gpu := range ctr.gpus {
+		// the gpu runtime adds /dev/kfd with the requested gpus
+		hasKFD = true
+		keys = append(keys, cl.gpuKeys(gpu)...)
+	}
+	if !hasKFD {
+		return nil
+	}
+	return keys
+}
+
+// gpuKeys returns the key of a gpu index, or the render ids of every gpu
+// for "all"
+func (cl *containerClient) gpuKeys(gpu string) []string {
+	if gpu == amdCDIAllGPUs {
+		return cl.renderIDs()
+	}
+	return []string{gpu}
+}
+
+// renderIDs lists the render node ids on the host
+func (cl *containerClient) renderIDs() []string {
+	ids := []string{}
+	fds, err := os.ReadDir(path.Join(cl.devRoot, driDir))
+	if err != nil {
+		return ids
+	}
+	for _, fd := range fds {
+		if id, ok := strings.CutPrefix(fd.Name(), renderPrefix); ok {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func mergeContainerInfo(cur, add ContainerInfo) ContainerInfo {
+	join := func(a, b string) string {
+		if a == "" {
+			return b
+		}
+		if b == "" {
+			return a
+		}
+		for _, v := range strings.Split(a, ",") {
+			if v == b {
+				return a
+			}
+		}
+		return a + "," + b
+	}
+	return ContainerInfo{
+		Id:      join(cur.Id, add.Id),
+		Name:    join(cur.Name, add.Name),
+		Image:   join(cur.Image, add.Image),
+		Project: join(cur.Project, add.Project),
+		Runtime: join(cur.Runtime, add.Runtime),
+	}
+}
+
+// ListWorkloads - returns the containers holding the gpus, the key is the
+// render id or the gpu index for cdi devices
+func (cl *containerClient) ListWorkloads() (map[string]Workload, error) {
+	wls := make(map[string]Workload)
+	cl.Lock()
+	defer cl.Unlock()
+	for k, v := range cl.GpuContainers {
+		wls[k] = Workload{
+			Type: Container,
+			Info: v,
+		}
+	}
+	return wls, nil
+}
+
+func (cl *containerClient) CheckExportLabels(labels map[string]bool) bool {
+	for k := range ContainerLabels {
+		if ok := labels[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (cl *containerClient) Close() error {
+	if cl.ctx != nil {
+		cl.cancel()
+	}
+	if cl.rt != nil {
+		cl.rt.Close()
+	}
+	return nil
+}
+
+func (cl *containerClient) Type() SchedulerType {
+	return Container
+}
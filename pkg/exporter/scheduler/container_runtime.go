@@ -0,0 +1,385 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// dockerRuntime talks to the docker engine api over its unix socket
+type dockerRuntime struct {
+	socket string
+	client *http.Client
+}
+
+// subset of the docker engine api container list and inspect responses
+type dockerContainerSummary struct {
+	Id string `json:"Id"`
+}
+
+type dockerContainerInspect struct {
+	Id     string `json:"Id"`
+	Name   string `json:"Name"`
+	Config struct {
+		Image  string            `json:"Image"`
+		Labels map[string]string `json:"Labels"`
+	} `json:"Config"`
+	HostConfig struct {
+		Privileged bool `json:"Privileged"`
+		Devices    []struct {
+			PathOnHost string `json:"PathOnHost"`
+		} `json:"Devices"`
+		DeviceRequests []struct {
+			Driver       string     `json:"Driver"`
+			Count        int        `json:"Count"`
+			DeviceIDs    []string   `json:"DeviceIDs"`
+			Capabilities [][]string `json:"Capabilities"`
+		} `json:"DeviceRequests"`
+	} `json:"HostConfig"`
+}
+
+func newDockerRuntime(socket string) *dockerRuntime {
+	return &dockerRuntime{
+		socket: socket,
+		client: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var d net.Dialer
+					return d.DialContext(ctx, "unix", socket)
+				},
+			},
+			Timeout: containerCmdTimeout,
+		},
+	}
+}
+
+func (d *dockerRuntime) Name() string {
+	return "docker:" + d.socket
+}
+
+func (d *dockerRuntime) get(ctx context.Context, apiPath string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://docker"+apiPath, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("docker api %v returned %v: %v", apiPath, resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if out == nil {
+		return nil
+	}
+	return json.Unmarshal(body, out)
+}
+
+// Ping checks the socket serves the docker engine api
+func (d *dockerRuntime) Ping(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, containerCmdTimeout)
+	defer cancel()
+	return d.get(ctx, "/_ping", nil)
+}
+
+func (d *dockerRuntime) ListContainers(ctx context.Context) ([]gpuContainer, error) {
+	var summaries []dockerContainerSummary
+	if err := d.get(ctx, "/containers/json", &summaries); err != nil {
+		return nil, fmt.Errorf("docker list containers failed, %v", err)
+	}
+	ctrs := []gpuContainer{}
+	for _, s := range summaries {
+		var inspect dockerContainerInspect
+		if err := d.get(ctx, "/containers/"+url.PathEscape(s.Id)+"/json", &inspect); err != nil {
+			// container may have exited since the list
+			logger.Log.Printf("docker inspect %v failed, %v", s.Id, err)
+			continue
+		}
+		ctr := gpuContainer{
+			info: ContainerInfo{
+				Id:      inspect.Id,
+				Name:    strings.TrimPrefix(inspect.Name, "/"),
+				Image:   inspect.Config.Image,
+				Project: inspect.Config.Labels[composeProjectLabel],
+				Runtime: "docker",
+			},
+		}
+		// privileged containers get every host device without device entries
+		ctr.privileged = inspect.HostConfig.Privileged
+		for _, dev := range inspect.HostConfig.Devices {
+			ctr.devices = append(ctr.devices, dev.PathOnHost)
+		}
+		for _, req := range inspect.HostConfig.DeviceRequests {
+			switch {
+			case req.Driver == "cdi":
+				ctr.cdi = append(ctr.cdi, req.DeviceIDs...)
+			case hasGPUCapability(req.Capabilities):
+				// --gpus all requests every gpu with a count of -1,
+				// --gpus device=0,1 lists the gpu indices
+				if req.Count < 0 {
+					ctr.gpus = append(ctr.gpus, amdCDIAllGPUs)
+				}
+				ctr.gpus = append(ctr.gpus, req.DeviceIDs...)
+			}
+		}
+		ctrs = append(ctrs, ctr)
+	}
+	return ctrs, nil
+}
+
+func (d *dockerRuntime) Close() {
+	d.client.CloseIdleConnections()
+}
+
+func hasGPUCapability(caps [][]string) bool {
+	for _, set := range caps {
+		for _, c := range set {
+			if c == "gpu" {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// criRuntime talks to a CRI runtime (containerd, cri-o) over its unix socket
+type criRuntime struct {
+	socket string
+	conn   *grpc.ClientConn
+	client cri.RuntimeServiceClient
+}
+
+// subset of the verbose container status info, the runtime spec carries
+// the devices resolved by the runtime including cdi devices
+type criContainerInfo struct {
+	RuntimeSpec struct {
+		Linux struct {
+			Devices []struct {
+				Path string `json:"path"`
+			} `json:"devices"`
+		} `json:"linux"`
+	} `json:"runtimeSpec"`
+}
+
+func newCRIRuntime(ctx context.Context, socket string) (*criRuntime, error) {
+	conn, err := grpc.NewClient("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	client := cri.NewRuntimeServiceClient(conn)
+	probeCtx, cancel := context.WithTimeout(ctx, containerCmdTimeout)
+	defer cancel()
+	if _, err := client.Version(probeCtx, &cri.VersionRequest{}); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return &criRuntime{socket: socket, conn: conn, client: client}, nil
+}
+
+func (c *criRuntime) Name() string {
+	return "cri:" + c.socket
+}
+
+func (c *criRuntime) ListContainers(ctx context.Context) ([]gpuContainer, error) {
+	resp, err := c.client.ListContainers(ctx, &cri.ListContainersRequest{
+		Filter: &cri.ContainerFilter{
+			State: &cri.ContainerStateValue{
+				State: cri.ContainerState_CONTAINER_RUNNING,
+			},
+		},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cri list containers failed, %v", err)
+	}
+	ctrs := []gpuContainer{}
+	for _, ctr := range resp.Containers {
+		if _, ok := ctr.Labels[k8sPodNameLabel]; ok {
+			continue
+		}
+		status, err := c.client.ContainerStatus(ctx, &cri.ContainerStatusRequest{
+			ContainerId: ctr.Id,
+			Verbose:     true,
+		})
+		if err != nil {
+			logger.Log.Printf("cri container status %v failed, %v", ctr.Id, err)
+			continue
+		}
+		var info criContainerInfo
+		if err := json.Unmarshal([]byte(status.GetInfo()["info"]), &info); err != nil {
+			logger.Log.Printf("cri container %v info parse failed, %v", ctr.Id, err)
+			continue
+		}
+		gctr := gpuContainer{
+			info: ContainerInfo{
+				Id:      ctr.Id,
+				Name:    ctr.GetMetadata().GetName(),
+				Image:   ctr.GetImage().GetImage(),
+				Project: ctr.Labels[composeProjectLabel],
+				Runtime: "cri",
+			},
+		}
+		if img := status.GetStatus().GetImage().GetImage(); img != "" {
+			gctr.info.Image = img
+		}
+		for _, dev := range info.RuntimeSpec.Linux.Devices {
+			gctr.devices = append(gctr.devices, dev.Path)
+		}
+		ctrs = append(ctrs, gctr)
+	}
+	return ctrs, nil
+}
+
+func (c *criRuntime) Close() {
+	if c.conn != nil {
+		c.conn.Close()
+	}
+}
+
+// containerdTaskRuntime reads the oci bundles of the containerd tasks, this
+// covers the containers created with nerdctl or ctr in any containerd
+// namespace. The runtime spec of the bundle holds the devices resolved at
+// create time, including the host devices given to privileged containers.
+type containerdTaskRuntime struct {
+	root           string          // io.containerd.runtime.v2.task directory
+	skipNamespaces map[string]bool // namespaces listed through a runtime api
+}
+
+// subset of the oci runtime spec written to the task bundle
+type ociBundleSpec struct {
+	Annotations map[string]string `json:"annotations"`
+	Linux       struct {
+		Devices []struct {
+			Path string `json:"path"`
+		} `json:"devices"`
+	} `json:"linux"`
+}
+
+func newContainerdTaskRuntime(root string, skipNamespaces map[string]bool) *containerdTaskRuntime {
+	return &containerdTaskRuntime{root: root, skipNamespaces: skipNamespaces}
+}
+
+func (c *containerdTaskRuntime) Name() string {
+	return "containerd-tasks:" + c.root
+}
+
+func (c *containerdTaskRuntime) ListContainers(ctx context.Context) ([]gpuContainer, error) {
+	namespaces, err := os.ReadDir(c.root)
+	if err != nil {
+		return nil, fmt.Errorf("containerd task list failed, %v", err)
+	}
+	ctrs := []gpuContainer{}
+	for _, ns := range namespaces {
+		if !ns.IsDir() || c.skipNamespaces[ns.Name()] {
+			continue
+		}
+		tasks, err := os.ReadDir(path.Join(c.root, ns.Name()))
+		if err != nil {
+			logger.Log.Printf("containerd namespace %v list failed, %v", ns.Name(), err)
+			continue
+		}
+		for _, task := range tasks {
+			data, err := os.ReadFile(path.Join(c.root, ns.Name(), task.Name(), "config.json"))
+			if err != nil {
+				// task may have exited since the list
+				logger.Debugf("containerd task %v/%v spec read failed, %v", ns.Name(), task.Name(), err)
+				continue
+			}
+			var spec ociBundleSpec
+			if err := json.Unmarshal(data, &spec); err != nil {
+				logger.Log.Printf("containerd task %v/%v spec parse failed, %v", ns.Name(), task.Name(), err)
+				continue
+			}
+			name := spec.Annotations[nerdctlNameAnnotation]
+			if name == "" {
+				name = task.Name()
+			}
+			ctr := gpuContainer{
+				info: ContainerInfo{
+					Id:      task.Name(),
+					Name:    name,
+					Runtime: "containerd",
+				},
+			}
+			for _, dev := range spec.Linux.Devices {
+				ctr.devices = append(ctr.devices, dev.Path)
+			}
+			ctrs = append(ctrs, ctr)
+		}
+	}
+	return ctrs, nil
+}
+
+func (c *containerdTaskRuntime) Close() {}
+
+// multiRuntime lists the containers of several runtimes
+type multiRuntime []containerRuntime
+
+func (m multiRuntime) Name() string {
+	names := []string{}
+	for _, rt := range m {
+		names = append(names, rt.Name())
+	}
+	return strings.Join(names, ",")
+}
+
+// ListContainers returns the containers of every runtime answering, it
+// fails only when all of them fail
+func (m multiRuntime) ListContainers(ctx context.Context) ([]gpuContainer, error) {
+	ctrs := []gpuContainer{}
+	var errs []string
+	for _, rt := range m {
+		rctrs, err := rt.ListContainers(ctx)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		ctrs = append(ctrs, rctrs...)
+	}
+	if len(errs) == len(m) {
+		return nil, fmt.Errorf("%v", strings.Join(errs, "; "))
+	}
+	for _, err := range errs {
+		logger.Log.Printf("container list failed, %v", err)
+	}
+	return ctrs, nil
+}
+
+func (m multiRuntime) Close() {
+	for _, rt := range m {
+		rt.Close()
+	}
+}
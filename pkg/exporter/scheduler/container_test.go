@@ -0,0 +1,353 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+
+	"google.golang.org/grpc"
+	cri "k8s.io/cri-api/pkg/apis/runtime/v1"
+)
+
+// recorded from the docker engine api (trimmed to the fields of interest)
+var dockerResponses = map[string]string{
+	"/_ping": `OK`,
+	"/containers/json": `[
+		{"Id": "3f1c2a9be0d1aa", "Names": ["/train-worker-1"], "Image": "rocm/pytorch:latest", "State": "running"},
+		{"Id": "7ab01c22de99ff", "Names": ["/infer"], "Image": "rocm/vllm:latest", "State": "running"},
+		{"Id": "c0ffee000000aa", "Names": ["/nginx"], "Image": "nginx", "State": "running"},
+		{"Id": "deadbeef0000aa", "Names": ["/exporter"], "Image": "rocm/device-metrics-exporter:v1.5.0", "State": "running"},
+		{"Id": "gone00000000aa", "Names": ["/gone"], "Image": "busybox", "State": "running"},
+		{"Id": "9a9a00000000aa", "Names": ["/finetune"], "Image": "rocm/pytorch:latest", "State": "running"}
+	]`,
+	"/containers/3f1c2a9be0d1aa/json": `{
+		"Id": "3f1c2a9be0d1aa", "Name": "/train-worker-1",
+		"Config": {"Image": "rocm/pytorch:latest", "Labels": {"com.docker.compose.project": "llm", "com.docker.compose.service": "worker"}},
+		"HostConfig": {"Privileged": false, "Devices": [
+			{"PathOnHost": "/dev/kfd", "PathInContainer": "/dev/kfd", "CgroupPermissions": "rwm"},
+			{"PathOnHost": "/dev/dri/renderD128", "PathInContainer": "/dev/dri/renderD128", "CgroupPermissions": "rwm"},
+			{"PathOnHost": "/dev/dri/renderD136", "PathInContainer": "/dev/dri/renderD136", "CgroupPermissions": "rwm"}
+		]}
+	}`,
+	"/containers/7ab01c22de99ff/json": `{
+		"Id": "7ab01c22de99ff", "Name": "/infer",
+		"Config": {"Image": "rocm/vllm:latest", "Labels": {}},
+		"HostConfig": {"Devices": [], "DeviceRequests": [{"Driver": "cdi", "DeviceIDs": ["amd.com/gpu=2"]}]}
+	}`,
+	"/containers/c0ffee000000aa/json": `{
+		"Id": "c0ffee000000aa", "Name": "/nginx",
+		"Config": {"Image": "nginx", "Labels": {}},
+		"HostConfig": {"Devices": [{"PathOnHost": "/dev/dri/renderD144"}]}
+	}`,
+	"/containers/deadbeef0000aa/json": `{
+		"Id": "deadbeef0000aa", "Name": "/exporter",
+		"Config": {"Image": "rocm/device-metrics-exporter:v1.5.0", "Labels": {}},
+		"HostConfig": {"Devices": [{"PathOnHost": "/dev/kfd"}, {"PathOnHost": "/dev/dri"}]}
+	}`,
+	"/containers/9a9a00000000aa/json": `{
+		"Id": "9a9a00000000aa", "Name": "/finetune",
+		"Config": {"Image": "rocm/pytorch:latest", "Labels": {}},
+		"HostConfig": {"Devices": [], "DeviceRequests": [{"Driver": "", "Count": 0, "DeviceIDs": ["3"], "Capabilities": [["gpu"]]}]}
+	}`,
+}
+
+// startFakeDocker serves the recorded docker api responses on a unix socket
+func startFakeDocker(t *testing.T) string {
+	t.Helper()
+	socket := path.Join(t.TempDir(), "docker.sock")
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	srv := &http.Server{Handler: http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp, ok := dockerResponses[r.URL.Path]
+		if !ok {
+			http.Error(w, `{"message": "No such container"}`, http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(resp))
+	})}
+	go func() { _ = srv.Serve(l) }()
+	t.Cleanup(func() { srv.Close() })
+	return socket
+}
+
+type fakeCRIServer struct {
+	cri.UnimplementedRuntimeServiceServer
+	containers []*cri.Container
+	info       map[string]string
+}
+
+func (f *fakeCRIServer) Version(context.Context, *cri.VersionRequest) (*cri.VersionResponse, error) {
+	return &cri.VersionResponse{RuntimeName: "containerd", RuntimeApiVersion: "v1"}, nil
+}
+
+func (f *fakeCRIServer) ListContainers(context.Context, *cri.ListContainersRequest) (*cri.ListContainersResponse, error) {
+	return &cri.ListContainersResponse{Containers: f.containers}, nil
+}
+
+func (f *fakeCRIServer) ContainerStatus(_ context.Context, req *cri.ContainerStatusRequest) (*cri.ContainerStatusResponse, error) {
+	return &cri.ContainerStatusResponse{
+		Status: &cri.ContainerStatus{Id: req.ContainerId, Image: &cri.ImageSpec{Image: "docker.io/rocm/pytorch:latest"}},
+		Info:   map[string]string{"info": f.info[req.ContainerId]},
+	}, nil
+}
+
+// startFakeCRI serves a fake CRI runtime service on a unix socket
+func startFakeCRI(t *testing.T, fake *fakeCRIServer) string {
+	t.Helper()
+	socket := path.Join(t.TempDir(), "containerd.sock")
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	srv := grpc.NewServer()
+	cri.RegisterRuntimeServiceServer(srv, fake)
+	go func() { _ = srv.Serve(l) }()
+	t.Cleanup(srv.Stop)
+	return socket
+}
+
+// fakeDevRoot creates a /dev/dri tree with the given render nodes
+func fakeDevRoot(t *testing.T, nodes ...string) string {
+	t.Helper()
+	root := t.TempDir()
+	if err := os.MkdirAll(path.Join(root, driDir), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range nodes {
+		if err := os.WriteFile(path.Join(root, driDir, n), nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return root
+}
+
+func TestContainerClientDocker(t *testing.T) {
+	socket := startFakeDocker(t)
+	ctx := context.Background()
+
+	rt, err := detectContainerRuntime(ctx, socket)
+	if err != nil {
+		t.Fatalf("detect runtime failed: %v", err)
+	}
+	if _, ok := rt.(*dockerRuntime); !ok {
+		t.Fatalf("expected docker runtime, got %v", rt.Name())
+	}
+
+	cl := newContainerClient(ctx, rt, fakeDevRoot(t, "renderD128", "renderD136", "card0"), "deadbeef0000")
+	defer cl.Close()
+	if err := cl.refresh(); err != nil {
+		t.Fatalf("refresh failed: %v", err)
+	}
+	wls, err := cl.ListWorkloads()
+	if err != nil {
+		t.Fatalf("list workloads failed: %v", err)
+	}
+
+	train := ContainerInfo{Id: "3f1c2a9be0d1aa", Name: "train-worker-1", Image: "rocm/pytorch:latest", Project: "llm", Runtime: "docker"}
+	want := map[string]ContainerInfo{
+		"128": train,
+		"136": train,
+		"2":   {Id: "7ab01c22de99ff", Name: "infer", Image: "rocm/vllm:latest", Runtime: "docker"},
+		"3":   {Id: "9a9a00000000aa", Name: "finetune", Image: "rocm/pytorch:latest", Runtime: "docker"},
+	}
+	if len(wls) != len(want) {
+		t.Fatalf("got %d workloads, want %d: %v", len(wls), len(want), wls)
+	}
+	for key, info := range want {
+		if wls[key].Type != Container || !reflect.DeepEqual(wls[key].Info, info) {
+			t.Errorf("key %v got %+v, want %+v", key, wls[key], info)
+		}
+	}
+}
+
+func TestContainerClientDockerSharedGPU(t *testing.T) {
+	socket := startFakeDocker(t)
+	rt := newDockerRuntime(socket)
+	// without self filtering the exporter container holds every render node
+	cl := newContainerClient(context.Background(), rt, fakeDevRoot(t, "renderD128", "renderD136"), "")
+	defer cl.Close()
+	if err := cl.refresh(); err != nil {
+		t.Fatalf("refresh failed: %v", err)
+	}
+	wls, _ := cl.ListWorkloads()
+	got := wls["128"].Info.(ContainerInfo)
+	if got.Name != "exporter,train-worker-1" || got.Image != "rocm/device-metrics-exporter:v1.5.0,rocm/pytorch:latest" {
+		t.Fatalf("unexpected merged container info %+v", got)
+	}
+}
+
+func TestContainerClientCRI(t *testing.T) {
+	fake := &fakeCRIServer{
+		containers: []*cri.Container{
+			{
+				Id:       "a1",
+				Metadata: &cri.ContainerMetadata{Name: "bench"},
+				Image:    &cri.ImageSpec{Image: "sha256:1234"},
+				Labels:   map[string]string{"com.docker.compose.project": "perf"},
+			},
+			{
+				Id:       "k8s",
+				Metadata: &cri.ContainerMetadata{Name: "pod-ctr"},
+				Labels:   map[string]string{"io.kubernetes.pod.name": "p"},
+			},
+		},
+		info: map[string]string{
+			"a1": `{"runtimeSpec": {"linux": {"devices": [{"path": "/dev/kfd", "type": "c"}, {"path": "/dev/dri/renderD129", "type": "c"}]}}}`,
+		},
+	}
+	socket := startFakeCRI(t, fake)
+	ctx := context.Background()
+
+	rt, err := detectContainerRuntime(ctx, socket)
+	if err != nil {
+		t.Fatalf("detect runtime failed: %v", err)
+	}
+	if _, ok := rt.(*criRuntime); !ok {
+		t.Fatalf("expected cri runtime, got %v", rt.Name())
+	}
+	cl := newContainerClient(ctx, rt, fakeDevRoot(t), "")
+	defer cl.Close()
+	if err := cl.refresh(); err != nil {
+		t.Fatalf("refresh failed: %v", err)
+	}
+	wls, _ := cl.ListWorkloads()
+	want := ContainerInfo{Id: "a1", Name: "bench", Image: "docker.io/rocm/pytorch:latest", Project: "perf", Runtime: "cri"}
+	if len(wls) != 1 || !reflect.DeepEqual(wls["129"].Info, want) {
+		t.Fatalf("got %v, want render 129 -> %+v", wls, want)
+	}
+}
+
+// writeTaskSpec writes the oci spec of a containerd task bundle
+func writeTaskSpec(t *testing.T, taskDir, ns, id, spec string) {
+	t.Helper()
+	dir := path.Join(taskDir, ns, id)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path.Join(dir, "config.json"), []byte(spec), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestContainerClientContainerdTasks(t *testing.T) {
+	socket := startFakeDocker(t)
+	taskDir := path.Join(path.Dir(socket), containerdTaskDir)
+	// nerdctl container in the default namespace
+	writeTaskSpec(t, taskDir, "default", "e1e1", `{
+		"annotations": {"nerdctl/name": "nerd-train"},
+		"linux": {"devices": [{"path": "/dev/kfd"}, {"path": "/dev/dri/renderD144"}]}}`)
+	// privileged ctr container, the spec lists every host device
+	writeTaskSpec(t, taskDir, "batch", "f2f2", `{
+		"linux": {"devices": [{"path": "/dev/null"}, {"path": "/dev/kfd"}, {"path": "/dev/dri/card1"}, {"path": "/dev/dri/renderD152"}]}}`)
+	// docker and kubelet containers are listed through their own clients
+	writeTaskSpec(t, taskDir, dockerNamespace, "3f1c2a9be0d1aa", `{
+		"linux": {"devices": [{"path": "/dev/kfd"}, {"path": "/dev/dri/renderD128"}]}}`)
+	writeTaskSpec(t, taskDir, criNamespace, "pod1", `{
+		"linux": {"devices": [{"path": "/dev/kfd"}, {"path": "/dev/dri/renderD160"}]}}`)
+	ctx := context.Background()
+
+	rt, err := detectContainerRuntime(ctx, socket)
+	if err != nil {
+		t.Fatalf("detect runtime failed: %v", err)
+	}
+	if _, ok := rt.(multiRuntime); !ok {
+		t.Fatalf("expected docker and containerd task runtimes, got %v", rt.Name())
+	}
+	cl := newContainerClient(ctx, rt, fakeDevRoot(t, "renderD128", "renderD136"), "deadbeef0000")
+	defer cl.Close()
+	if err := cl.refresh(); err != nil {
+		t.Fatalf("refresh failed: %v", err)
+	}
+	wls, _ := cl.ListWorkloads()
+	want := map[string]ContainerInfo{
+		"144": {Id: "e1e1", Name: "nerd-train", Runtime: "containerd"},
+		"152": {Id: "f2f2", Name: "f2f2", Runtime: "containerd"},
+		"128": {Id: "3f1c2a9be0d1aa", Name: "train-worker-1", Image: "rocm/pytorch:latest", Project: "llm", Runtime: "docker"},
+	}
+	for key, info := range want {
+		if !reflect.DeepEqual(wls[key].Info, info) {
+			t.Errorf("key %v got %+v, want %+v", key, wls[key].Info, info)
+		}
+	}
+	if _, ok := wls["160"]; ok {
+		t.Errorf("kubelet container should not be attributed: %v", wls["160"])
+	}
+
+	// without a runtime api the task bundles are used alone, including
+	// the docker namespace
+	rt, err = detectContainerRuntime(ctx, path.Join(path.Dir(socket), "containerd.sock"))
+	if err != nil {
+		t.Fatalf("detect runtime failed: %v", err)
+	}
+	if _, ok := rt.(*containerdTaskRuntime); !ok {
+		t.Fatalf("expected containerd task runtime, got %v", rt.Name())
+	}
+	ctrs, err := rt.ListContainers(ctx)
+	if err != nil || len(ctrs) != 3 {
+		t.Fatalf("got %v containers (%v), want 3", len(ctrs), err)
+	}
+}
+
+func TestContainerGPUKeys(t *testing.T) {
+	cl := newContainerClient(context.Background(), nil, fakeDevRoot(t, "renderD128", "renderD136"), "")
+	defer cl.Close()
+	tests := []struct {
+		name string
+		ctr  gpuContainer
+		want []string
+	}{
+		{"render node", gpuContainer{devices: []string{"/dev/kfd", "/dev/dri/renderD136"}}, []string{"136"}},
+		{"no kfd", gpuContainer{devices: []string{"/dev/dri/renderD136"}}, nil},
+		{"privileged", gpuContainer{privileged: true}, []string{"128", "136"}},
+		{"gpus all", gpuContainer{gpus: []string{"all"}}, []string{"128", "136"}},
+		{"gpus index", gpuContainer{gpus: []string{"0", "1"}}, []string{"0", "1"}},
+		{"cdi", gpuContainer{cdi: []string{"amd.com/gpu=1", "vendor.com/nic=0"}}, []string{"1"}},
+	}
+	for _, tc := range tests {
+		if got := cl.containerGPUKeys(tc.ctr); !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("%v: got %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestDetectContainerRuntimeNoSocket(t *testing.T) {
+	if _, err := detectContainerRuntime(context.Background(), path.Join(t.TempDir(), "none.sock")); err == nil {
+		t.Fatalf("expected error for missing socket")
+	}
+}
+
+func TestIsShortContainerId(t *testing.T) {
+	tests := map[string]bool{
+		"deadbeef0000": true,
+		"node01":       false,
+		"DEADBEEF0000": false,
+		"deadbeef000g": false,
+	}
+	for id, want := range tests {
+		if got := isShortContainerId(id); got != want {
+			t.Errorf("isShortContainerId(%q) = %v, want %v", id, got, want)
+		}
+	}
+}
@@ -0,0 +1,394 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+const (
+	// fluxPollInterval is how often the running job list is refreshed
+	fluxPollInterval = 10 * time.Second
+	// fluxCmdTimeout bounds each flux cli invocation
+	fluxCmdTimeout = 5 * time.Second
+	// f58 alphabet used by flux for user facing job ids
+	fluxF58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+)
+
+// cmdRunner runs a command and returns its stdout, replaced in tests with
+// recorded output
+type cmdRunner func(ctx context.Context, name string, args ...string) ([]byte, error)
+
+func execRunner(ctx context.Context, name string, args ...string) ([]byte, error) {
+	return exec.CommandContext(ctx, name, args...).Output()
+}
+
+// fluxJob is the subset of `flux jobs --json` job fields used by the exporter
+type fluxJob struct {
+	Id       uint64 `json:"id"`
+	UserId   int    `json:"userid"`
+	UserName string `json:"username"`
+	Queue    string `json:"queue"`
+	Nodelist string `json:"nodelist"`
+}
+
+type fluxJobList struct {
+	Jobs []fluxJob `json:"jobs"`
+}
+
+// fluxR is the subset of the flux resource set (R) for a job
+type fluxR struct {
+	Execution struct {
+		RLite []struct {
+			Rank     string            `json:"rank"`
+			Children map[string]string `json:"children"`
+		} `json:"R_lite"`
+		Nodelist []string `json:"nodelist"`
+	} `json:"execution"`
+}
+
+type fluxClient struct {
+	sync.Mutex
+	GpuJobs  map[string]JobInfo
+	hostname string
+	run      cmdRunner
+	ctx      context.Context
+	cancel   context.CancelFunc
+}
+
+// NewFluxClient creates a flux scheduler client that polls the flux cli for
+// running jobs allocated on this node
+func NewFluxClient(ctx context.Context) (SchedulerClient, error) {
+	if _, err := exec.LookPath("flux"); err != nil {
+		return nil, fmt.Errorf("flux cli not found, %v", err)
+	}
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get hostname, %v", err)
+	}
+	cl := newFluxClient(ctx, hostname, execRunner)
+	out, err := cl.runCmd("flux", "getattr", "local-uri")
+	if err != nil {
+		cl.cancel()
+		return nil, fmt.Errorf("flux instance not reachable, %v", err)
+	}
+	logger.Log.Printf("flux instance uri %v", strings.TrimSpace(string(out)))
+	go cl.pollJobs()
+	logger.Log.Printf("created flux scheduler client")
+	return cl, nil
+}
+
+func newFluxClient(ctx context.Context, hostname string, run cmdRunner) *fluxClient {
+	ctx, cancel := context.WithCancel(ctx)
+	return &fluxClient{
+		GpuJobs:  make(map[string]JobInfo),
+		hostname: shortHostname(hostname),
+		run:      run,
+		ctx:      ctx,
+		cancel:   cancel,
+	}
+}
+
+func (cl *fluxClient) runCmd(name string, args ...string) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(cl.ctx, fluxCmdTimeout)
+	defer cancel()
+	return cl.run(ctx, name, args...)
+}
+
+func (cl *fluxClient) pollJobs() {
+	ticker := time.NewTicker(fluxPollInterval)
+	defer ticker.Stop()
+	for {
+		if err := cl.refresh(); err != nil {
+			logger.Log.Printf("flux job refresh failed, %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-cl.ctx.Done():
+			logger.Log.Printf("flux job poller stopped")
+			return
+		}
+	}
+}
+
+// refresh rebuilds the gpu to job map from the running jobs on this node
+func (cl *fluxClient) refresh() error {
+	// -A lists the jobs of all users, not only the exporter user
+	out, err := cl.runCmd("flux", "jobs", "-A", "--json", "--filter=running")
+	if err != nil {
+		return fmt.Errorf("flux jobs failed, %v", err)
+	}
+	var jobs fluxJobList
+	if err := json.Unmarshal(out, &jobs); err != nil {
+		return fmt.Errorf("could not parse flux jobs output, %v", err)
+	}
+
+	gpuJobs := make(map[string]JobInfo)
+	for _, job := range jobs.Jobs {
+		if !hostInList(cl.hostname, job.Nodelist) {
+			continue
+		}
+		jobId := fluxJobIdF58(job.Id)
+		rout, err := cl.runCmd("flux", "job", "info", strconv.FormatUint(job.Id, 10), "R")
+		if err != nil {
+			logger.Log.Printf("flux job info %v R failed, %v", jobId, err)
+			continue
+		}
+		gpus, err := fluxLocalGPUs(rout, cl.hostname)
+		if err != nil {
+			logger.Log.Printf("flux job %v resource parse failed, %v", jobId, err)
+			continue
+		}
+		user := job.UserName
+		if user == "" {
+			user = strconv.Itoa(job.UserId)
+		}
+		for _, gpu := range gpus {
+			gpuJobs[gpu] = JobInfo{
+				Id:        jobId,
+				User:      user,
+				Partition: job.Queue,
+			}
+		}
+	}
+
+	cl.Lock()
+	cl.GpuJobs = gpuJobs
+	cl.Unlock()
+	logger.Debugf("flux gpu jobs %v", gpuJobs)
+	return nil
+}
+
+// fluxLocalGPUs returns the gpu indices allocated on hostname from a job R
+func fluxLocalGPUs(data []byte, hostname string) ([]string, error) {
+	var r fluxR
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+	hosts := []string{}
+	for _, nl := range r.Execution.Nodelist {
+		hosts = append(hosts, expandHostlist(nl)...)
+	}
+	// the nodelist maps one to one onto the sorted rank set of R_lite (RFC 20),
+	// ranks need not start at 0 nor be contiguous
+	rankSet := map[int]bool{}
+	for _, rl := range r.Execution.RLite {
+		ranks, err := parseIDSet(rl.Rank)
+		if err != nil {
+			return nil, err
+		}
+		for _, rk := range ranks {
+			rankSet[rk] = true
+		}
+	}
+	sortedRanks := make([]int, 0, len(rankSet))
+	for rk := range rankSet {
+		sortedRanks = append(sortedRanks, rk)
+	}
+	sort.Ints(sortedRanks)
+	if len(sortedRanks) != len(hosts) {
+		return nil, fmt.Errorf("job nodelist %v does not match ranks %v", r.Execution.Nodelist, sortedRanks)
+	}
+	rank := -1
+	for i, h := range hosts {
+		if shortHostname(h) == hostname {
+			rank = sortedRanks[i]
+			break
+		}
+	}
+	if rank < 0 {
+		return nil, fmt.Errorf("host %v not in job nodelist %v", hostname, r.Execution.Nodelist)
+	}
+	for _, rl := range r.Execution.RLite {
+		ranks, err := parseIDSet(rl.Rank)
+		if err != nil {
+			return nil, err
+		}
+		for _, rk := range ranks {
+			if rk != rank {
+				continue
+			}
+			gpuSet, ok := rl.Children["gpu"]
+			if !ok {
+				return []string{}, nil
+			}
+			gpus, err := parseIDSet(gpuSet)
+			if err != nil {
+				return nil, err
+			}
+			ids := make([]string, 0, len(gpus))
+			for _, g := range gpus {
+				ids = append(ids, strconv.Itoa(g))
+			}
+			return ids, nil
+		}
+	}
+	return []string{}, nil
+}
+
+// fluxJobIdF58 encodes a flux job id in the f58 form shown by the flux cli
+func fluxJobIdF58(id uint64) string {
+	if id == 0 {
+		return "ƒ1"
+	}
+	var buf []byte
+	for id > 0 {
+		buf = append([]byte{fluxF58Alphabet[id%58]}, buf...)
+		id /= 58
+	}
+	return "ƒ" + string(buf)
+}
+
+// parseIDSet parses an RFC 22 idset such as "0-3,5,7-8"
+func parseIDSet(set string) ([]int, error) {
+	ids := []int{}
+	set = strings.TrimSpace(set)
+	if set == "" {
+		return ids, nil
+	}
+	for _, part := range strings.Split(set, ",") {
+		lo, hi, isRange := strings.Cut(part, "-")
+		start, err := strconv.Atoi(strings.TrimSpace(lo))
+		if err != nil {
+			return nil, fmt.Errorf("invalid idset %q", set)
+		}
+		end := start
+		if isRange {
+			end, err = strconv.Atoi(strings.TrimSpace(hi))
+			if err != nil || end < start {
+				return nil, fmt.Errorf("invalid idset %q", set)
+			}
+		}
+		for i := start; i <= end; i++ {
+			ids = append(ids, i)
+		}
+	}
+	return ids, nil
+}
+
+// expandHostlist expands a hostlist such as "node[01-03,7],login" into
+// individual hostnames
+func expandHostlist(hostlist string) []string {
+	hosts := []string{}
+	depth := 0
+	start := 0
+	for i, c := range hostlist {
+		switch c {
+		case '[':
+			depth++
+		case ']':
+			depth--
+		case ',':
+			if depth == 0 {
+				hosts = append(hosts, expandHostRange(hostlist[start:i])...)
+				start = i + 1
+			}
+		}
+	}
+	if start < len(hostlist) {
+		hosts = append(hosts, expandHostRange(hostlist[start:])...)
+	}
+	return hosts
+}
+
+func expandHostRange(host string) []string {
+	lb := strings.Index(host, "[")
+	rb := strings.Index(host, "]")
+	if lb < 0 || rb < lb {
+		return []string{host}
+	}
+	prefix, suffix := host[:lb], host[rb+1:]
+	hosts := []string{}
+	for _, part := range strings.Split(host[lb+1:rb], ",") {
+		lo, hi, isRange := strings.Cut(part, "-")
+		start, err := strconv.Atoi(lo)
+		if err != nil {
+			continue
+		}
+		end := start
+		if isRange {
+			if end, err = strconv.Atoi(hi); err != nil {
+				continue
+			}
+		}
+		for i := start; i <= end; i++ {
+			// keep zero padding of the range start
+			hosts = append(hosts, fmt.Sprintf("%s%0*d%s", prefix, len(lo), i, suffix))
+		}
+	}
+	return hosts
+}
+
+func hostInList(hostname, hostlist string) bool {
+	for _, h := range expandHostlist(hostlist) {
+		if shortHostname(h) == hostname {
+			return true
+		}
+	}
+	return false
+}
+
+func shortHostname(hostname string) string {
+	short, _, _ := strings.Cut(hostname, ".")
+	return short
+}
+
+// ListWorkloads - returns the list of flux jobs running on the gpus
+// the key is the gpu index and the value is the job info
+func (cl *fluxClient) ListWorkloads() (map[string]Workload, error) {
+	jobs := make(map[string]Workload)
+	cl.Lock()
+	defer cl.Unlock()
+	for k, v := range cl.GpuJobs {
+		jobs[k] = Workload{
+			Type: Flux,
+			Info: v,
+		}
+	}
+	return jobs, nil
+}
+
+func (cl *fluxClient) CheckExportLabels(labels map[string]bool) bool {
+	for k := range SlurmLabels {
+		if ok := labels[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (cl *fluxClient) Close() error {
+	if cl.ctx != nil {
+		cl.cancel()
+	}
+	return nil
+}
+
+func (cl *fluxClient) Type() SchedulerType {
+	return Flux
+}
@@ -0,0 +1,216 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// recorded from `flux jobs -A --json --filter=running` on a two job instance
+const fluxJobsOutput = `{
+  "jobs": [
+    {
+      "id": 2886218366976,
+      "userid": 1001,
+      "urgency": 16,
+      "priority": 16,
+      "t_submit": 1718040412.6201,
+      "t_run": 1718040412.6412,
+      "state": 8,
+      "name": "train.sh",
+      "queue": "gpu",
+      "ntasks": 8,
+      "nnodes": 2,
+      "ranks": "0-1",
+      "nodelist": "mi300-[01-02]",
+      "expiration": 1718044012.0,
+      "username": "alice",
+      "success": false,
+      "result": "",
+      "waitstatus": 0
+    },
+    {
+      "id": 3157470740480,
+      "userid": 1002,
+      "urgency": 16,
+      "priority": 16,
+      "t_submit": 1718040590.118,
+      "t_run": 1718040590.1377,
+      "state": 8,
+      "name": "bench",
+      "queue": "debug",
+      "ntasks": 3,
+      "nnodes": 3,
+      "ranks": "3,7-8",
+      "nodelist": "mi300-[04,09-10]",
+      "expiration": 1718044190.0,
+      "username": "bob",
+      "success": false,
+      "result": "",
+      "waitstatus": 0
+    }
+  ]
+}`
+
+// recorded from `flux job info <id> R` for the first job above
+const fluxJobROutput = `{"version": 1, "execution": {"R_lite": [{"rank": "0", "children": {"core": "0-95", "gpu": "0-3"}}, {"rank": "1", "children": {"core": "0-95", "gpu": "4-7"}}], "starttime": 1718040412.0, "expiration": 1718044012.0, "nodelist": ["mi300-[01-02]"]}}`
+
+// recorded from `flux job info <id> R` for the second job above, the ranks
+// of the job are sparse and do not start at 0
+const fluxSparseJobROutput = `{"version": 1, "execution": {"R_lite": [{"rank": "3,7", "children": {"core": "0-95", "gpu": "0-1"}}, {"rank": "8", "children": {"core": "0-95", "gpu": "2"}}], "starttime": 1718040590.0, "expiration": 1718044190.0, "nodelist": ["mi300-04", "mi300-[09-10]"]}}`
+
+func TestMain(m *testing.M) {
+	logger.Init(false)
+	m.Run()
+}
+
+func TestParseIDSet(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    []int
+		wantErr bool
+	}{
+		{in: "", want: []int{}},
+		{in: "3", want: []int{3}},
+		{in: "0-3", want: []int{0, 1, 2, 3}},
+		{in: "0-1,5,7-8", want: []int{0, 1, 5, 7, 8}},
+		{in: "3-1", wantErr: true},
+		{in: "a", wantErr: true},
+	}
+	for _, tc := range tests {
+		got, err := parseIDSet(tc.in)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("parseIDSet(%q) expected error", tc.in)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseIDSet(%q) unexpected error: %v", tc.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("parseIDSet(%q) = %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestExpandHostlist(t *testing.T) {
+	tests := []struct {
+		in   string
+		want []string
+	}{
+		{in: "node1", want: []string{"node1"}},
+		{in: "node[1-3]", want: []string{"node1", "node2", "node3"}},
+		{in: "mi300-[08-10]", want: []string{"mi300-08", "mi300-09", "mi300-10"}},
+		{in: "a[1,3],login", want: []string{"a1", "a3", "login"}},
+	}
+	for _, tc := range tests {
+		got := expandHostlist(tc.in)
+		if !reflect.DeepEqual(got, tc.want) {
+			t.Errorf("expandHostlist(%q) = %v, want %v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestFluxJobIdF58(t *testing.T) {
+	tests := map[uint64]string{
+		0:  "ƒ1",
+		1:  "ƒ2",
+		57: "ƒz",
+		58: "ƒ21",
+	}
+	for id, want := range tests {
+		if got := fluxJobIdF58(id); got != want {
+			t.Errorf("fluxJobIdF58(%v) = %v, want %v", id, got, want)
+		}
+	}
+}
+
+func fakeFluxRunner(outputs map[string]string) cmdRunner {
+	return func(_ context.Context, name string, args ...string) ([]byte, error) {
+		cmd := strings.Join(append([]string{name}, args...), " ")
+		out, ok := outputs[cmd]
+		if !ok {
+			return nil, fmt.Errorf("unexpected command %q", cmd)
+		}
+		return []byte(out), nil
+	}
+}
+
+func TestFluxRefresh(t *testing.T) {
+	runner := fakeFluxRunner(map[string]string{
+		"flux jobs -A --json --filter=running": fluxJobsOutput,
+		"flux job info 2886218366976 R":        fluxJobROutput,
+		"flux job info 3157470740480 R":        fluxSparseJobROutput,
+	})
+	alice := JobInfo{Id: fluxJobIdF58(2886218366976), User: "alice", Partition: "gpu"}
+	bob := JobInfo{Id: fluxJobIdF58(3157470740480), User: "bob", Partition: "debug"}
+
+	tests := []struct {
+		hostname string
+		wantGPUs []string
+		wantJob  JobInfo
+	}{
+		{hostname: "mi300-01.cluster.local", wantGPUs: []string{"0", "1", "2", "3"}, wantJob: alice},
+		{hostname: "mi300-02", wantGPUs: []string{"4", "5", "6", "7"}, wantJob: alice},
+		{hostname: "mi300-03", wantGPUs: []string{}},
+		// nodelist entries map onto the sorted ranks 3, 7 and 8
+		{hostname: "mi300-04", wantGPUs: []string{"0", "1"}, wantJob: bob},
+		{hostname: "mi300-09", wantGPUs: []string{"0", "1"}, wantJob: bob},
+		{hostname: "mi300-10", wantGPUs: []string{"2"}, wantJob: bob},
+	}
+	for _, tc := range tests {
+		cl := newFluxClient(context.Background(), tc.hostname, runner)
+		if err := cl.refresh(); err != nil {
+			t.Fatalf("%v: refresh failed: %v", tc.hostname, err)
+		}
+		wls, err := cl.ListWorkloads()
+		if err != nil {
+			t.Fatalf("%v: list workloads failed: %v", tc.hostname, err)
+		}
+		if len(wls) != len(tc.wantGPUs) {
+			t.Fatalf("%v: got %d workloads, want %d", tc.hostname, len(wls), len(tc.wantGPUs))
+		}
+		for _, gpu := range tc.wantGPUs {
+			wl, ok := wls[gpu]
+			if !ok {
+				t.Fatalf("%v: gpu %v missing from workloads", tc.hostname, gpu)
+			}
+			if wl.Type != Flux || wl.Info.(JobInfo) != tc.wantJob {
+				t.Errorf("%v: gpu %v got %v, want %+v", tc.hostname, gpu, wl, tc.wantJob)
+			}
+		}
+		_ = cl.Close()
+	}
+}
+
+func TestFluxRefreshError(t *testing.T) {
+	cl := newFluxClient(context.Background(), "mi300-01", fakeFluxRunner(map[string]string{
+		"flux jobs -A --json --filter=running": "not json",
+	}))
+	defer cl.Close()
+	if err := cl.refresh(); err == nil {
+		t.Fatalf("expected parse error")
+	}
+}
@@ -0,0 +1,221 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"sigs.k8s.io/yaml"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// workloadDescriptor is the json/yaml file format dropped in the workload
+// directory by any orchestrator
+//
+//	gpus: ["0", "1"]          # gpu index, pcie bus id or render id
+//	labels:
+//	  team: research
+//	  experiment: llm-7b
+//	ttl: 2h                   # optional, relative to the file modification time
+type workloadDescriptor struct {
+	GPUs   []string          `json:"gpus"`
+	Labels map[string]string `json:"labels"`
+	TTL    string            `json:"ttl,omitempty"`
+}
+
+type genericWorkload struct {
+	gpus   []string
+	labels map[string]string
+	expiry time.Time // zero if no ttl
+}
+
+type genericClient struct {
+	sync.Mutex
+	dir       string
+	workloads map[string]genericWorkload // descriptor file name -> workload
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+// NewGenericClient creates a scheduler client that attributes gpus to
+// workloads described by json/yaml files in globals.WorkloadDir
+func NewGenericClient(ctx context.Context) (SchedulerClient, error) {
+	return newGenericClient(ctx, globals.WorkloadDir)
+}
+
+func newGenericClient(ctx context.Context, dir string) (*genericClient, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	cl := &genericClient{
+		dir:       dir,
+		workloads: make(map[string]genericWorkload),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+
+	if err := watchJobDir(ctx, "generic", dir, isWorkloadDescriptor, cl.processDescriptor); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	logger.Log.Printf("created generic workload client on %v", dir)
+	return cl, nil
+}
+
+// isWorkloadDescriptor accepts json/yaml files, hidden files are skipped so
+// writers can create a temp file and rename it in place
+func isWorkloadDescriptor(name string) bool {
+	if strings.HasPrefix(name, ".") {
+		return false
+	}
+	switch strings.ToLower(path.Ext(name)) {
+	case ".json", ".yaml", ".yml":
+		return true
+	}
+	return false
+}
+
+func (cl *genericClient) processDescriptor(op fsnotify.Op, file string, buff []byte) {
+	if !op.Has(fsnotify.Write) {
+		cl.Lock()
+		delete(cl.workloads, file)
+		cl.Unlock()
+		logger.Log.Printf("removed generic workload %v", file)
+		return
+	}
+
+	modTime := time.Now()
+	if fi, err := os.Stat(path.Join(cl.dir, file)); err == nil {
+		modTime = fi.ModTime()
+	}
+	wl, err := parseWorkloadDescriptor(buff, modTime)
+	if err != nil {
+		logger.Log.Printf("could not parse workload descriptor %v, %v", file, err)
+		return
+	}
+	cl.Lock()
+	cl.workloads[file] = wl
+	cl.Unlock()
+	logger.Log.Printf("generic workload %v on gpus %v labels %v", file, wl.gpus, wl.labels)
+}
+
+// parseWorkloadDescriptor parses a json or yaml descriptor, the ttl is
+// applied from modTime
+func parseWorkloadDescriptor(buff []byte, modTime time.Time) (genericWorkload, error) {
+	var desc workloadDescriptor
+	if err := yaml.Unmarshal(buff, &desc); err != nil {
+		return genericWorkload{}, err
+	}
+	if len(desc.GPUs) == 0 {
+		return genericWorkload{}, fmt.Errorf("no gpus in descriptor")
+	}
+	wl := genericWorkload{
+		gpus:   desc.GPUs,
+		labels: desc.Labels,
+	}
+	if wl.labels == nil {
+		wl.labels = map[string]string{}
+	}
+	if desc.TTL != "" {
+		ttl, err := time.ParseDuration(desc.TTL)
+		if err != nil || ttl <= 0 {
+			return genericWorkload{}, fmt.Errorf("invalid ttl %q", desc.TTL)
+		}
+		wl.expiry = modTime.Add(ttl)
+	}
+	return wl, nil
+}
+
+// ListWorkloads - returns the generic workloads keyed by the gpu ids from the
+// descriptors, expired descriptors are dropped. When several descriptors name
+// the same gpu their labels are merged in file name order.
+func (cl *genericClient) ListWorkloads() (map[string]Workload, error) {
+	now := time.Now()
+	cl.Lock()
+	defer cl.Unlock()
+
+	files := make([]string, 0, len(cl.workloads))
+	for file, wl := range cl.workloads {
+		if !wl.expiry.IsZero() && now.After(wl.expiry) {
+			logger.Log.Printf("generic workload %v expired", file)
+			delete(cl.workloads, file)
+			continue
+		}
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	wls := make(map[string]Workload)
+	for _, file := range files {
+		wl := cl.workloads[file]
+		for _, gpu := range wl.gpus {
+			info, ok := wls[gpu].Info.(WorkloadInfo)
+			if !ok {
+				info = WorkloadInfo{Labels: map[string]string{}}
+			}
+			if info.Name == "" {
+				info.Name = file
+			} else {
+				info.Name = info.Name + "," + file
+			}
+			for k, v := range wl.labels {
+				info.Labels[k] = v
+			}
+			wls[gpu] = Workload{
+				Type: Generic,
+				Info: info,
+			}
+		}
+	}
+	return wls, nil
+}
+
+// CheckExportLabels returns true if any of the labels is provided by a
+// current workload descriptor
+func (cl *genericClient) CheckExportLabels(labels map[string]bool) bool {
+	cl.Lock()
+	defer cl.Unlock()
+	for _, wl := range cl.workloads {
+		for k := range wl.labels {
+			if labels[k] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (cl *genericClient) Close() error {
+	if cl.ctx != nil {
+		cl.cancel()
+	}
+	return nil
+}
+
+func (cl *genericClient) Type() SchedulerType {
+	return Generic
+}
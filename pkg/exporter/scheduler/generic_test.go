@@ -0,0 +1,192 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"os"
+	"path"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+func TestParseWorkloadDescriptor(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name       string
+		data       string
+		wantGPUs   []string
+		wantLabels map[string]string
+		wantExpiry time.Time
+		wantErr    bool
+	}{
+		{
+			name:       "json",
+			data:       `{"gpus": ["0", "1"], "labels": {"team": "research", "experiment": "llm-7b"}}`,
+			wantGPUs:   []string{"0", "1"},
+			wantLabels: map[string]string{"team": "research", "experiment": "llm-7b"},
+		},
+		{
+			name:       "yaml with ttl",
+			data:       "gpus: [\"0000:05:00.0\"]\nlabels:\n  team: infra\nttl: 2h\n",
+			wantGPUs:   []string{"0000:05:00.0"},
+			wantLabels: map[string]string{"team": "infra"},
+			wantExpiry: now.Add(2 * time.Hour),
+		},
+		{
+			name:       "no labels",
+			data:       `{"gpus": ["3"]}`,
+			wantGPUs:   []string{"3"},
+			wantLabels: map[string]string{},
+		},
+		{
+			name:    "no gpus",
+			data:    `{"labels": {"team": "infra"}}`,
+			wantErr: true,
+		},
+		{
+			name:    "bad ttl",
+			data:    `{"gpus": ["0"], "ttl": "soon"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid",
+			data:    `{"gpus": [`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			wl, err := parseWorkloadDescriptor([]byte(tc.data), now)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(wl.gpus, tc.wantGPUs) {
+				t.Errorf("gpus = %v, want %v", wl.gpus, tc.wantGPUs)
+			}
+			if !reflect.DeepEqual(wl.labels, tc.wantLabels) {
+				t.Errorf("labels = %v, want %v", wl.labels, tc.wantLabels)
+			}
+			if !wl.expiry.Equal(tc.wantExpiry) {
+				t.Errorf("expiry = %v, want %v", wl.expiry, tc.wantExpiry)
+			}
+		})
+	}
+}
+
+func TestIsWorkloadDescriptor(t *testing.T) {
+	tests := map[string]bool{
+		"train.json":  true,
+		"train.yaml":  true,
+		"train.YML":   true,
+		".train.json": false,
+		"train.tmp":   false,
+		"0":           false,
+	}
+	for name, want := range tests {
+		if got := isWorkloadDescriptor(name); got != want {
+			t.Errorf("isWorkloadDescriptor(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
+
+func TestGenericListWorkloads(t *testing.T) {
+	cl := &genericClient{workloads: map[string]genericWorkload{
+		"a.json": {gpus: []string{"0", "1"}, labels: map[string]string{"team": "research", "stage": "train"}},
+		"b.yaml": {gpus: []string{"1"}, labels: map[string]string{"stage": "eval"}},
+		"c.json": {gpus: []string{"2"}, labels: map[string]string{"team": "old"}, expiry: time.Now().Add(-time.Minute)},
+	}}
+
+	wls, err := cl.ListWorkloads()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]WorkloadInfo{
+		"0": {Name: "a.json", Labels: map[string]string{"team": "research", "stage": "train"}},
+		"1": {Name: "a.json,b.yaml", Labels: map[string]string{"team": "research", "stage": "eval"}},
+	}
+	if len(wls) != len(want) {
+		t.Fatalf("got %d workloads, want %d: %v", len(wls), len(want), wls)
+	}
+	for gpu, info := range want {
+		if wls[gpu].Type != Generic || !reflect.DeepEqual(wls[gpu].Info, info) {
+			t.Errorf("gpu %v got %+v, want %+v", gpu, wls[gpu], info)
+		}
+	}
+	if _, ok := cl.workloads["c.json"]; ok {
+		t.Errorf("expired descriptor not dropped")
+	}
+	if !cl.CheckExportLabels(map[string]bool{"stage": true}) || cl.CheckExportLabels(map[string]bool{"pod": true}) {
+		t.Errorf("unexpected CheckExportLabels result")
+	}
+
+	cl.processDescriptor(fsnotify.Remove, "b.yaml", nil)
+	wls, _ = cl.ListWorkloads()
+	if wls["1"].Info.(WorkloadInfo).Labels["stage"] != "train" {
+		t.Errorf("expected b.yaml labels removed, got %v", wls["1"])
+	}
+}
+
+func TestGenericClientWatch(t *testing.T) {
+	dir := t.TempDir()
+	// descriptor present before the client starts is replayed
+	if err := os.WriteFile(path.Join(dir, "pre.json"), []byte(`{"gpus":["0"],"labels":{"team":"a"}}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cl, err := newGenericClient(ctx, dir)
+	if err != nil {
+		t.Fatalf("client create failed: %v", err)
+	}
+	defer cl.Close()
+
+	if err := os.WriteFile(path.Join(dir, "new.yaml"), []byte("gpus: [\"1\"]\nlabels:\n  team: b\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	waitFor := func(cond func(map[string]Workload) bool) {
+		t.Helper()
+		deadline := time.Now().Add(5 * time.Second)
+		for time.Now().Before(deadline) {
+			wls, _ := cl.ListWorkloads()
+			if cond(wls) {
+				return
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+		wls, _ := cl.ListWorkloads()
+		t.Fatalf("condition not met, workloads %v", wls)
+	}
+	waitFor(func(wls map[string]Workload) bool { return len(wls) == 2 })
+
+	if err := os.Remove(path.Join(dir, "pre.json")); err != nil {
+		t.Fatal(err)
+	}
+	waitFor(func(wls map[string]Workload) bool {
+		_, ok := wls["0"]
+		return !ok && len(wls) == 1
+	})
+}
@@ -0,0 +1,99 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/fsnotify/fsnotify"
+)
+
+// watchJobDir watches a directory of per gpu job files written by scheduler
+// prolog/epilog scripts. handler is called with fsnotify.Write and the file
+// content for created/updated files and with fsnotify.Remove for deleted files.
+// Existing files are replayed as writes on startup.
+func watchJobDir(ctx context.Context, name, dir string, accept func(string) bool,
+	handler func(op fsnotify.Op, file string, data []byte)) error {
+	if err := os.MkdirAll(path.Dir(dir), 0644); err != nil {
+		logger.Log.Printf("error creating %v dir %v err: %v", name, dir, err)
+	}
+
+	// Create new watcher.
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return fmt.Errorf("%v fsnotify watcher init failed: %v. Likely cause: host-wide inotify exhaustion (fs.inotify.max_user_instances).", name, err)
+	}
+
+	// Add a path.
+	if err := watcher.Add(dir); err != nil {
+		watcher.Close()
+		return fmt.Errorf("%v fsnotify watcher failed to watch %q: %v. Check directory permissions.", name, dir, err)
+	}
+
+	// Start listening for events.
+	go func() {
+		defer watcher.Close()
+		for ctx.Err() == nil {
+			select {
+			case event, ok := <-watcher.Events:
+				if !ok {
+					return
+				}
+
+				if !accept(path.Base(event.Name)) {
+					logger.Log.Printf("skip event: %+v", event)
+					continue
+				}
+				logger.Log.Printf("event: %+v", event)
+
+				if event.Has(fsnotify.Create | fsnotify.Write) {
+					logger.Log.Printf("modified file: %v", event.Name)
+					data, err := os.ReadFile(event.Name)
+					if err != nil {
+						logger.Log.Printf("failed to read %v, %v", event.Name, err)
+						continue
+					}
+					handler(fsnotify.Write, path.Base(event.Name), data)
+
+				} else if event.Has(fsnotify.Remove) {
+					logger.Log.Printf("deleted file: %v", event.Name)
+					handler(fsnotify.Remove, path.Base(event.Name), nil)
+				}
+			case err, ok := <-watcher.Errors:
+				if !ok {
+					return
+				}
+				logger.Log.Printf("error: %v", err)
+			case <-ctx.Done():
+				logger.Log.Printf("%v job watcher stopped", name)
+				return
+			}
+		}
+	}()
+
+	// read existing
+	if fds, err := os.ReadDir(dir); err == nil {
+		for _, f := range fds {
+			watcher.Events <- fsnotify.Event{Name: path.Join(dir, f.Name()), Op: fsnotify.Write}
+		}
+	}
+	return nil
+}
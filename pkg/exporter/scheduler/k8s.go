@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/ROCm/device-metrics-exporter/pkg/exporter/gen/exportermetrics"
@@ -38,9 +39,27 @@ var KubernetesLabels = map[string]bool{
 	exportermetrics.MetricLabel_CONTAINER.String(): true,
 }
 
+const (
+	// podResourcesRefreshInterval bounds the age of the cached pod resources
+	// when no pod events are received
+	podResourcesRefreshInterval = 30 * time.Second
+	// podResourcesMinRefreshInterval rate limits event triggered refreshes
+	podResourcesMinRefreshInterval = time.Second
+	// podResourcesTimeout bounds each pod resources list call
+	podResourcesTimeout = 10 * time.Second
+)
+
 type podResourcesClient struct {
-	clientConn *grpc.ClientConn
-	ctx        context.Context // parent context
+	sync.Mutex
+	clientConn    *grpc.ClientConn
+	socket        string
+	ctx           context.Context // parent context
+	cancel        context.CancelFunc
+	workloads     map[string]Workload // cached device id -> workload
+	lastRefresh   time.Time           // last successful refresh
+	refreshErrors uint64
+	refreshCh     chan struct{}
+	refreshMu     sync.Mutex // serializes the list and reconnect
 }
 
 // NewKubernetesClient - creates a kubernetes schedler client
@@ -49,21 +68,121 @@ func NewKubernetesClient(ctx context.Context) (SchedulerClient, error) {
 		logger.Log.Printf("no kubelet found")
 		return nil, fmt.Errorf("no kubelet, %v", err)
 	}
-	client, err := grpc.NewClient("unix://"+globals.PodResourceSocket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	cl, err := newPodResourcesClient(ctx, globals.PodResourceSocket)
+	if err != nil {
+		return nil, err
+	}
+	go cl.refreshLoop()
+	logger.Log.Printf("created k8s scheduler client")
+	return cl, nil
+}
+
+func newPodResourcesClient(ctx context.Context, socket string) (*podResourcesClient, error) {
+	client, err := grpc.NewClient("unix://"+socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		logger.Log.Printf("kubelet socket err: %v", err)
 		return nil, fmt.Errorf("kubelet socket error, %v", err)
 	}
-	logger.Log.Printf("created k8s scheduler client")
-	return &podResourcesClient{clientConn: client, ctx: ctx}, nil
+	ctx, cancel := context.WithCancel(ctx)
+	return &podResourcesClient{
+		clientConn: client,
+		socket:     socket,
+		ctx:        ctx,
+		cancel:     cancel,
+		refreshCh:  make(chan struct{}, 1),
+	}, nil
+}
+
+// Refresh requests a refresh of the cached pod resources, requests made
+// while a refresh is pending are coalesced
+func (cl *podResourcesClient) Refresh() {
+	select {
+	case cl.refreshCh <- struct{}{}:
+	default:
+	}
+}
+
+// CacheStats returns the age of the cached pod resources and the number of
+// failed refreshes
+func (cl *podResourcesClient) CacheStats() CacheStats {
+	cl.Lock()
+	defer cl.Unlock()
+	return CacheStats{
+		LastRefresh:   cl.lastRefresh,
+		RefreshErrors: cl.refreshErrors,
+	}
+}
+
+// refreshLoop refreshes the cache on pod events and on a bounded interval
+func (cl *podResourcesClient) refreshLoop() {
+	ticker := time.NewTicker(podResourcesRefreshInterval)
+	defer ticker.Stop()
+	for {
+		if err := cl.refresh(); err != nil {
+			logger.Log.Printf("pod resources cache refresh failed, %v", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-cl.refreshCh:
+			logger.Debugf("pod resources cache refresh on pod event")
+			ticker.Reset(podResourcesRefreshInterval)
+		case <-cl.ctx.Done():
+			logger.Log.Printf("pod resources cache stopped")
+			return
+		}
+		// rate limit bursts of pod events
+		select {
+		case <-time.After(podResourcesMinRefreshInterval):
+		case <-cl.ctx.Done():
+			logger.Log.Printf("pod resources cache stopped")
+			return
+		}
+	}
+}
+
+// refresh lists the pod resources and replaces the cache on success, the
+// stale cache is kept on failure
+func (cl *podResourcesClient) refresh() error {
+	cl.refreshMu.Lock()
+	defer cl.refreshMu.Unlock()
+	wls, err := cl.listPodResources()
+	cl.Lock()
+	defer cl.Unlock()
+	if err != nil {
+		cl.refreshErrors++
+		return err
+	}
+	cl.workloads = wls
+	cl.lastRefresh = time.Now()
+	return nil
 }
 
 // ListWorkloads - list all the workloads
 // This function will return a map of device id to workload
 // The device id is the device id (pcie id or partition xcd string) of the GPU
+// Workloads are served from the cache, the pod resources are listed inline
+// only until the first successful refresh
 func (cl *podResourcesClient) ListWorkloads() (map[string]Workload, error) {
+	cl.Lock()
+	synced := !cl.lastRefresh.IsZero()
+	cl.Unlock()
+	if !synced {
+		if err := cl.refresh(); err != nil {
+			return nil, err
+		}
+	}
+	cl.Lock()
+	defer cl.Unlock()
+	wls := make(map[string]Workload, len(cl.workloads))
+	for k, v := range cl.workloads {
+		wls[k] = v
+	}
+	return wls, nil
+}
+
+func (cl *podResourcesClient) listPodResources() (map[string]Workload, error) {
 	prCl := kube.NewPodResourcesListerClient(cl.clientConn)
-	ctx, cancel := context.WithTimeout(cl.ctx, time.Second*10)
+	ctx, cancel := context.WithTimeout(cl.ctx, podResourcesTimeout)
 	defer cancel()
 	resp, err := prCl.List(ctx, &kube.ListPodResourcesRequest{})
 	if err != nil {
@@ -76,7 +195,7 @@ func (cl *podResourcesClient) ListWorkloads() (map[string]Workload, error) {
 
 			// Retry once after reconnect
 			prCl = kube.NewPodResourcesListerClient(cl.clientConn)
-			ctx, cancel = context.WithTimeout(cl.ctx, time.Second*10)
+			ctx, cancel = context.WithTimeout(cl.ctx, podResourcesTimeout)
 			defer cancel()
 
 			resp, err = prCl.List(ctx, &kube.ListPodResourcesRequest{})
@@ -144,6 +263,9 @@ func (cl *podResourcesClient) CheckExportLabels(labels map[string]bool) bool {
 }
 
 func (cl *podResourcesClient) Close() error {
+	if cl.cancel != nil {
+		cl.cancel()
+	}
 	return cl.clientConn.Close()
 }
 
@@ -159,7 +281,7 @@ func (cl *podResourcesClient) reconnect() error {
 	}
 
 	var err error
-	cl.clientConn, err = grpc.NewClient("unix://"+globals.PodResourceSocket, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	cl.clientConn, err = grpc.NewClient("unix://"+cl.socket, grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
 		logger.Log.Printf("failed to reconnect to kubelet socket: %v", err)
 		return err
@@ -0,0 +1,169 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/grpc"
+	kube "k8s.io/kubelet/pkg/apis/podresources/v1"
+)
+
+type fakePodResourcesServer struct {
+	kube.UnimplementedPodResourcesListerServer
+	sync.Mutex
+	pods  []*kube.PodResources
+	err   error
+	calls int
+}
+
+func (f *fakePodResourcesServer) List(context.Context, *kube.ListPodResourcesRequest) (*kube.ListPodResourcesResponse, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.calls++
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &kube.ListPodResourcesResponse{PodResources: f.pods}, nil
+}
+
+func (f *fakePodResourcesServer) set(pods []*kube.PodResources, err error) {
+	f.Lock()
+	defer f.Unlock()
+	f.pods, f.err = pods, err
+}
+
+func (f *fakePodResourcesServer) listCalls() int {
+	f.Lock()
+	defer f.Unlock()
+	return f.calls
+}
+
+func gpuPod(name, devId string) *kube.PodResources {
+	return &kube.PodResources{
+		Name:      name,
+		Namespace: "default",
+		Containers: []*kube.ContainerResources{{
+			Name:    "main",
+			Devices: []*kube.ContainerDevices{{ResourceName: "amd.com/gpu", DeviceIds: []string{devId}}},
+		}},
+	}
+}
+
+// startFakeKubelet serves the pod resources api on a unix socket
+func startFakeKubelet(t *testing.T, fake *fakePodResourcesServer) string {
+	t.Helper()
+	socket := path.Join(t.TempDir(), "kubelet.sock")
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatalf("listen failed: %v", err)
+	}
+	srv := grpc.NewServer()
+	kube.RegisterPodResourcesListerServer(srv, fake)
+	go func() { _ = srv.Serve(l) }()
+	t.Cleanup(srv.Stop)
+	return socket
+}
+
+func TestPodResourcesCache(t *testing.T) {
+	fake := &fakePodResourcesServer{pods: []*kube.PodResources{gpuPod("train", "0000:05:00.0")}}
+	cl, err := newPodResourcesClient(context.Background(), startFakeKubelet(t, fake))
+	if err != nil {
+		t.Fatalf("client create failed: %v", err)
+	}
+	defer cl.Close()
+
+	// first list syncs the cache inline
+	wls, err := cl.ListWorkloads()
+	if err != nil {
+		t.Fatalf("list workloads failed: %v", err)
+	}
+	if wls["0000:05:00.0"].Info.(PodResourceInfo).Pod != "train" {
+		t.Fatalf("unexpected workloads %v", wls)
+	}
+
+	// scrapes are served from the cache
+	fake.set([]*kube.PodResources{gpuPod("infer", "0000:05:00.0")}, nil)
+	for i := 0; i < 3; i++ {
+		wls, _ = cl.ListWorkloads()
+	}
+	if calls := fake.listCalls(); calls != 1 || wls["0000:05:00.0"].Info.(PodResourceInfo).Pod != "train" {
+		t.Fatalf("expected cached workloads after %d calls, got %v", calls, wls)
+	}
+
+	// refresh picks up the new allocation
+	if err := cl.refresh(); err != nil {
+		t.Fatalf("refresh failed: %v", err)
+	}
+	wls, _ = cl.ListWorkloads()
+	if wls["0000:05:00.0"].Info.(PodResourceInfo).Pod != "infer" {
+		t.Fatalf("expected refreshed workloads, got %v", wls)
+	}
+
+	// failed refresh keeps the stale cache and counts the error
+	fake.set(nil, fmt.Errorf("kubelet busy"))
+	if err := cl.refresh(); err == nil {
+		t.Fatalf("expected refresh error")
+	}
+	wls, err = cl.ListWorkloads()
+	if err != nil || len(wls) != 1 {
+		t.Fatalf("expected stale workloads, got %v, %v", wls, err)
+	}
+	stats := cl.CacheStats()
+	if stats.RefreshErrors != 1 || stats.LastRefresh.IsZero() {
+		t.Fatalf("unexpected cache stats %+v", stats)
+	}
+}
+
+func TestPodResourcesCacheNotSynced(t *testing.T) {
+	fake := &fakePodResourcesServer{err: fmt.Errorf("kubelet busy")}
+	cl, err := newPodResourcesClient(context.Background(), startFakeKubelet(t, fake))
+	if err != nil {
+		t.Fatalf("client create failed: %v", err)
+	}
+	defer cl.Close()
+	if _, err := cl.ListWorkloads(); err == nil {
+		t.Fatalf("expected error before the first successful refresh")
+	}
+
+	c := NewCacheCollector(cl, prometheus.Labels{"hostname": "node1"})
+	expected := `
+# HELP scheduler_cache_age_seconds Seconds since the last successful refresh of the scheduler workload cache, -1 if never refreshed
+# TYPE scheduler_cache_age_seconds gauge
+scheduler_cache_age_seconds{hostname="node1",scheduler="kubernetes"} -1
+# HELP scheduler_cache_refresh_errors_total Number of failed refreshes of the scheduler workload cache
+# TYPE scheduler_cache_refresh_errors_total counter
+scheduler_cache_refresh_errors_total{hostname="node1",scheduler="kubernetes"} 1
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(expected)); err != nil {
+		t.Fatalf("unexpected cache metrics: %v", err)
+	}
+}
+
+func TestCacheCollectorNotCached(t *testing.T) {
+	if c := NewCacheCollector(&genericClient{}, nil); c != nil {
+		t.Fatalf("expected no collector for uncached client")
+	}
+}
@@ -0,0 +1,142 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+	"github.com/fsnotify/fsnotify"
+)
+
+type pbsClient struct {
+	sync.Mutex
+	GpuJobs map[string]JobInfo
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+// NewPBSClient creates a PBS Pro scheduler client that watches the per gpu
+// job files written by the exporter execjob_launch/execjob_end hook
+func NewPBSClient(ctx context.Context) (SchedulerClient, error) {
+	ctx, cancel := context.WithCancel(ctx)
+
+	cl := &pbsClient{
+		GpuJobs: make(map[string]JobInfo),
+		ctx:     ctx,
+		cancel:  cancel,
+	}
+
+	isJobFile := func(name string) bool {
+		_, err := strconv.Atoi(name)
+		return err == nil
+	}
+	if err := watchJobDir(ctx, "pbs", globals.PBSDir, isJobFile, cl.processPBS); err != nil {
+		cancel()
+		return nil, err
+	}
+
+	logger.Log.Printf("created pbs scheduler client")
+	return cl, nil
+}
+
+// processPBS handles a job file named after the gpu index, the content is the
+// job environment captured by the hook
+func (cl *pbsClient) processPBS(op fsnotify.Op, gpu string, buff []byte) {
+	if !op.Has(fsnotify.Write) {
+		cl.Lock()
+		delete(cl.GpuJobs, gpu)
+		cl.Unlock()
+		logger.Log.Printf("removed pbs job on gpu %v", gpu)
+		return
+	}
+
+	job, err := parsePBSJobEnv(buff)
+	if err != nil {
+		logger.Log.Printf("could not parse pbs job env %v", err)
+		logger.Log.Printf("job env %v ", string(buff))
+		return
+	}
+	cl.Lock()
+	cl.GpuJobs[gpu] = job
+	cl.Unlock()
+	logger.Log.Printf("pbs job %+v on gpu %v", job, gpu)
+}
+
+// parsePBSJobEnv converts the hook job env to JobInfo, the cluster is the
+// PBS server name which is the suffix of the job id (<seq>.<server>)
+func parsePBSJobEnv(buff []byte) (JobInfo, error) {
+	var jobEnv map[string]string
+	if err := json.Unmarshal(buff, &jobEnv); err != nil {
+		return JobInfo{}, err
+	}
+	job := JobInfo{
+		Id:        jobEnv["PBS_JOBID"],
+		User:      jobEnv["PBS_JOBUSER"],
+		Partition: jobEnv["PBS_QUEUE"],
+		Cluster:   jobEnv["PBS_SERVER"],
+	}
+	if job.User == "" {
+		job.User = jobEnv["PBS_O_LOGNAME"]
+	}
+	if job.Cluster == "" {
+		if _, server, ok := strings.Cut(job.Id, "."); ok {
+			job.Cluster = server
+		}
+	}
+	return job, nil
+}
+
+// ListWorkloads - returns the list of pbs jobs running on the gpus
+// the key is the gpu index and the value is the job info
+func (cl *pbsClient) ListWorkloads() (map[string]Workload, error) {
+	jobs := make(map[string]Workload)
+	cl.Lock()
+	defer cl.Unlock()
+	for k, v := range cl.GpuJobs {
+		jobs[k] = Workload{
+			Type: PBS,
+			Info: v,
+		}
+	}
+	return jobs, nil
+}
+
+func (cl *pbsClient) CheckExportLabels(labels map[string]bool) bool {
+	for k := range SlurmLabels {
+		if ok := labels[k]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func (cl *pbsClient) Close() error {
+	if cl.ctx != nil {
+		cl.cancel()
+	}
+	return nil
+}
+
+func (cl *pbsClient) Type() SchedulerType {
+	return PBS
+}
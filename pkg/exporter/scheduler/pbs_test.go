@@ -0,0 +1,82 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+func TestParsePBSJobEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     string
+		want    JobInfo
+		wantErr bool
+	}{
+		{
+			name: "hook env",
+			env:  `{"PBS_JOBID":"1042.pbs-head","PBS_JOBUSER":"alice","PBS_QUEUE":"workq","PBS_SERVER":"pbs-head"}`,
+			want: JobInfo{Id: "1042.pbs-head", User: "alice", Partition: "workq", Cluster: "pbs-head"},
+		},
+		{
+			name: "fallback user and server",
+			env:  `{"PBS_JOBID":"7.head01","PBS_O_LOGNAME":"bob","PBS_QUEUE":"gpu"}`,
+			want: JobInfo{Id: "7.head01", User: "bob", Partition: "gpu", Cluster: "head01"},
+		},
+		{
+			name:    "invalid",
+			env:     `PBS_JOBID=7`,
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parsePBSJobEnv([]byte(tc.env))
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Fatalf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestProcessPBS(t *testing.T) {
+	cl := &pbsClient{GpuJobs: make(map[string]JobInfo)}
+	cl.processPBS(fsnotify.Write, "2", []byte(`{"PBS_JOBID":"1042.pbs-head","PBS_JOBUSER":"alice","PBS_QUEUE":"workq"}`))
+	cl.processPBS(fsnotify.Write, "3", []byte(`garbage`))
+
+	wls, _ := cl.ListWorkloads()
+	if len(wls) != 1 || wls["2"].Type != PBS || wls["2"].Info.(JobInfo).Id != "1042.pbs-head" {
+		t.Fatalf("unexpected workloads %v", wls)
+	}
+
+	cl.processPBS(fsnotify.Remove, "2", nil)
+	wls, _ = cl.ListWorkloads()
+	if len(wls) != 0 {
+		t.Fatalf("expected no workloads after remove, got %v", wls)
+	}
+}
@@ -16,13 +16,20 @@ limitations under the License.
 
 package scheduler
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type SchedulerType int
 
 const (
 	Kubernetes SchedulerType = iota + 1
 	Slurm
+	Flux
+	PBS
+	Generic
+	Container
 )
 
 type Workload struct {
@@ -38,6 +45,20 @@ type SchedulerClient interface {
 	Type() SchedulerType
 }
 
+// WorkloadCache is implemented by scheduler clients serving workloads from a
+// cache refreshed in the background
+type WorkloadCache interface {
+	// Refresh requests an asynchronous refresh of the cache
+	Refresh()
+	// CacheStats returns the cache refresh state
+	CacheStats() CacheStats
+}
+
+type CacheStats struct {
+	LastRefresh   time.Time // last successful refresh, zero if never
+	RefreshErrors uint64    // failed refreshes since start
+}
+
 type PodResourceInfo struct {
 	Pod       string
 	Namespace string
@@ -49,15 +70,35 @@ type JobInfo struct {
 	User      string
 	Partition string
 	Cluster   string
+	// job opted out of GPU profiling
+	ProfilerOptOut bool
+}
+
+// ContainerInfo describes a non k8s container holding the GPU, fields are
+// comma separated when several containers share the GPU
+type ContainerInfo struct {
+	Id      string
+	Name    string
+	Image   string
+	Project string // compose project
+	Runtime string // docker or cri
+}
+
+// WorkloadInfo holds the arbitrary key/value labels of a generic workload
+type WorkloadInfo struct {
+	Name   string
+	Labels map[string]string
 }
 
 func (s SchedulerType) String() string {
-	return [...]string{"Kubernetes", "Slurm"}[s-1]
+	return [...]string{"Kubernetes", "Slurm", "Flux", "PBS", "Generic", "Container"}[s-1]
 }
 
 // returns String representation of Workload
 // k8s: Pod: <pod-name>, Namespace: <namespace>, Container: <container-name>
-// slurm: Job: <job-id>, User: <user>, Partition: <partition>, Cluster: <cluster>
+// slurm/flux/pbs: Job: <job-id>, User: <user>, Partition: <partition>, Cluster: <cluster>
+// generic: Workload: <descriptor-name>, Labels: <labels>
+// container: Container: <name>, Image: <image>, Project: <compose-project>
 func (w Workload) String() string {
 	switch w.Type {
 	case Kubernetes:
@@ -65,11 +106,20 @@ func (w Workload) String() string {
 			return fmt.Sprintf("Pod: %s, Namespace: %s, Container: %s",
 				podInfo.Pod, podInfo.Namespace, podInfo.Container)
 		}
-	case Slurm:
+	case Slurm, Flux, PBS:
 		if jobInfo, ok := w.Info.(JobInfo); ok {
 			return fmt.Sprintf("Job: %s, User: %s, Partition: %s, Cluster: %s",
 				jobInfo.Id, jobInfo.User, jobInfo.Partition, jobInfo.Cluster)
 		}
+	case Generic:
+		if wlInfo, ok := w.Info.(WorkloadInfo); ok {
+			return fmt.Sprintf("Workload: %s, Labels: %v", wlInfo.Name, wlInfo.Labels)
+		}
+	case Container:
+		if ctrInfo, ok := w.Info.(ContainerInfo); ok {
+			return fmt.Sprintf("Container: %s, Image: %s, Project: %s",
+				ctrInfo.Name, ctrInfo.Image, ctrInfo.Project)
+		}
 	}
 	return fmt.Sprintf("Workload Type: %s", w.Type.String())
 }
@@ -78,7 +128,13 @@ func GetExportLabels(t SchedulerType) map[string]bool {
 	switch t {
 	case Kubernetes:
 		return KubernetesLabels
+	case Generic:
+		// generic workload labels are user defined through ExtraWorkloadLabels
+		return map[string]bool{}
+	case Container:
+		return ContainerLabels
 	default:
+		// all batch schedulers share the JOB_ID/JOB_USER/JOB_PARTITION labels
 		return SlurmLabels
 	}
 }
@@ -20,8 +20,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"os"
-	"path"
 	"strconv"
 	"strings"
 	"sync"
@@ -32,6 +30,10 @@ import (
 	"github.com/fsnotify/fsnotify"
 )
 
+// SlurmProfilerOptOutEnv is the job env set to true by jobs opting out of
+// GPU profiling
+const SlurmProfilerOptOutEnv = "AMD_PROFILER_OPT_OUT"
+
 var SlurmLabels = map[string]bool{
 	exportermetrics.MetricLabel_JOB_ID.String():        true,
 	exportermetrics.MetricLabel_JOB_USER.String():      true,
@@ -55,70 +57,13 @@ func NewSlurmClient(ctx context.Context) (SchedulerClient, error) {
 		cancel:  cancel,
 	}
 
-	if err := os.MkdirAll(path.Dir(globals.SlurmDir), 0644); err != nil {
-		logger.Log.Printf("error creating slurm dir %v err: %v", globals.SlurmDir, err)
+	isJobFile := func(name string) bool {
+		_, err := strconv.Atoi(name)
+		return err == nil
 	}
-
-	// Create new watcher.
-	watcher, err := fsnotify.NewWatcher()
-	if err != nil {
-		cancel()
-		return nil, fmt.Errorf("slurm fsnotify watcher init failed: %v. Likely cause: host-wide inotify exhaustion (fs.inotify.max_user_instances).", err)
-	}
-
-	// Add a path.
-	if err := watcher.Add(globals.SlurmDir); err != nil {
-		watcher.Close()
+	if err := watchJobDir(ctx, "slurm", globals.SlurmDir, isJobFile, cl.processSlurm); err != nil {
 		cancel()
-		return nil, fmt.Errorf("slurm fsnotify watcher failed to watch %q: %v. Check directory permissions.", globals.SlurmDir, err)
-	}
-
-	// Start listening for events.
-	go func() {
-		defer watcher.Close()
-		for cl.ctx.Err() == nil {
-			select {
-			case event, ok := <-watcher.Events:
-				if !ok {
-					return
-				}
-
-				if _, err := strconv.Atoi(path.Base(event.Name)); err != nil {
-					logger.Log.Printf("skip event: %+v", event)
-					continue
-				}
-				logger.Log.Printf("event: %+v", event)
-
-				if event.Has(fsnotify.Create | fsnotify.Write) {
-					logger.Log.Printf("modified file: %v", event.Name)
-					data, err := os.ReadFile(event.Name)
-					if err != nil {
-						logger.Log.Printf("failed to read %v, %v", event.Name, err)
-						continue
-					}
-					cl.processSlurm(fsnotify.Write, path.Base(event.Name), data)
-
-				} else if event.Has(fsnotify.Remove) {
-					logger.Log.Printf("deleted file: %v", event.Name)
-					cl.processSlurm(fsnotify.Remove, path.Base(event.Name), nil)
-				}
-			case err, ok := <-watcher.Errors:
-				if !ok {
-					return
-				}
-				logger.Log.Printf("error: %v", err)
-			case <-cl.ctx.Done():
-				logger.Log.Printf("slurm job watcher stopped")
-				return
-			}
-		}
-	}()
-
-	// read existing
-	if fds, err := os.ReadDir(globals.SlurmDir); err == nil {
-		for _, f := range fds {
-			watcher.Events <- fsnotify.Event{Name: globals.SlurmDir + "/" + f.Name(), Op: fsnotify.Write}
-		}
+		return nil, err
 	}
 
 	logger.Log.Printf("created slurm scheduler client")
@@ -139,10 +84,11 @@ func (cl *client) processSlurm(op fsnotify.Op, name string, buff []byte) {
 			cl.Lock()
 			for _, allocGPU := range strings.Split(gpus, ",") {
 				cl.GpuJobs[allocGPU] = JobInfo{
-					Id:        jobEnv["SLURM_JOB_ID"],
-					User:      jobEnv["SLURM_JOB_USER"],
-					Partition: jobEnv["SLURM_JOB_PARTITION"],
-					Cluster:   jobEnv["SLURM_CLUSTER_NAME"],
+					Id:             jobEnv["SLURM_JOB_ID"],
+					User:           jobEnv["SLURM_JOB_USER"],
+					Partition:      jobEnv["SLURM_JOB_PARTITION"],
+					Cluster:        jobEnv["SLURM_CLUSTER_NAME"],
+					ProfilerOptOut: isTrue(jobEnv[SlurmProfilerOptOutEnv]),
 				}
 			}
 			cl.Unlock()
@@ -157,6 +103,15 @@ func (cl *client) processSlurm(op fsnotify.Op, name string, buff []byte) {
 	}
 }
 
+// isTrue returns true for the true values of a job env flag
+func isTrue(v string) bool {
+	switch strings.ToLower(strings.TrimSpace(v)) {
+	case "1", "true", "yes":
+		return true
+	}
+	return false
+}
+
 // ListWorkloads - returns the list of workloads
 // for slurm it returns the list of jobs running on the gpus
 // the key is the gpu id/render id (integer) and the value is the job info
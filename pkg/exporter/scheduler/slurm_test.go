@@ -0,0 +1,59 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package scheduler
+
+import (
+	"testing"
+
+	"github.com/fsnotify/fsnotify"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+func TestProcessSlurmProfilerOptOut(t *testing.T) {
+	logger.Init(true)
+	tests := []struct {
+		name string
+		env  string
+		want JobInfo
+	}{
+		{
+			name: "opt out",
+			env:  `{"SLURM_JOB_ID":"12","SLURM_JOB_USER":"alice","CUDA_VISIBLE_DEVICES":"0","AMD_PROFILER_OPT_OUT":"true"}`,
+			want: JobInfo{Id: "12", User: "alice", ProfilerOptOut: true},
+		},
+		{
+			name: "flag unset",
+			env:  `{"SLURM_JOB_ID":"13","SLURM_JOB_USER":"bob","CUDA_VISIBLE_DEVICES":"0","AMD_PROFILER_OPT_OUT":""}`,
+			want: JobInfo{Id: "13", User: "bob"},
+		},
+		{
+			name: "numeric flag",
+			env:  `{"SLURM_JOB_ID":"14","CUDA_VISIBLE_DEVICES":"0","AMD_PROFILER_OPT_OUT":"1"}`,
+			want: JobInfo{Id: "14", ProfilerOptOut: true},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cl := &client{GpuJobs: make(map[string]JobInfo)}
+			cl.processSlurm(fsnotify.Write, "0", []byte(tc.env))
+			if got := cl.GpuJobs["0"]; got != tc.want {
+				t.Fatalf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
@@ -38,6 +38,7 @@ import (
 // SvcHandler is a struct that manages the gRPC server and metrics services.
 type SvcHandler struct {
 	grpc                 *grpc.Server
+	ifoeGrpc             *grpc.Server // IFOE health serves the NIC health service API on its own server
 	mh                   *metricsutil.MetricsHandler
 	gpuHealthSvc         *gpumetricsserver.MetricsSvcImpl
 	nicHealthSvc         *nicmetricsserver.MetricsSvcImpl
@@ -48,7 +49,7 @@ type SvcHandler struct {
 	enableDebugAPI       bool
 	serverWg             sync.WaitGroup
 	errChan              chan error
-	grpcMu               sync.Mutex // guards grpc against concurrent Stop/Run shutdown
+	grpcMu               sync.Mutex // guards grpc and ifoeGrpc against concurrent Stop/Run shutdown
 }
 
 // SvcHandlerOption set desired option
@@ -87,7 +88,7 @@ func InitSvcs(mh *metricsutil.MetricsHandler, opts ...SvcHandlerOption) *SvcHand
 	svcHandler := &SvcHandler{
 		mh:      mh,
 		grpc:    grpc.NewServer(),
-		errChan: make(chan error, 2), // Buffered channel for 2 potential error from 2 listeners
+		errChan: make(chan error, 3), // Buffered channel for 3 potential error from 3 listeners
 	}
 	for _, o := range opts {
 		o(svcHandler)
@@ -95,6 +96,7 @@ func InitSvcs(mh *metricsutil.MetricsHandler, opts ...SvcHandlerOption) *SvcHand
 
 	svcHandler.gpuHealthSvc = gpumetricsserver.NewMetricsServer(svcHandler.enableDebugAPI)
 	svcHandler.nicHealthSvc = nicmetricsserver.NewMetricsServer(svcHandler.enableDebugAPI)
+	svcHandler.ifoeHealthSvc = nicmetricsserver.NewMetricsServer(svcHandler.enableDebugAPI)
 	return svcHandler
 }
 
@@ -113,20 +115,26 @@ func (s *SvcHandler) RegisterIFOEHealthClient(client nicmetricsserver.HealthInte
 	return s.ifoeHealthSvc.RegisterHealthClient(client)
 }
 
-// Stop stops the gRPC server. It is safe to call concurrently and more than
-// once: the grpc handle is captured and cleared under grpcMu so a second
+// Stop stops the gRPC servers. It is safe to call concurrently and more than
+// once: the grpc handles are captured and cleared under grpcMu so a second
 // caller (e.g. a SIGTERM arriving after a prior shutdown) never dereferences
 // a nil server.
 func (s *SvcHandler) Stop() {
 	s.grpcMu.Lock()
 	grpcServer := s.grpc
 	s.grpc = nil
+	ifoeGrpcServer := s.ifoeGrpc
+	s.ifoeGrpc = nil
 	s.grpcMu.Unlock()
 
 	if grpcServer != nil {
 		logger.Log.Printf("stopping Health gRPC server")
 		grpcServer.GracefulStop()
 	}
+	if ifoeGrpcServer != nil {
+		logger.Log.Printf("stopping IFOE Health gRPC server")
+		ifoeGrpcServer.GracefulStop()
+	}
 }
 
 // Run starts the gRPC server and listens for incoming connections on the specified sockets.
@@ -144,6 +152,13 @@ func (s *SvcHandler) Run() error {
 		s.grpc = grpc.NewServer()
 	}
 	grpcServer := s.grpc // capture under the mutex; serving goroutines must not read s.grpc directly
+	var ifoeGrpcServer *grpc.Server
+	if s.enableIFOEMonitoring {
+		if s.ifoeGrpc == nil {
+			s.ifoeGrpc = grpc.NewServer()
+		}
+		ifoeGrpcServer = s.ifoeGrpc
+	}
 	s.grpcMu.Unlock()
 
 	// register all the services with the gRPC server
@@ -154,6 +169,9 @@ func (s *SvcHandler) Run() error {
 	if s.enableNICMonitoring {
 		nicmetricssvc.RegisterMetricsServiceServer(grpcServer, s.nicHealthSvc)
 	}
+	if s.enableIFOEMonitoring {
+		nicmetricssvc.RegisterMetricsServiceServer(ifoeGrpcServer, s.ifoeHealthSvc)
+	}
 
 	if s.enableGPUMonitoring {
 		// start listening on the socket for GPU metrics
@@ -175,6 +193,16 @@ func (s *SvcHandler) Run() error {
 		go s.startAndServeGRPC(grpcServer, nicLis)
 	}
 
+	// start listening on the socket for IFOE health if enabled
+	if s.enableIFOEMonitoring {
+		ifoeLis, err := s.listenOnSocket(globals.IFOEMetricsSocketPath)
+		if err != nil {
+			return fmt.Errorf("failed to listen on socket %s: %v", globals.IFOEMetricsSocketPath, err)
+		}
+		s.serverWg.Add(1)
+		go s.startAndServeGRPC(ifoeGrpcServer, ifoeLis)
+	}
+
 	// Wait for any server to report an error, or for a shutdown signal
 	select {
 	case err := <-s.errChan:
@@ -0,0 +1,102 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the \"License\");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an \"AS IS\" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/globals"
+)
+
+var (
+	// kubepods cgroup segment, systemd driver escapes the uid dashes with
+	// underscores: kubepods-besteffort-pod<uid>.slice or pod<uid>
+	cgroupPodUIDRe = regexp.MustCompile(`pod([0-9a-f]{8}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{12})`)
+	// container scope of docker, containerd, cri-o and podman either as the
+	// plain id (cgroupfs driver) or a runtime prefixed systemd scope
+	cgroupContainerIDRe = regexp.MustCompile(`^(?:docker-|cri-containerd-|crio-|libpod-)?([0-9a-f]{64})(?:\.scope)?$`)
+)
+
+// ProcessOwner is the pod and/or container a process runs in
+type ProcessOwner struct {
+	PodUID      string
+	ContainerID string
+}
+
+// GetProcRoot returns the host procfs root, the host /proc is mounted on
+// /host/proc in the exporter container
+func GetProcRoot() string {
+	if _, err := os.Stat(globals.HostProcRoot); err == nil {
+		return globals.HostProcRoot
+	}
+	return "/proc"
+}
+
+// GetProcessName returns the command name of the process
+func GetProcessName(procRoot string, pid uint32) string {
+	data, err := os.ReadFile(path.Join(procRoot, fmt.Sprintf("%v", pid), "comm"))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// GetProcessOwner resolves the owning pod and container of the process from
+// its cgroup membership
+func GetProcessOwner(procRoot string, pid uint32) ProcessOwner {
+	data, err := os.ReadFile(path.Join(procRoot, fmt.Sprintf("%v", pid), "cgroup"))
+	if err != nil {
+		return ProcessOwner{}
+	}
+	return ParseCgroupOwner(string(data))
+}
+
+// ParseCgroupOwner parses /proc/<pid>/cgroup content, the first hierarchy
+// carrying a container scope wins
+func ParseCgroupOwner(data string) ProcessOwner {
+	owner := ProcessOwner{}
+	for _, line := range strings.Split(data, "\n") {
+		// hierarchy-ID:controller-list:cgroup-path
+		fields := strings.SplitN(line, ":", 3)
+		if len(fields) != 3 {
+			continue
+		}
+		cgPath := fields[2]
+		if owner.PodUID == "" {
+			if m := cgroupPodUIDRe.FindStringSubmatch(cgPath); m != nil {
+				owner.PodUID = strings.ReplaceAll(m[1], "_", "-")
+			}
+		}
+		if owner.ContainerID == "" {
+			segs := strings.Split(cgPath, "/")
+			for i := len(segs) - 1; i >= 0; i-- {
+				if m := cgroupContainerIDRe.FindStringSubmatch(segs[i]); m != nil {
+					owner.ContainerID = m[1]
+					break
+				}
+			}
+		}
+		if owner.PodUID != "" && owner.ContainerID != "" {
+			break
+		}
+	}
+	return owner
+}
@@ -260,26 +260,98 @@ func NormalizeExtraPodLabels(extraPodLabels map[string]string) map[string]string
 	return extraPodLabelsMap
 }
 
+// pod metadata references of the extra pod labels map values, plain values
+// are pod label keys. ':' is not valid in label keys so they can't collide
+const (
+	PodAnnotationRefPrefix  = "annotation:"
+	NamespaceLabelRefPrefix = "namespace:"
+	OwnerKindRefPrefix      = "owner:"
+	// OwnerKindTopLevel selects the top level owner of the pod
+	OwnerKindTopLevel = "*"
+)
+
+// NormalizePodMetadataLabels merges the pod label, pod annotation, namespace
+// label and owner kind maps into a single prometheus label to pod metadata
+// reference map resolved by GetPodMetadata
+func NormalizePodMetadataLabels(podLabels, podAnnotations, namespaceLabels, ownerKinds map[string]string) map[string]string {
+	extraPodLabelsMap := NormalizeExtraPodLabels(podLabels)
+	sources := []struct {
+		prefix string
+		labels map[string]string
+	}{
+		{PodAnnotationRefPrefix, podAnnotations},
+		{NamespaceLabelRefPrefix, namespaceLabels},
+		{OwnerKindRefPrefix, ownerKinds},
+	}
+	for _, src := range sources {
+		for prometheusLabel, key := range src.labels {
+			if len(extraPodLabelsMap) >= globals.MaxSupportedPodLabels {
+				logger.Log.Printf("Max pod labels supported: %v, ignoring extra pod labels.", globals.MaxSupportedPodLabels)
+				return extraPodLabelsMap
+			}
+			if src.prefix == OwnerKindRefPrefix {
+				key = strings.ToLower(key)
+			}
+			extraPodLabelsMap[strings.ToLower(prometheusLabel)] = src.prefix + key
+		}
+	}
+	return extraPodLabelsMap
+}
+
+func NormalizeExtraWorkloadLabels(extraWorkloadLabels map[string]string) map[string]string {
+	extraWorkloadLabelsMap := make(map[string]string)
+	labelCount := 0
+	for prometheusLabel, workloadLabelKey := range extraWorkloadLabels {
+		if labelCount >= globals.MaxSupportedWorkloadLabels {
+			logger.Log.Printf("Max workload labels supported: %v, ignoring extra workload labels.", globals.MaxSupportedWorkloadLabels)
+			break
+		}
+		label := strings.ToLower(prometheusLabel)
+		extraWorkloadLabelsMap[label] = workloadLabelKey
+		labelCount++
+	}
+	return extraWorkloadLabelsMap
+}
+
 func NormalizeStringWithoutPrefix(str, prefix string) string {
 	normalizedStr := strings.TrimPrefix(str, prefix)
 	normalizedStr = strings.ToLower(normalizedStr)
 	return normalizedStr
 }
 
-func GetPodLabels(podInfo *scheduler.PodResourceInfo, k8sPodInfoMap map[string]types.K8sPodInfo) map[string]string {
-	if podInfo != nil {
-		podName, podNs := podInfo.Pod, podInfo.Namespace
-		if podName != "" && podNs != "" {
-			pKey := types.PodUniqueKey{
-				PodName:   podName,
-				Namespace: podNs,
-			}
-			if pod, exists := k8sPodInfoMap[pKey.String()]; exists {
-				return pod.Labels
-			}
-		}
+// GetPodMetadata returns the pod labels along with the annotations, namespace
+// labels and owners of the pod keyed by their metadata reference
+func GetPodMetadata(podInfo *scheduler.PodResourceInfo, k8sPodInfoMap map[string]types.K8sPodInfo) map[string]string {
+	meta := map[string]string{}
+	if podInfo == nil || podInfo.Pod == "" || podInfo.Namespace == "" {
+		return meta
+	}
+	pKey := types.PodUniqueKey{
+		PodName:   podInfo.Pod,
+		Namespace: podInfo.Namespace,
+	}
+	pod, exists := k8sPodInfoMap[pKey.String()]
+	if !exists {
+		return meta
+	}
+	for k, v := range pod.Labels {
+		meta[k] = v
+	}
+	for k, v := range pod.Annotations {
+		meta[PodAnnotationRefPrefix+k] = v
+	}
+	for k, v := range pod.NamespaceLabels {
+		meta[NamespaceLabelRefPrefix+k] = v
+	}
+	// the nearest owner wins when a kind repeats in the chain
+	for i := len(pod.Owners) - 1; i >= 0; i-- {
+		meta[OwnerKindRefPrefix+strings.ToLower(pod.Owners[i].Kind)] = pod.Owners[i].Name
+	}
+	if len(pod.Owners) > 0 {
+		top := pod.Owners[len(pod.Owners)-1]
+		meta[OwnerKindRefPrefix+OwnerKindTopLevel] = top.Kind + "/" + top.Name
 	}
-	return map[string]string{}
+	return meta
 }
 
 func GetPodUID(podInfo *scheduler.PodResourceInfo, k8sPodInfoMap map[string]types.K8sPodInfo) string {
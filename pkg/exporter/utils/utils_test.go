@@ -18,10 +18,14 @@ package utils
 
 import (
 	"math"
+	"reflect"
 	"testing"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/scheduler"
+	"github.com/ROCm/device-metrics-exporter/pkg/types"
 )
 
 func TestGetPCIeBaseAddress(t *testing.T) {
@@ -331,3 +335,117 @@ func TestValidateAndExport(t *testing.T) {
 		})
 	}
 }
+
+func TestParseCgroupOwner(t *testing.T) {
+	cid := "3f1c2a9be0d1aa2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f7081920a"
+	tests := []struct {
+		name     string
+		cgroup   string
+		expected ProcessOwner
+	}{
+		{
+			name:     "k8s systemd driver",
+			cgroup:   "0::/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod0a1b2c3d_1111_2222_3333_444455556666.slice/cri-containerd-" + cid + ".scope\n",
+			expected: ProcessOwner{PodUID: "0a1b2c3d-1111-2222-3333-444455556666", ContainerID: cid},
+		},
+		{
+			name:     "k8s cgroupfs driver v1",
+			cgroup:   "12:pids:/kubepods/besteffort/pod0a1b2c3d-1111-2222-3333-444455556666/" + cid + "\n11:cpuset:/\n",
+			expected: ProcessOwner{PodUID: "0a1b2c3d-1111-2222-3333-444455556666", ContainerID: cid},
+		},
+		{
+			name:     "docker systemd driver",
+			cgroup:   "0::/system.slice/docker-" + cid + ".scope\n",
+			expected: ProcessOwner{ContainerID: cid},
+		},
+		{
+			name:     "docker cgroupfs driver",
+			cgroup:   "0::/docker/" + cid + "\n",
+			expected: ProcessOwner{ContainerID: cid},
+		},
+		{
+			name:     "host process",
+			cgroup:   "0::/user.slice/user-1000.slice/session-3.scope\n",
+			expected: ProcessOwner{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := ParseCgroupOwner(tt.cgroup)
+			if got != tt.expected {
+				t.Errorf("ParseCgroupOwner() = %+v; want %+v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetPodMetadata(t *testing.T) {
+	labelsMap := NormalizePodMetadataLabels(
+		map[string]string{"WORKLOAD_ID": "amd-workload-id"},
+		map[string]string{"COST_CENTER": "billing/cost-center"},
+		map[string]string{"TEAM": "team"},
+		map[string]string{"DEPLOYMENT": "Deployment", "OWNER": "*"},
+	)
+	expectedMap := map[string]string{
+		"workload_id": "amd-workload-id",
+		"cost_center": "annotation:billing/cost-center",
+		"team":        "namespace:team",
+		"deployment":  "owner:deployment",
+		"owner":       "owner:*",
+	}
+	if !reflect.DeepEqual(labelsMap, expectedMap) {
+		t.Fatalf("NormalizePodMetadataLabels() = %v; want %v", labelsMap, expectedMap)
+	}
+
+	podInfoMap := map[string]types.K8sPodInfo{
+		"ml-trainer-5d8f7c9b4-x2k9p": {
+			Name:            "trainer-5d8f7c9b4-x2k9p",
+			Namespace:       "ml",
+			Labels:          map[string]string{"amd-workload-id": "llama"},
+			Annotations:     map[string]string{"billing/cost-center": "cc-42"},
+			NamespaceLabels: map[string]string{"team": "research"},
+			Owners: []types.PodOwner{
+				{Kind: "ReplicaSet", Name: "trainer-5d8f7c9b4"},
+				{Kind: "Deployment", Name: "trainer"},
+			},
+		},
+	}
+	tests := []struct {
+		name     string
+		podInfo  *scheduler.PodResourceInfo
+		expected map[string]string
+	}{
+		{
+			name:    "pod with owners",
+			podInfo: &scheduler.PodResourceInfo{Pod: "trainer-5d8f7c9b4-x2k9p", Namespace: "ml"},
+			expected: map[string]string{
+				"amd-workload-id":                "llama",
+				"annotation:billing/cost-center": "cc-42",
+				"namespace:team":                 "research",
+				"owner:replicaset":               "trainer-5d8f7c9b4",
+				"owner:deployment":               "trainer",
+				"owner:*":                        "Deployment/trainer",
+			},
+		},
+		{
+			name:     "unknown pod",
+			podInfo:  &scheduler.PodResourceInfo{Pod: "gone", Namespace: "ml"},
+			expected: map[string]string{},
+		},
+		{
+			name:     "no pod",
+			podInfo:  nil,
+			expected: map[string]string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := GetPodMetadata(tt.podInfo, podInfoMap)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("GetPodMetadata() = %v; want %v", got, tt.expected)
+			}
+		})
+	}
+}
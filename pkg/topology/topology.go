@@ -0,0 +1,291 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+// Package topology maps the PCIe and NUMA placement of the GPUs and NICs of
+// the node from sysfs, used like logger.Log.
+package topology
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+// DeviceType is the kind of a device in the topology
+type DeviceType string
+
+const (
+	GPU DeviceType = "gpu"
+	NIC DeviceType = "nic"
+
+	// DefaultSysfsRoot is the sysfs mount point
+	DefaultSysfsRoot = "/sys"
+)
+
+// Relation is how close two devices are, from closest to farthest
+type Relation string
+
+const (
+	// RelationPCIeSwitch - devices share a PCIe switch or root port
+	RelationPCIeSwitch Relation = "pcie_switch"
+	// RelationHostBridge - devices share a PCIe host bridge (root complex)
+	RelationHostBridge Relation = "pcie_host_bridge"
+	// RelationNUMANode - devices are on different root complexes of a NUMA node
+	RelationNUMANode Relation = "numa_node"
+	// RelationSystem - devices are on different NUMA nodes
+	RelationSystem Relation = "system"
+)
+
+var (
+	relationRank = map[Relation]int{
+		RelationPCIeSwitch: 0,
+		RelationHostBridge: 1,
+		RelationNUMANode:   2,
+		RelationSystem:     3,
+	}
+
+	// PCIe root bus, pci0000:00
+	rootBusRe = regexp.MustCompile(`^pci[0-9a-f]{4}:[0-9a-f]{2}$`)
+	// PCIe device address, 0000:00:01.1
+	bdfRe = regexp.MustCompile(`^[0-9a-f]{4}:[0-9a-f]{2}:[0-9a-f]{2}\.[0-7]$`)
+
+	mu        sync.RWMutex
+	singleton = newTopology(DefaultSysfsRoot)
+)
+
+// Device is a GPU or NIC with its PCIe placement
+type Device struct {
+	Type     DeviceType `json:"type"`
+	Name     string     `json:"name"`
+	PCIeAddr string     `json:"pcie_bus_id"`
+	NUMANode int        `json:"numa_node"`
+	// PCIe root bus, bridges and switch ports down to the device itself
+	PCIePath []string `json:"pcie_path"`
+}
+
+// Link is the relation of a GPU and a NIC
+type Link struct {
+	GPU      string   `json:"gpu"`
+	NIC      string   `json:"nic"`
+	Relation Relation `json:"relation"`
+	Hops     int      `json:"hops"` // PCIe hops through the closest common bridge
+	Closest  bool     `json:"closest"`
+}
+
+// Graph is the topology of the node served as JSON
+type Graph struct {
+	Devices []Device `json:"devices"`
+	Links   []Link   `json:"links"`
+}
+
+// topology holds the devices registered by the agents
+type topology struct {
+	sync.Mutex
+	sysfsRoot string
+	devices   map[DeviceType]map[string]*Device // devices by type and pcie address
+	resolved  map[string]*Device                // sysfs placement cache by pcie address
+}
+
+func newTopology(sysfsRoot string) *topology {
+	return &topology{
+		sysfsRoot: sysfsRoot,
+		devices:   map[DeviceType]map[string]*Device{},
+		resolved:  map[string]*Device{},
+	}
+}
+
+// resolve reads the PCIe ancestry and NUMA node of the device from sysfs
+func (t *topology) resolve(addr string) (*Device, error) {
+	addr = strings.ToLower(addr)
+	if dev, ok := t.resolved[addr]; ok {
+		return dev, nil
+	}
+	devPath := filepath.Join(t.sysfsRoot, "bus", "pci", "devices", addr)
+	realPath, err := filepath.EvalSymlinks(devPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve %s, %v", devPath, err)
+	}
+	dev := &Device{PCIeAddr: addr, NUMANode: -1}
+	for _, elem := range strings.Split(filepath.ToSlash(realPath), "/") {
+		if rootBusRe.MatchString(elem) || bdfRe.MatchString(elem) {
+			dev.PCIePath = append(dev.PCIePath, elem)
+		}
+	}
+	if len(dev.PCIePath) == 0 || dev.PCIePath[len(dev.PCIePath)-1] != addr {
+		return nil, fmt.Errorf("unexpected sysfs path %s of %s", realPath, addr)
+	}
+	if data, err := os.ReadFile(filepath.Join(devPath, "numa_node")); err == nil {
+		if node, err := strconv.Atoi(strings.TrimSpace(string(data))); err == nil {
+			dev.NUMANode = node
+		}
+	}
+	t.resolved[addr] = dev
+	return dev, nil
+}
+
+// setDevices replaces the devices of the type, by pcie address to name
+func (t *topology) setDevices(kind DeviceType, devs map[string]string) {
+	t.Lock()
+	defer t.Unlock()
+	devices := map[string]*Device{}
+	for addr, name := range devs {
+		if addr == "" {
+			continue
+		}
+		placement, err := t.resolve(addr)
+		if err != nil {
+			logger.Log.Printf("topology: %s %s not mapped, %v", kind, addr, err)
+			continue
+		}
+		dev := *placement
+		dev.Type = kind
+		dev.Name = name
+		devices[dev.PCIeAddr] = &dev
+	}
+	t.devices[kind] = devices
+}
+
+// relate returns the relation of two devices and the PCIe hops between them
+func relate(a, b *Device) (Relation, int) {
+	common := 0
+	for common < len(a.PCIePath) && common < len(b.PCIePath) && a.PCIePath[common] == b.PCIePath[common] {
+		common++
+	}
+	hops := len(a.PCIePath) - common + len(b.PCIePath) - common
+	switch {
+	case common > 1:
+		// common ancestor below the root bus
+		return RelationPCIeSwitch, hops
+	case common == 1:
+		return RelationHostBridge, hops
+	case a.NUMANode >= 0 && a.NUMANode == b.NUMANode:
+		return RelationNUMANode, hops
+	}
+	return RelationSystem, hops
+}
+
+// closer returns true if relation r1 over h1 hops is closer than r2 over h2
+func closer(r1 Relation, h1 int, r2 Relation, h2 int) bool {
+	if relationRank[r1] != relationRank[r2] {
+		return relationRank[r1] < relationRank[r2]
+	}
+	return h1 < h2
+}
+
+// closest returns the closest device of the other type to the device at
+// the pcie address, ties go to the lowest address
+func (t *topology) closest(kind DeviceType, addr string) *Device {
+	t.Lock()
+	defer t.Unlock()
+	if addr == "" {
+		return nil
+	}
+	dev, err := t.resolve(addr)
+	if err != nil {
+		return nil
+	}
+	peerKind := NIC
+	if kind == NIC {
+		peerKind = GPU
+	}
+	var best *Device
+	var bestRel Relation
+	var bestHops int
+	for _, peerAddr := range sortedAddrs(t.devices[peerKind]) {
+		peer := t.devices[peerKind][peerAddr]
+		rel, hops := relate(dev, peer)
+		if best == nil || closer(rel, hops, bestRel, bestHops) {
+			best, bestRel, bestHops = peer, rel, hops
+		}
+	}
+	return best
+}
+
+func (t *topology) graph() Graph {
+	t.Lock()
+	defer t.Unlock()
+	g := Graph{Devices: []Device{}, Links: []Link{}}
+	for _, kind := range []DeviceType{GPU, NIC} {
+		for _, addr := range sortedAddrs(t.devices[kind]) {
+			g.Devices = append(g.Devices, *t.devices[kind][addr])
+		}
+	}
+	for _, gpuAddr := range sortedAddrs(t.devices[GPU]) {
+		gpu := t.devices[GPU][gpuAddr]
+		first := len(g.Links)
+		closest := -1
+		for _, nicAddr := range sortedAddrs(t.devices[NIC]) {
+			rel, hops := relate(gpu, t.devices[NIC][nicAddr])
+			g.Links = append(g.Links, Link{GPU: gpuAddr, NIC: nicAddr, Relation: rel, Hops: hops})
+			if closest < 0 || closer(rel, hops, g.Links[closest].Relation, g.Links[closest].Hops) {
+				closest = len(g.Links) - 1
+			}
+		}
+		if closest >= first {
+			g.Links[closest].Closest = true
+		}
+	}
+	return g
+}
+
+func sortedAddrs(devices map[string]*Device) []string {
+	addrs := make([]string, 0, len(devices))
+	for addr := range devices {
+		addrs = append(addrs, addr)
+	}
+	sort.Strings(addrs)
+	return addrs
+}
+
+// SetDevices replaces the devices of the type in the topology, devs maps the
+// pcie address of a device to its name
+func SetDevices(kind DeviceType, devs map[string]string) {
+	mu.RLock()
+	defer mu.RUnlock()
+	singleton.setDevices(kind, devs)
+}
+
+// Closest returns the closest device of the other type to the device of the
+// given type at the pcie address, nil if none is known
+func Closest(kind DeviceType, addr string) *Device {
+	mu.RLock()
+	defer mu.RUnlock()
+	return singleton.closest(kind, addr)
+}
+
+// GetGraph returns the devices and their relations
+func GetGraph() Graph {
+	mu.RLock()
+	defer mu.RUnlock()
+	return singleton.graph()
+}
+
+// HandleQuery serves the topology graph as JSON
+func HandleQuery(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(GetGraph()); err != nil {
+		logger.Log.Printf("topology: failed to encode graph, %v", err)
+	}
+}
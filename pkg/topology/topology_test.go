@@ -0,0 +1,182 @@
+/**
+# Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#     http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+**/
+
+package topology
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/logger"
+)
+
+func TestMain(m *testing.M) {
+	logger.Init(true)
+	os.Exit(m.Run())
+}
+
+// writeFakeSysfs creates the pci devices under the root with the bus
+// symlinks, devices maps the device path below devices/ to its numa node
+func writeFakeSysfs(t *testing.T, root string, devices map[string]string) {
+	t.Helper()
+	busDir := filepath.Join(root, "bus", "pci", "devices")
+	if err := os.MkdirAll(busDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	for path, numaNode := range devices {
+		devDir := filepath.Join(root, "devices", path)
+		if err := os.MkdirAll(devDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(devDir, "numa_node"), []byte(numaNode+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Symlink(filepath.Join("..", "..", "..", "devices", path), filepath.Join(busDir, filepath.Base(path))); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// newFakeTopology returns a topology of two host bridges on numa node 0
+// and one on numa node 1
+//
+//	pci0000:00 ─ 00:01.1 ─ switch 01:00.0 ┬ 02:00.0 ─ GPU 0 03:00.0
+//	           │                          └ 02:01.0 ─ NIC   04:00.0
+//	           └ 00:03.1 ─ GPU 1 05:00.0
+//	pci0000:40 ─ 40:01.1 ─ NIC 41:00.0
+//	pci0000:80 ─ 80:01.1 ─ GPU 2 81:00.0 (numa node 1)
+func newFakeTopology(t *testing.T) *topology {
+	root := t.TempDir()
+	writeFakeSysfs(t, root, map[string]string{
+		"pci0000:00/0000:00:01.1/0000:01:00.0/0000:02:00.0/0000:03:00.0": "0",
+		"pci0000:00/0000:00:01.1/0000:01:00.0/0000:02:01.0/0000:04:00.0": "0",
+		"pci0000:00/0000:00:03.1/0000:05:00.0":                           "0",
+		"pci0000:40/0000:40:01.1/0000:41:00.0":                           "0",
+		"pci0000:80/0000:80:01.1/0000:81:00.0":                           "1",
+	})
+	topo := newTopology(root)
+	topo.setDevices(GPU, map[string]string{
+		"0000:03:00.0": "0",
+		"0000:05:00.0": "1",
+		"0000:81:00.0": "2",
+		"0000:99:00.0": "3", // not in sysfs
+	})
+	topo.setDevices(NIC, map[string]string{
+		"0000:04:00.0": "enp4s0",
+		"0000:41:00.0": "enp65s0",
+	})
+	return topo
+}
+
+func TestClosest(t *testing.T) {
+	topo := newFakeTopology(t)
+
+	tests := []struct {
+		kind     DeviceType
+		addr     string
+		expected string // name of the closest device, empty for none
+	}{
+		{kind: GPU, addr: "0000:03:00.0", expected: "enp4s0"},  // same switch
+		{kind: GPU, addr: "0000:05:00.0", expected: "enp4s0"},  // same host bridge
+		{kind: GPU, addr: "0000:81:00.0", expected: "enp65s0"}, // other numa node, fewer hops
+		{kind: NIC, addr: "0000:04:00.0", expected: "0"},
+		{kind: NIC, addr: "0000:41:00.0", expected: "1"}, // same numa node
+		{kind: GPU, addr: "0000:99:00.0", expected: ""},
+		{kind: NIC, addr: "", expected: ""},
+	}
+	for _, tt := range tests {
+		name := ""
+		if dev := topo.closest(tt.kind, tt.addr); dev != nil {
+			name = dev.Name
+		}
+		if name != tt.expected {
+			t.Errorf("closest to %s %s: expected %q, got %q", tt.kind, tt.addr, tt.expected, name)
+		}
+	}
+
+	gpu0 := topo.devices[GPU]["0000:03:00.0"]
+	if gpu0.NUMANode != 0 || len(gpu0.PCIePath) != 5 || gpu0.PCIePath[0] != "pci0000:00" {
+		t.Errorf("unexpected placement %+v", gpu0)
+	}
+	if _, ok := topo.devices[GPU]["0000:99:00.0"]; ok {
+		t.Errorf("device missing in sysfs must not be mapped")
+	}
+}
+
+func TestRelate(t *testing.T) {
+	topo := newFakeTopology(t)
+	tests := []struct {
+		gpu, nic string
+		relation Relation
+		hops     int
+	}{
+		{gpu: "0000:03:00.0", nic: "0000:04:00.0", relation: RelationPCIeSwitch, hops: 4},
+		{gpu: "0000:05:00.0", nic: "0000:04:00.0", relation: RelationHostBridge, hops: 6},
+		{gpu: "0000:05:00.0", nic: "0000:41:00.0", relation: RelationNUMANode, hops: 6},
+		{gpu: "0000:81:00.0", nic: "0000:04:00.0", relation: RelationSystem, hops: 8},
+	}
+	for _, tt := range tests {
+		relation, hops := relate(topo.devices[GPU][tt.gpu], topo.devices[NIC][tt.nic])
+		if relation != tt.relation || hops != tt.hops {
+			t.Errorf("%s - %s: expected %s/%d, got %s/%d", tt.gpu, tt.nic, tt.relation, tt.hops, relation, hops)
+		}
+	}
+}
+
+func TestHandleQuery(t *testing.T) {
+	mu.Lock()
+	saved := singleton
+	singleton = newFakeTopology(t)
+	mu.Unlock()
+	defer func() {
+		mu.Lock()
+		singleton = saved
+		mu.Unlock()
+	}()
+
+	rr := httptest.NewRecorder()
+	HandleQuery(rr, httptest.NewRequest("GET", "/topology", nil))
+
+	var g Graph
+	if err := json.Unmarshal(rr.Body.Bytes(), &g); err != nil {
+		t.Fatalf("invalid json %s: %v", rr.Body.String(), err)
+	}
+	if len(g.Devices) != 5 || len(g.Links) != 6 {
+		t.Fatalf("expected 5 devices and 6 links, got %+v", g)
+	}
+	closest := map[string]string{}
+	for _, l := range g.Links {
+		if l.Closest {
+			closest[l.GPU] = l.NIC
+		}
+	}
+	expected := map[string]string{
+		"0000:03:00.0": "0000:04:00.0",
+		"0000:05:00.0": "0000:04:00.0",
+		"0000:81:00.0": "0000:41:00.0",
+	}
+	if len(closest) != len(expected) {
+		t.Errorf("expected closest links %v, got %v", expected, closest)
+	}
+	for gpu, nic := range expected {
+		if closest[gpu] != nic {
+			t.Errorf("expected closest NIC of %s to be %s, got %s", gpu, nic, closest[gpu])
+		}
+	}
+}
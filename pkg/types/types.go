@@ -30,8 +30,17 @@ func (p *PodUniqueKey) String() string {
 
 // K8sPodInfo - struct to hold k8s pod info
 type K8sPodInfo struct {
-	Name      string
-	Namespace string
-	Labels    map[string]string
-	UID       string
+	Name            string
+	Namespace       string
+	Labels          map[string]string
+	UID             string
+	Annotations     map[string]string
+	NamespaceLabels map[string]string
+	Owners          []PodOwner // owner chain starting from the pod controller
+}
+
+// PodOwner - controller owning the pod or one of its owners
+type PodOwner struct {
+	Kind string
+	Name string
 }
@@ -30,6 +30,7 @@
 #include <cstdint>
 #include <map>
 #include <memory>
+#include <set>
 #include <unordered_map>
 #include <vector>
 
@@ -100,7 +101,9 @@ class CounterSampler {
   static std::vector<rocprofiler_agent_v0_t> get_available_agents();
 
   static std::vector<std::shared_ptr<CounterSampler>>& get_samplers();
-  static int runSample(std::vector<std::string> &metric_fields, uint64_t duration, uint32_t delay_ms = 0);
+  // excluded_devices holds the DRM render minors of the GPUs not to sample
+  static int runSample(std::vector<std::string> &metric_fields, uint64_t duration, uint32_t delay_ms = 0,
+                       const std::set<uint32_t> &excluded_devices = {});
 
  private:
   rocprofiler_agent_id_t agent_ = {};
@@ -30,6 +30,7 @@
 #include <iostream>
 #include <map>
 #include <memory>
+#include <set>
 #include <sstream>
 #include <stdexcept>
 #include <unordered_map>
@@ -266,7 +267,8 @@ std::vector<rocprofiler_agent_v0_t> CounterSampler::get_available_agents() {
   return agents;
 }
 
-int CounterSampler::runSample(std::vector<std::string> &metric_fields, uint64_t duration, uint32_t delay_ms) 
+int CounterSampler::runSample(std::vector<std::string> &metric_fields, uint64_t duration, uint32_t delay_ms,
+                              const std::set<uint32_t> &excluded_devices)
 {
 	std::vector<std::shared_ptr<CounterSampler>> samplers = {};
 	std::vector<rocprofiler_agent_v0_t> agents;
@@ -285,6 +287,9 @@ int CounterSampler::runSample(std::vector<std::string> &metric_fields, uint64_t
 	// find intersection of supported and requested fields	
   for (uint32_t gpu_index = 0; gpu_index < agents.size(); gpu_index++) {
     auto& agent = agents[gpu_index];
+    if (excluded_devices.count(agent.drm_render_minor)) {
+      continue;
+    }
     auto& cs = *samplers[gpu_index];
     
     try {
@@ -299,8 +304,16 @@ int CounterSampler::runSample(std::vector<std::string> &metric_fields, uint64_t
 
   // Print all results after successful sampling
   std::cout << "{\n\"GpuMetrics\": \[\n\t";
+  bool first_gpu = true;
   for (uint32_t gpu_index = 0; gpu_index < agents.size(); gpu_index++) {
     auto& agent = agents[gpu_index];
+    if (excluded_devices.count(agent.drm_render_minor)) {
+      continue;
+    }
+    if (!first_gpu) {
+      std::cout << ",";
+    }
+    first_gpu = false;
     auto gpu_id = agent.gpu_id;
     auto drm_render_minor = agent.drm_render_minor;
     auto logical_node_id = agent.logical_node_id;
@@ -321,9 +334,6 @@ int CounterSampler::runSample(std::vector<std::string> &metric_fields, uint64_t
       std::cout << "\t\t}";
     }
     std::cout << "\n\t]}\n";
-    if (gpu_index + 1 != agents.size()) {
-      std::cout << ",";
-    }
   }
   std::cout << "]\n}\n";
 	return 0;
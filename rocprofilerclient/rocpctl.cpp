@@ -32,6 +32,8 @@
 #include <fstream>
 #include <map>
 #include <string>
+#include <set>
+#include <sstream>
 #include <cstdlib>
 
 #include "RocpCounterSampler.h"
@@ -65,6 +67,7 @@ main(int argc, char** argv)
     std::vector<std::string> metric_fields;
     uint64_t duration = 1000;    // Default sampling duration (in microseconds)
     uint32_t ptl_delay = 10;     // Default PTL delay (in milliseconds)
+    std::set<uint32_t> excluded_devices; // DRM render minors not to sample
     long ndevice = ntotdevice;   // Use actual device count
 
     if(ndevice > ntotdevice) ndevice = ntotdevice;
@@ -79,7 +82,8 @@ main(int argc, char** argv)
                       << "Options:\n"
                       << "  -h, --help          Show this help message and exit\n"
                       << "  -d <duration>       Sampling duration in microseconds (default: 1000)\n"
-                      << "  -p <delay>          PTL delay in milliseconds (default: 10)\n\n"
+                      << "  -p <delay>          PTL delay in milliseconds (default: 10)\n"
+                      << "  -x <render ids>     Comma separated DRM render minors of GPUs to exclude\n\n"
                       << "Arguments:\n"
                       << "  METRICS             List of metric fields to collect\n\n"
                       << "Example:\n"
@@ -107,13 +111,28 @@ main(int argc, char** argv)
                 std::cerr << "Invalid value for -p: " << argv[i] << std::endl;
                 return -1;
             }
+        } else if (arg == "-x") {
+            if (i + 1 >= argc || argv[i + 1] == nullptr) {
+                std::cerr << "Option -x requires a list of render ids" << std::endl;
+                return -1;
+            }
+            std::stringstream ids(argv[++i]);
+            std::string id;
+            while (std::getline(ids, id, ',')) {
+                try {
+                    excluded_devices.insert(std::stoul(id));
+                } catch (const std::exception&) {
+                    std::cerr << "Invalid value for -x: " << argv[i] << std::endl;
+                    return -1;
+                }
+            }
         } else {                
             metric_fields.push_back(arg);            
         }
     }
 
     try {        
-        int rc = amd::rocp::CounterSampler::runSample(metric_fields, duration, ptl_delay);
+        int rc = amd::rocp::CounterSampler::runSample(metric_fields, duration, ptl_delay, excluded_devices);
         if (rc != 0) {
             std::cerr << "run sample err: " << rc << "\n"; 
             return -1;
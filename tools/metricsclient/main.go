@@ -455,6 +455,9 @@ func main() {
 		gpuctl          = flag.Bool("gpuctl", false, "enable gpu control operations")
 		gpuctlPort      = flag.String("gpuctl-port", "50061", "port for gpuctl operations")
 		setupMockInband = flag.Bool("setup-mock-inbandras", false, "setup mock inband RAS error_list file")
+		ual             = flag.Bool("ual", false, "show UAL/IFOE devices, stations and ports from the gpu agent")
+		ualObject       = flag.String("ual-object", "all", "UAL objects to show: device, station, port or all")
+		watch           = flag.Duration("watch", 0, "refresh interval of the UAL view, highlights counter deltas")
 	)
 	flag.Parse()
 
@@ -486,6 +489,14 @@ func main() {
 		return
 	}
 
+	if *ual {
+		if err := showUAL(*gpuctlPort, *ualObject, *jout, *watch); err != nil {
+			fmt.Printf("Error: %v\n", err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if *gpuctl {
 		getGpuAgent(*gpuctlPort, *jout)
 		return
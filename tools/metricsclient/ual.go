@@ -0,0 +1,390 @@
+/*
+Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the \"License\");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an \"AS IS\" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+	"github.com/ROCm/device-metrics-exporter/pkg/exporter/utils"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const (
+	ualHighlight = "\033[1;33m"
+	ualReset     = "\033[0m"
+	ualClear     = "\033[H\033[2J"
+)
+
+// ualDeviceView is the read-only view of a UAL device, the crypto keys are
+// never shown
+type ualDeviceView struct {
+	UUID               string   `json:"uuid"`
+	AcceleratorID      uint32   `json:"accelerator_id"`
+	ConfigPhase        string   `json:"config_phase"`
+	VirtualizationMode string   `json:"virtualization_mode"`
+	EncapType          string   `json:"encap_type"`
+	CryptoMode         string   `json:"crypto_mode"`
+	FailoverMode       string   `json:"failover_mode"`
+	LocalAccelerators  []uint32 `json:"local_accelerators"`
+	VPodAccelerators   []uint32 `json:"vpod_accelerators"`
+	Stations           uint32   `json:"stations"`
+	Ports              uint32   `json:"ports"`
+	FirmwareVersion    string   `json:"firmware_version"`
+}
+
+// ualStationView is the read-only view of a UAL station
+type ualStationView struct {
+	UUID          string `json:"uuid"`
+	Name          string `json:"name"`
+	DeviceUUID    string `json:"device_uuid"`
+	LogicalIndex  uint32 `json:"logical_index"`
+	AdminState    string `json:"admin_state"`
+	LinkState     string `json:"link_state"`
+	BandwidthGbps uint32 `json:"available_bandwidth_gbps"`
+	Ports         int    `json:"ports"`
+}
+
+// ualPortCounters are the counters of a UAL network port watched for deltas
+type ualPortCounters struct {
+	FailedoverStreams uint64 `json:"failedover_streams"`
+	PausedStreams     uint64 `json:"paused_streams"`
+	BitErrorRate      uint64 `json:"bit_error_rate"`
+	FECCodeWords      uint64 `json:"fec_codewords"`
+	FECErroredWords   uint64 `json:"fec_errored_codewords"`
+}
+
+// ualPortDeltas are the changes of the port counters since the previous
+// view of the watch mode
+type ualPortDeltas struct {
+	FailedoverStreams int64 `json:"failedover_streams"`
+	PausedStreams     int64 `json:"paused_streams"`
+	BitErrorRate      int64 `json:"bit_error_rate"`
+	FECCodeWords      int64 `json:"fec_codewords"`
+	FECErroredWords   int64 `json:"fec_errored_codewords"`
+}
+
+// ualPortView is the read-only view of a UAL network port
+type ualPortView struct {
+	UUID        string          `json:"uuid"`
+	Name        string          `json:"name"`
+	StationUUID string          `json:"station_uuid"`
+	LocalIndex  uint32          `json:"local_index"`
+	AdminState  string          `json:"admin_state"`
+	OperState   string          `json:"oper_state"`
+	Counters    ualPortCounters `json:"counters"`
+	Deltas      *ualPortDeltas  `json:"deltas,omitempty"`
+}
+
+// ualView is the UAL state reported by the gpu agent
+type ualView struct {
+	Devices  []ualDeviceView  `json:"devices,omitempty"`
+	Stations []ualStationView `json:"stations,omitempty"`
+	Ports    []ualPortView    `json:"ports,omitempty"`
+}
+
+func ualEnumName(name, prefix string) string {
+	return strings.ToLower(strings.TrimPrefix(name, prefix))
+}
+
+func ualVersion(v *amdgpu.SemanticVersion) string {
+	if v == nil {
+		return "-"
+	}
+	return fmt.Sprintf("%d.%d.%d", v.GetMajor(), v.GetMinor(), v.GetPatch())
+}
+
+// getUALView reads the UAL devices, stations and ports from the gpu agent,
+// only the get calls of the UAL service are used
+func getUALView(ctx context.Context, client amdgpu.UALSvcClient, object string) (*ualView, error) {
+	view := &ualView{}
+	if object == "all" || object == "device" {
+		resp, err := client.UALDeviceGet(ctx, &amdgpu.UALDeviceGetRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("UALDeviceGet call failed: %v", err)
+		}
+		if resp.ApiStatus != amdgpu.ApiStatus_API_STATUS_OK {
+			return nil, fmt.Errorf("UALDeviceGet api status: %v", resp.ApiStatus)
+		}
+		for _, dev := range resp.Response {
+			spec := dev.GetSpec()
+			view.Devices = append(view.Devices, ualDeviceView{
+				UUID:               utils.UUIDToString(spec.GetId()),
+				AcceleratorID:      spec.GetAcceleratorId(),
+				ConfigPhase:        ualEnumName(spec.GetConfigPhase().String(), "UAL_CONFIG_PHASE_"),
+				VirtualizationMode: ualEnumName(spec.GetVirtualizationMode().String(), "UAL_VIRTUALIZATION_MODE_"),
+				EncapType:          ualEnumName(spec.GetEncapType().String(), "UAL_ENCAP_TYPE_"),
+				CryptoMode:         ualEnumName(spec.GetCryptoSpec().GetCryptoMode().String(), "UAL_CRYPTO_MODE_"),
+				FailoverMode:       ualEnumName(spec.GetFailoverMode().String(), "UAL_FAILOVER_MODE_"),
+				LocalAccelerators:  spec.GetLocalAccelerators(),
+				VPodAccelerators:   spec.GetVPodAccelerators(),
+				Stations:           spec.GetCapability().GetNumUALStation(),
+				Ports:              spec.GetCapability().GetNumNetworkPort(),
+				FirmwareVersion:    ualVersion(dev.GetStatus().GetVersion().GetFirmwareVersion()),
+			})
+		}
+		sort.Slice(view.Devices, func(i, j int) bool {
+			return view.Devices[i].AcceleratorID < view.Devices[j].AcceleratorID
+		})
+	}
+	if object == "all" || object == "station" {
+		resp, err := client.UALStationGet(ctx, &amdgpu.UALStationGetRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("UALStationGet call failed: %v", err)
+		}
+		if resp.ApiStatus != amdgpu.ApiStatus_API_STATUS_OK {
+			return nil, fmt.Errorf("UALStationGet api status: %v", resp.ApiStatus)
+		}
+		for _, station := range resp.Response {
+			spec, status := station.GetSpec(), station.GetStatus()
+			view.Stations = append(view.Stations, ualStationView{
+				UUID:          utils.UUIDToString(spec.GetId()),
+				Name:          status.GetName(),
+				DeviceUUID:    utils.UUIDToString(spec.GetUALDevice()),
+				LogicalIndex:  status.GetLogicalIndex(),
+				AdminState:    ualEnumName(spec.GetAdminState().String(), "UAL_STATION_STATE_"),
+				LinkState:     ualEnumName(status.GetOperState().String(), "UAL_LINK_STATE_"),
+				BandwidthGbps: status.GetCurrentAvailableBandwidth(),
+				Ports:         len(spec.GetNetworkPorts()),
+			})
+		}
+		sort.Slice(view.Stations, func(i, j int) bool {
+			if view.Stations[i].DeviceUUID != view.Stations[j].DeviceUUID {
+				return view.Stations[i].DeviceUUID < view.Stations[j].DeviceUUID
+			}
+			return view.Stations[i].LogicalIndex < view.Stations[j].LogicalIndex
+		})
+	}
+	if object == "all" || object == "port" {
+		resp, err := client.UALNetworkPortGet(ctx, &amdgpu.UALNetworkPortGetRequest{})
+		if err != nil {
+			return nil, fmt.Errorf("UALNetworkPortGet call failed: %v", err)
+		}
+		if resp.ApiStatus != amdgpu.ApiStatus_API_STATUS_OK {
+			return nil, fmt.Errorf("UALNetworkPortGet api status: %v", resp.ApiStatus)
+		}
+		for _, port := range resp.Response {
+			spec, status, stats := port.GetSpec(), port.GetStatus(), port.GetStats()
+			bins := []uint64{
+				stats.GetFECCodeWordSymbolErrors0(), stats.GetFECCodeWordSymbolErrors1(),
+				stats.GetFECCodeWordSymbolErrors2(), stats.GetFECCodeWordSymbolErrors3(),
+				stats.GetFECCodeWordSymbolErrors4(), stats.GetFECCodeWordSymbolErrors5(),
+				stats.GetFECCodeWordSymbolErrors6(), stats.GetFECCodeWordSymbolErrors7(),
+				stats.GetFECCodeWordSymbolErrors8(), stats.GetFECCodeWordSymbolErrors9(),
+				stats.GetFECCodeWordSymbolErrors10(), stats.GetFECCodeWordSymbolErrors11(),
+				stats.GetFECCodeWordSymbolErrors12(), stats.GetFECCodeWordSymbolErrors13(),
+				stats.GetFECCodeWordSymbolErrors14(), stats.GetFECCodeWordSymbolErrors15(),
+			}
+			counters := ualPortCounters{
+				FailedoverStreams: uint64(stats.GetNumFailedoverStreams()),
+				PausedStreams:     uint64(stats.GetNumPausedStreams()),
+				BitErrorRate:      stats.GetBitErrorRate(),
+			}
+			for i, count := range bins {
+				counters.FECCodeWords += count
+				if i != 0 {
+					counters.FECErroredWords += count
+				}
+			}
+			view.Ports = append(view.Ports, ualPortView{
+				UUID:        utils.UUIDToString(spec.GetId()),
+				Name:        status.GetName(),
+				StationUUID: utils.UUIDToString(spec.GetUALStation()),
+				LocalIndex:  status.GetLocalPortIndex(),
+				AdminState:  ualEnumName(spec.GetAdminState().String(), "UAL_PORT_STATE_"),
+				OperState:   ualEnumName(status.GetOperState().String(), "UAL_PORT_STATE_"),
+				Counters:    counters,
+			})
+		}
+		sort.Slice(view.Ports, func(i, j int) bool {
+			if view.Ports[i].StationUUID != view.Ports[j].StationUUID {
+				return view.Ports[i].StationUUID < view.Ports[j].StationUUID
+			}
+			return view.Ports[i].LocalIndex < view.Ports[j].LocalIndex
+		})
+	}
+	return view, nil
+}
+
+// setUALDeltas sets the port counter deltas since the previous view, the
+// stream and bit error rate gauges may also decrease
+func setUALDeltas(view, prev *ualView) {
+	if prev == nil {
+		return
+	}
+	prevPorts := make(map[string]ualPortCounters, len(prev.Ports))
+	for _, port := range prev.Ports {
+		prevPorts[port.UUID] = port.Counters
+	}
+	delta := func(cur, old uint64) int64 {
+		return int64(cur) - int64(old)
+	}
+	for i := range view.Ports {
+		old, ok := prevPorts[view.Ports[i].UUID]
+		if !ok {
+			continue
+		}
+		cur := view.Ports[i].Counters
+		view.Ports[i].Deltas = &ualPortDeltas{
+			FailedoverStreams: delta(cur.FailedoverStreams, old.FailedoverStreams),
+			PausedStreams:     delta(cur.PausedStreams, old.PausedStreams),
+			BitErrorRate:      delta(cur.BitErrorRate, old.BitErrorRate),
+			FECCodeWords:      delta(cur.FECCodeWords, old.FECCodeWords),
+			FECErroredWords:   delta(cur.FECErroredWords, old.FECErroredWords),
+		}
+	}
+}
+
+// ualCounter formats a counter padded to width, highlighted with its delta
+// when it changed
+func ualCounter(value uint64, delta *int64, width int) string {
+	if delta == nil || *delta == 0 {
+		return fmt.Sprintf("%-*d", width, value)
+	}
+	text := fmt.Sprintf("%d(%+d)", value, *delta)
+	pad := ""
+	if len(text) < width {
+		pad = strings.Repeat(" ", width-len(text))
+	}
+	return ualHighlight + text + ualReset + pad
+}
+
+func ualAccelerators(ids []uint32) string {
+	if len(ids) == 0 {
+		return "-"
+	}
+	s := make([]string, 0, len(ids))
+	for _, id := range ids {
+		s = append(s, fmt.Sprintf("%d", id))
+	}
+	return strings.Join(s, ",")
+}
+
+func printUALView(view *ualView) {
+	if len(view.Devices) != 0 {
+		fmt.Printf("%-38s %-6s %-10s %-12s %-10s %-12s %-10s %-10s %-16s %-10s\n",
+			"Device", "Accel", "Phase", "Virt", "Encap", "Crypto", "Failover", "LocalGPUs", "vPodGPUs", "Firmware")
+		fmt.Println(strings.Repeat("-", 140))
+		for _, d := range view.Devices {
+			fmt.Printf("%-38s %-6d %-10s %-12s %-10s %-12s %-10s %-10s %-16s %-10s\n",
+				d.UUID, d.AcceleratorID, d.ConfigPhase, d.VirtualizationMode, d.EncapType, d.CryptoMode,
+				d.FailoverMode, ualAccelerators(d.LocalAccelerators), ualAccelerators(d.VPodAccelerators),
+				d.FirmwareVersion)
+		}
+		fmt.Println()
+	}
+	if len(view.Stations) != 0 {
+		fmt.Printf("%-38s %-16s %-6s %-10s %-6s %-10s %-6s %-38s\n",
+			"Station", "Name", "Index", "Admin", "Link", "BW(Gbps)", "Ports", "Device")
+		fmt.Println(strings.Repeat("-", 140))
+		for _, s := range view.Stations {
+			fmt.Printf("%-38s %-16s %-6d %-10s %-6s %-10d %-6d %-38s\n",
+				s.UUID, s.Name, s.LogicalIndex, s.AdminState, s.LinkState, s.BandwidthGbps, s.Ports, s.DeviceUUID)
+		}
+		fmt.Println()
+	}
+	if len(view.Ports) != 0 {
+		fmt.Printf("%-16s %-6s %-10s %-10s %-14s %-14s %-14s %-24s %-20s %-38s\n",
+			"Port", "Index", "Admin", "Oper", "Failedover", "Paused", "BER", "FECCodewords", "FECErrored", "Station")
+		fmt.Println(strings.Repeat("-", 180))
+		for _, p := range view.Ports {
+			var d ualPortDeltas
+			has := p.Deltas != nil
+			if has {
+				d = *p.Deltas
+			}
+			pick := func(v *int64) *int64 {
+				if !has {
+					return nil
+				}
+				return v
+			}
+			fmt.Printf("%-16s %-6d %-10s %-10s %s %s %s %s %s %-38s\n",
+				p.Name, p.LocalIndex, p.AdminState, p.OperState,
+				ualCounter(p.Counters.FailedoverStreams, pick(&d.FailedoverStreams), 14),
+				ualCounter(p.Counters.PausedStreams, pick(&d.PausedStreams), 14),
+				ualCounter(p.Counters.BitErrorRate, pick(&d.BitErrorRate), 14),
+				ualCounter(p.Counters.FECCodeWords, pick(&d.FECCodeWords), 24),
+				ualCounter(p.Counters.FECErroredWords, pick(&d.FECErroredWords), 20),
+				p.StationUUID)
+		}
+	}
+}
+
+// showUAL prints the UAL state of the gpu agent once, or every interval
+// in watch mode until interrupted
+func showUAL(port, object string, isJson bool, interval time.Duration) error {
+	switch object {
+	case "all", "device", "station", "port":
+	default:
+		return fmt.Errorf("invalid UAL object %v, expected device, station, port or all", object)
+	}
+	conn, err := grpc.NewClient(
+		fmt.Sprintf("localhost:%s", port),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to connect to GPU agent: %v", err)
+	}
+	defer conn.Close()
+	client := amdgpu.NewUALSvcClient(conn)
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	var prev *ualView
+	for {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		view, err := getUALView(ctx, client, object)
+		cancel()
+		if err != nil {
+			return err
+		}
+		setUALDeltas(view, prev)
+		prev = view
+
+		if isJson {
+			jsonData, err := json.MarshalIndent(view, "", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal response: %v", err)
+			}
+			fmt.Println(string(jsonData))
+		} else {
+			if interval > 0 {
+				fmt.Print(ualClear)
+				fmt.Printf("Every %v: %v\n\n", interval, time.Now().Format(time.RFC3339))
+			}
+			printUALView(view)
+		}
+		if interval <= 0 {
+			return nil
+		}
+		select {
+		case <-sigs:
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright (c) Advanced Micro Devices, Inc. All rights reserved.
+
+Licensed under the Apache License, Version 2.0 (the \"License\");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+     http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an \"AS IS\" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ROCm/device-metrics-exporter/pkg/amdgpu/gen/amdgpu"
+)
+
+func TestSetUALDeltas(t *testing.T) {
+	port := func(uuid string, c ualPortCounters) ualPortView {
+		return ualPortView{UUID: uuid, Counters: c}
+	}
+	tests := []struct {
+		name     string
+		view     *ualView
+		prev     *ualView
+		expected map[string]*ualPortDeltas
+	}{
+		{
+			name:     "first view",
+			view:     &ualView{Ports: []ualPortView{port("p0", ualPortCounters{FECCodeWords: 10})}},
+			expected: map[string]*ualPortDeltas{"p0": nil},
+		},
+		{
+			name: "counters increase",
+			view: &ualView{Ports: []ualPortView{port("p0", ualPortCounters{FECCodeWords: 150, FECErroredWords: 3})}},
+			prev: &ualView{Ports: []ualPortView{port("p0", ualPortCounters{FECCodeWords: 100, FECErroredWords: 3})}},
+			expected: map[string]*ualPortDeltas{
+				"p0": {FECCodeWords: 50},
+			},
+		},
+		{
+			name: "gauges decrease",
+			view: &ualView{Ports: []ualPortView{port("p0", ualPortCounters{PausedStreams: 1, BitErrorRate: 2, FailedoverStreams: 0})}},
+			prev: &ualView{Ports: []ualPortView{port("p0", ualPortCounters{PausedStreams: 4, BitErrorRate: 7, FailedoverStreams: 2})}},
+			expected: map[string]*ualPortDeltas{
+				"p0": {PausedStreams: -3, BitErrorRate: -5, FailedoverStreams: -2},
+			},
+		},
+		{
+			name: "new port",
+			view: &ualView{Ports: []ualPortView{
+				port("p0", ualPortCounters{FECCodeWords: 5}),
+				port("p1", ualPortCounters{FECCodeWords: 9}),
+			}},
+			prev: &ualView{Ports: []ualPortView{port("p0", ualPortCounters{FECCodeWords: 5})}},
+			expected: map[string]*ualPortDeltas{
+				"p0": {},
+				"p1": nil,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setUALDeltas(tt.view, tt.prev)
+			for _, p := range tt.view.Ports {
+				if !reflect.DeepEqual(p.Deltas, tt.expected[p.UUID]) {
+					t.Errorf("port %s deltas %+v, want %+v", p.UUID, p.Deltas, tt.expected[p.UUID])
+				}
+			}
+		})
+	}
+}
+
+func TestUALCounter(t *testing.T) {
+	delta := func(v int64) *int64 { return &v }
+	tests := []struct {
+		name     string
+		value    uint64
+		delta    *int64
+		width    int
+		expected string
+	}{
+		{"no previous view", 42, nil, 6, "42    "},
+		{"unchanged", 42, delta(0), 6, "42    "},
+		{"increased", 42, delta(2), 10, ualHighlight + "42(+2)" + ualReset + "    "},
+		{"decreased", 7, delta(-3), 6, ualHighlight + "7(-3)" + ualReset + " "},
+		{"wider than the column", 123456, delta(100), 6, ualHighlight + "123456(+100)" + ualReset},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ualCounter(tt.value, tt.delta, tt.width); got != tt.expected {
+				t.Errorf("ualCounter() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestUALFormatHelpers(t *testing.T) {
+	tests := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{"no accelerators", ualAccelerators(nil), "-"},
+		{"accelerators", ualAccelerators([]uint32{0, 3, 7}), "0,3,7"},
+		{"enum name", ualEnumName("UAL_CONFIG_PHASE_ACTIVE", "UAL_CONFIG_PHASE_"), "active"},
+		{"no version", ualVersion(nil), "-"},
+		{"version", ualVersion(&amdgpu.SemanticVersion{Major: 1, Minor: 2, Patch: 3}), "1.2.3"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.expected {
+			t.Errorf("%s: got %q, want %q", tt.name, tt.got, tt.expected)
+		}
+	}
+}
+
+func TestPrintUALView(t *testing.T) {
+	view := &ualView{
+		Ports: []ualPortView{
+			{Name: "ual0", UUID: "p0", Counters: ualPortCounters{FECCodeWords: 150}},
+			{Name: "ual1", UUID: "p1", Counters: ualPortCounters{FECCodeWords: 80}},
+		},
+	}
+	setUALDeltas(view, &ualView{Ports: []ualPortView{
+		{UUID: "p0", Counters: ualPortCounters{FECCodeWords: 100}},
+		{UUID: "p1", Counters: ualPortCounters{FECCodeWords: 80}},
+	}})
+
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	printUALView(view)
+	os.Stdout = stdout
+	w.Close()
+	out, _ := io.ReadAll(r)
+
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	if len(lines) != 4 || !strings.HasPrefix(lines[0], "Port") {
+		t.Fatalf("expected the port table only, got:\n%s", out)
+	}
+	if !strings.Contains(lines[2], ualHighlight+"150(+50)"+ualReset) {
+		t.Errorf("changed counter not highlighted: %q", lines[2])
+	}
+	if strings.Contains(lines[3], ualHighlight) {
+		t.Errorf("unchanged counters highlighted: %q", lines[3])
+	}
+}